@@ -0,0 +1,3378 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.0
+// source: cms/v1/configuration.proto
+
+package cmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PatchType int32
+
+const (
+	PatchType_PATCH_TYPE_UNSPECIFIED PatchType = 0
+	PatchType_PATCH_TYPE_MERGE       PatchType = 1 // RFC 7396 JSON Merge Patch
+	PatchType_PATCH_TYPE_JSON        PatchType = 2 // RFC 6902 JSON Patch
+)
+
+// Enum value maps for PatchType.
+var (
+	PatchType_name = map[int32]string{
+		0: "PATCH_TYPE_UNSPECIFIED",
+		1: "PATCH_TYPE_MERGE",
+		2: "PATCH_TYPE_JSON",
+	}
+	PatchType_value = map[string]int32{
+		"PATCH_TYPE_UNSPECIFIED": 0,
+		"PATCH_TYPE_MERGE":       1,
+		"PATCH_TYPE_JSON":        2,
+	}
+)
+
+func (x PatchType) Enum() *PatchType {
+	p := new(PatchType)
+	*p = x
+	return p
+}
+
+func (x PatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cms_v1_configuration_proto_enumTypes[0].Descriptor()
+}
+
+func (PatchType) Type() protoreflect.EnumType {
+	return &file_cms_v1_configuration_proto_enumTypes[0]
+}
+
+func (x PatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchType.Descriptor instead.
+func (PatchType) EnumDescriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{0}
+}
+
+type SortField int32
+
+const (
+	SortField_SORT_FIELD_UNSPECIFIED SortField = 0 // Same as SORT_FIELD_NAME
+	SortField_SORT_FIELD_NAME        SortField = 1 // Namespace and name, and version for the versions of a configuration
+	SortField_SORT_FIELD_CREATED_AT  SortField = 2 // When the (latest) version was written
+	SortField_SORT_FIELD_VERSION     SortField = 3 // The (latest) version number
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_UNSPECIFIED",
+		1: "SORT_FIELD_NAME",
+		2: "SORT_FIELD_CREATED_AT",
+		3: "SORT_FIELD_VERSION",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_UNSPECIFIED": 0,
+		"SORT_FIELD_NAME":        1,
+		"SORT_FIELD_CREATED_AT":  2,
+		"SORT_FIELD_VERSION":     3,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_cms_v1_configuration_proto_enumTypes[1].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_cms_v1_configuration_proto_enumTypes[1]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortField.Descriptor instead.
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{1}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0 // Same as SORT_ORDER_ASC
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_cms_v1_configuration_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_cms_v1_configuration_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{2}
+}
+
+type DiffFormat int32
+
+const (
+	DiffFormat_DIFF_FORMAT_UNSPECIFIED DiffFormat = 0 // Same as DIFF_FORMAT_PATCH
+	DiffFormat_DIFF_FORMAT_PATCH       DiffFormat = 1 // RFC 6902 JSON Patch operations
+	DiffFormat_DIFF_FORMAT_UNIFIED     DiffFormat = 2 // Unified diff for humans to read
+)
+
+// Enum value maps for DiffFormat.
+var (
+	DiffFormat_name = map[int32]string{
+		0: "DIFF_FORMAT_UNSPECIFIED",
+		1: "DIFF_FORMAT_PATCH",
+		2: "DIFF_FORMAT_UNIFIED",
+	}
+	DiffFormat_value = map[string]int32{
+		"DIFF_FORMAT_UNSPECIFIED": 0,
+		"DIFF_FORMAT_PATCH":       1,
+		"DIFF_FORMAT_UNIFIED":     2,
+	}
+)
+
+func (x DiffFormat) Enum() *DiffFormat {
+	p := new(DiffFormat)
+	*p = x
+	return p
+}
+
+func (x DiffFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_cms_v1_configuration_proto_enumTypes[3].Descriptor()
+}
+
+func (DiffFormat) Type() protoreflect.EnumType {
+	return &file_cms_v1_configuration_proto_enumTypes[3]
+}
+
+func (x DiffFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffFormat.Descriptor instead.
+func (DiffFormat) EnumDescriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{3}
+}
+
+type ConfigEventType int32
+
+const (
+	ConfigEventType_CONFIG_EVENT_TYPE_UNSPECIFIED ConfigEventType = 0
+	ConfigEventType_CONFIG_EVENT_TYPE_PUT         ConfigEventType = 1
+	ConfigEventType_CONFIG_EVENT_TYPE_ROLLBACK    ConfigEventType = 2
+	ConfigEventType_CONFIG_EVENT_TYPE_DELETE      ConfigEventType = 3
+	ConfigEventType_CONFIG_EVENT_TYPE_RESTORE     ConfigEventType = 4
+	ConfigEventType_CONFIG_EVENT_TYPE_PURGE       ConfigEventType = 5
+	ConfigEventType_CONFIG_EVENT_TYPE_PROMOTE     ConfigEventType = 6
+)
+
+// Enum value maps for ConfigEventType.
+var (
+	ConfigEventType_name = map[int32]string{
+		0: "CONFIG_EVENT_TYPE_UNSPECIFIED",
+		1: "CONFIG_EVENT_TYPE_PUT",
+		2: "CONFIG_EVENT_TYPE_ROLLBACK",
+		3: "CONFIG_EVENT_TYPE_DELETE",
+		4: "CONFIG_EVENT_TYPE_RESTORE",
+		5: "CONFIG_EVENT_TYPE_PURGE",
+		6: "CONFIG_EVENT_TYPE_PROMOTE",
+	}
+	ConfigEventType_value = map[string]int32{
+		"CONFIG_EVENT_TYPE_UNSPECIFIED": 0,
+		"CONFIG_EVENT_TYPE_PUT":         1,
+		"CONFIG_EVENT_TYPE_ROLLBACK":    2,
+		"CONFIG_EVENT_TYPE_DELETE":      3,
+		"CONFIG_EVENT_TYPE_RESTORE":     4,
+		"CONFIG_EVENT_TYPE_PURGE":       5,
+		"CONFIG_EVENT_TYPE_PROMOTE":     6,
+	}
+)
+
+func (x ConfigEventType) Enum() *ConfigEventType {
+	p := new(ConfigEventType)
+	*p = x
+	return p
+}
+
+func (x ConfigEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cms_v1_configuration_proto_enumTypes[4].Descriptor()
+}
+
+func (ConfigEventType) Type() protoreflect.EnumType {
+	return &file_cms_v1_configuration_proto_enumTypes[4]
+}
+
+func (x ConfigEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigEventType.Descriptor instead.
+func (ConfigEventType) EnumDescriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{4}
+}
+
+// Config is a version of a configuration
+type Config struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	Name              string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type              string                      `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value             *structpb.Struct            `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Version           int64                       `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	SchemaVersion     int64                       `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`             // Version of the type's schema the value was validated against
+	RollbackedVersion int64                       `protobuf:"varint,6,opt,name=rollbacked_version,json=rollbackedVersion,proto3" json:"rollbacked_version,omitempty"` // Version the value was copied from by a rollback or restore
+	Deleted           bool                        `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`                                              // Set on the tombstone version of a deleted configuration
+	CreatedAt         *timestamppb.Timestamp      `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy         string                      `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`              // Caller that wrote the version, if the request was authenticated
+	ChangeMessage     string                      `protobuf:"bytes,10,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"` // Why the version was written, if the request said
+	Namespace         string                      `protobuf:"bytes,11,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Overlays          map[string]*structpb.Struct `protobuf:"bytes,12,rep,name=overlays,proto3" json:"overlays,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Merge patch over the value by environment, unset when the value of an environment is asked for
+	Environment       string                      `protobuf:"bytes,13,opt,name=environment,proto3" json:"environment,omitempty"`                                                                     // Environment whose overlay the version wrote
+	PromotedFrom      string                      `protobuf:"bytes,14,opt,name=promoted_from,json=promotedFrom,proto3" json:"promoted_from,omitempty"`                                               // Environment the overlay was promoted from
+	PromotedVersion   int64                       `protobuf:"varint,15,opt,name=promoted_version,json=promotedVersion,proto3" json:"promoted_version,omitempty"`                                     // Version the overlay was promoted from
+	Labels            map[string]string           `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string           `protobuf:"bytes,17,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Config) GetValue() *structpb.Struct {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Config) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Config) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Config) GetRollbackedVersion() int64 {
+	if x != nil {
+		return x.RollbackedVersion
+	}
+	return 0
+}
+
+func (x *Config) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Config) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Config) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Config) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *Config) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Config) GetOverlays() map[string]*structpb.Struct {
+	if x != nil {
+		return x.Overlays
+	}
+	return nil
+}
+
+func (x *Config) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Config) GetPromotedFrom() string {
+	if x != nil {
+		return x.PromotedFrom
+	}
+	return ""
+}
+
+func (x *Config) GetPromotedVersion() int64 {
+	if x != nil {
+		return x.PromotedVersion
+	}
+	return 0
+}
+
+func (x *Config) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Config) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// StringMap is a map whose presence is told apart from an empty map: an empty map removes what an unset one keeps
+type StringMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       map[string]string      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringMap) Reset() {
+	*x = StringMap{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringMap) ProtoMessage() {}
+
+func (x *StringMap) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringMap.ProtoReflect.Descriptor instead.
+func (*StringMap) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StringMap) GetEntries() map[string]string {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PutConfigurationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value           *structpb.Struct       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, the version this request replaces
+	ChangeMessage   string                 `protobuf:"bytes,5,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`        // Optional, why the configuration changes
+	Namespace       string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`                                     // Optional, defaults to the default namespace
+	Labels          *StringMap             `protobuf:"bytes,7,opt,name=labels,proto3" json:"labels,omitempty"`                                           // Optional, replaces the labels, they are kept if unset
+	Annotations     *StringMap             `protobuf:"bytes,8,opt,name=annotations,proto3" json:"annotations,omitempty"`                                 // Optional, replaces the annotations, they are kept if unset
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutConfigurationRequest) Reset() {
+	*x = PutConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutConfigurationRequest) ProtoMessage() {}
+
+func (x *PutConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*PutConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PutConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutConfigurationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PutConfigurationRequest) GetValue() *structpb.Struct {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PutConfigurationRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *PutConfigurationRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *PutConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PutConfigurationRequest) GetLabels() *StringMap {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PutConfigurationRequest) GetAnnotations() *StringMap {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// Violation is a single field of a configuration value that doesn't match its schema
+type Violation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pointer       string                 `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Violation) Reset() {
+	*x = Violation{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation) ProtoMessage() {}
+
+func (x *Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Violation.ProtoReflect.Descriptor instead.
+func (*Violation) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Violation) GetPointer() string {
+	if x != nil {
+		return x.Pointer
+	}
+	return ""
+}
+
+func (x *Violation) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *Violation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`                                  // Version that would be created, if valid
+	SchemaVersion int64                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"` // Version of the type's schema the value was validated against, if valid
+	Violations    []*Violation           `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`                             // Every field that doesn't match the schema, if invalid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidateConfigurationResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateConfigurationResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ValidateConfigurationResponse) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *ValidateConfigurationResponse) GetViolations() []*Violation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type PatchConfigurationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PatchType       PatchType              `protobuf:"varint,2,opt,name=patch_type,json=patchType,proto3,enum=cms.v1.PatchType" json:"patch_type,omitempty"`
+	Patch           []byte                 `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`                                             // JSON document of the patch
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, the version this request replaces
+	ChangeMessage   string                 `protobuf:"bytes,5,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`        // Optional, why the configuration changes
+	Namespace       string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`                                     // Optional, defaults to the default namespace
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PatchConfigurationRequest) Reset() {
+	*x = PatchConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchConfigurationRequest) ProtoMessage() {}
+
+func (x *PatchConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*PatchConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PatchConfigurationRequest) GetPatchType() PatchType {
+	if x != nil {
+		return x.PatchType
+	}
+	return PatchType_PATCH_TYPE_UNSPECIFIED
+}
+
+func (x *PatchConfigurationRequest) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *PatchConfigurationRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *PatchConfigurationRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *PatchConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type PutConfigurationOverlayRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Environment     string                 `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	Overlay         *structpb.Struct       `protobuf:"bytes,3,opt,name=overlay,proto3" json:"overlay,omitempty"`                                         // Merge patch over the base value, empty to remove the overlay
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, the version this request replaces
+	ChangeMessage   string                 `protobuf:"bytes,5,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`        // Optional, why the overlay changes
+	Namespace       string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`                                     // Optional, defaults to the default namespace
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutConfigurationOverlayRequest) Reset() {
+	*x = PutConfigurationOverlayRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutConfigurationOverlayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutConfigurationOverlayRequest) ProtoMessage() {}
+
+func (x *PutConfigurationOverlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutConfigurationOverlayRequest.ProtoReflect.Descriptor instead.
+func (*PutConfigurationOverlayRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PutConfigurationOverlayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutConfigurationOverlayRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *PutConfigurationOverlayRequest) GetOverlay() *structpb.Struct {
+	if x != nil {
+		return x.Overlay
+	}
+	return nil
+}
+
+func (x *PutConfigurationOverlayRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *PutConfigurationOverlayRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *PutConfigurationOverlayRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type PutConfigurationLabelsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels          *StringMap             `protobuf:"bytes,2,opt,name=labels,proto3" json:"labels,omitempty"`                                           // Optional, replaces the labels, they are kept if unset
+	Annotations     *StringMap             `protobuf:"bytes,3,opt,name=annotations,proto3" json:"annotations,omitempty"`                                 // Optional, replaces the annotations, they are kept if unset
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, the version this request replaces
+	ChangeMessage   string                 `protobuf:"bytes,5,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`        // Optional, why the labels change
+	Namespace       string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`                                     // Optional, defaults to the default namespace
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutConfigurationLabelsRequest) Reset() {
+	*x = PutConfigurationLabelsRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutConfigurationLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutConfigurationLabelsRequest) ProtoMessage() {}
+
+func (x *PutConfigurationLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutConfigurationLabelsRequest.ProtoReflect.Descriptor instead.
+func (*PutConfigurationLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PutConfigurationLabelsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PutConfigurationLabelsRequest) GetLabels() *StringMap {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PutConfigurationLabelsRequest) GetAnnotations() *StringMap {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *PutConfigurationLabelsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *PutConfigurationLabelsRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *PutConfigurationLabelsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`     // Optional, defaults to the default namespace
+	Environment   string                 `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"` // Optional, return the value this environment sees
+	Alias         string                 `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`             // Optional, return the version this alias points at instead of the latest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigurationRequest) Reset() {
+	*x = GetConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationRequest) ProtoMessage() {}
+
+func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetConfigurationRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *GetConfigurationRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type WaitForConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AfterVersion  int64                  `protobuf:"varint,2,opt,name=after_version,json=afterVersion,proto3" json:"after_version,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`         // Optional, between 1s and 5m, defaults to 30s
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`     // Optional, defaults to the default namespace
+	Environment   string                 `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"` // Optional, return the value this environment sees
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForConfigurationRequest) Reset() {
+	*x = WaitForConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForConfigurationRequest) ProtoMessage() {}
+
+func (x *WaitForConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*WaitForConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WaitForConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WaitForConfigurationRequest) GetAfterVersion() int64 {
+	if x != nil {
+		return x.AfterVersion
+	}
+	return 0
+}
+
+func (x *WaitForConfigurationRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *WaitForConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WaitForConfigurationRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type WaitForConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modified      bool                   `protobuf:"varint,1,opt,name=modified,proto3" json:"modified,omitempty"` // False when no newer version was written before the timeout
+	Config        *Config                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`      // Set if modified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForConfigurationResponse) Reset() {
+	*x = WaitForConfigurationResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForConfigurationResponse) ProtoMessage() {}
+
+func (x *WaitForConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*WaitForConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WaitForConfigurationResponse) GetModified() bool {
+	if x != nil {
+		return x.Modified
+	}
+	return false
+}
+
+func (x *WaitForConfigurationResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ListConfigurationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Skip      uint64                 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`          // Skipped after the cursor
+	Limit     uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`        // Between 1 and 100
+	Namespace string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Optional, defaults to the default namespace
+	Sort      SortField              `protobuf:"varint,4,opt,name=sort,proto3,enum=cms.v1.SortField" json:"sort,omitempty"`
+	Order     SortOrder              `protobuf:"varint,5,opt,name=order,proto3,enum=cms.v1.SortOrder" json:"order,omitempty"`
+	Cursor    string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"` // Optional, the next_cursor of the previous page, which had the same sort and order
+	// Optional filters, a configuration is listed if its latest version passes all of them
+	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	NamePrefix    string                 `protobuf:"bytes,8,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	NameGlob      string                 `protobuf:"bytes,9,opt,name=name_glob,json=nameGlob,proto3" json:"name_glob,omitempty"` // e.g. payments_*
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Where         []string               `protobuf:"bytes,12,rep,name=where,proto3" json:"where,omitempty"`       // Predicates on the value, such as value.region == "eu"
+	Selector      string                 `protobuf:"bytes,13,opt,name=selector,proto3" json:"selector,omitempty"` // Label selector, such as team=payments,tier in (critical,high)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationsRequest) Reset() {
+	*x = ListConfigurationsRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationsRequest) ProtoMessage() {}
+
+func (x *ListConfigurationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigurationsRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListConfigurationsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *ListConfigurationsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListConfigurationsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListConfigurationsRequest) GetSort() SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListConfigurationsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListConfigurationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListConfigurationsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListConfigurationsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListConfigurationsRequest) GetNameGlob() string {
+	if x != nil {
+		return x.NameGlob
+	}
+	return ""
+}
+
+func (x *ListConfigurationsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListConfigurationsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListConfigurationsRequest) GetWhere() []string {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+func (x *ListConfigurationsRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type ListConfigurationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*Config              `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	Total         uint64                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                            // How many configurations the caller may read across every page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationsResponse) Reset() {
+	*x = ListConfigurationsResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationsResponse) ProtoMessage() {}
+
+func (x *ListConfigurationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigurationsResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListConfigurationsResponse) GetConfigs() []*Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *ListConfigurationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListConfigurationsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListConfigurationVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Skip          uint64                 `protobuf:"varint,2,opt,name=skip,proto3" json:"skip,omitempty"`                           // Skipped after the cursor
+	Limit         uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                         // Between 5 and 100
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Optional, only list the versions written by this caller
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`                  // Optional, defaults to the default namespace
+	Sort          SortField              `protobuf:"varint,6,opt,name=sort,proto3,enum=cms.v1.SortField" json:"sort,omitempty"`
+	Order         SortOrder              `protobuf:"varint,7,opt,name=order,proto3,enum=cms.v1.SortOrder" json:"order,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"` // Optional, the next_cursor of the previous page, which had the same sort and order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationVersionsRequest) Reset() {
+	*x = ListConfigurationVersionsRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationVersionsRequest) ProtoMessage() {}
+
+func (x *ListConfigurationVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigurationVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListConfigurationVersionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListConfigurationVersionsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *ListConfigurationVersionsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListConfigurationVersionsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListConfigurationVersionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListConfigurationVersionsRequest) GetSort() SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListConfigurationVersionsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListConfigurationVersionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListConfigurationVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*Config              `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	Total         uint64                 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                            // How many versions pass the filter across every page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationVersionsResponse) Reset() {
+	*x = ListConfigurationVersionsResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationVersionsResponse) ProtoMessage() {}
+
+func (x *ListConfigurationVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigurationVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListConfigurationVersionsResponse) GetConfigs() []*Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *ListConfigurationVersionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListConfigurationVersionsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetConfigurationVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`     // Optional, defaults to the default namespace
+	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"` // Optional, return the value this environment saw
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigurationVersionRequest) Reset() {
+	*x = GetConfigurationVersionRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigurationVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationVersionRequest) ProtoMessage() {}
+
+func (x *GetConfigurationVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigurationVersionRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetConfigurationVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetConfigurationVersionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetConfigurationVersionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetConfigurationVersionRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type RollbackConfigurationVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeMessage string                 `protobuf:"bytes,3,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"` // Optional, why the configuration is rolled back
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                              // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackConfigurationVersionRequest) Reset() {
+	*x = RollbackConfigurationVersionRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackConfigurationVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackConfigurationVersionRequest) ProtoMessage() {}
+
+func (x *RollbackConfigurationVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackConfigurationVersionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackConfigurationVersionRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RollbackConfigurationVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RollbackConfigurationVersionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackConfigurationVersionRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *RollbackConfigurationVersionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type PromoteConfigurationVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                                        // Environment whose overlay is promoted
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                                            // Environment the overlay is copied to
+	ChangeMessage string                 `protobuf:"bytes,5,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"` // Optional, why the version is promoted
+	Namespace     string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`                              // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteConfigurationVersionRequest) Reset() {
+	*x = PromoteConfigurationVersionRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteConfigurationVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteConfigurationVersionRequest) ProtoMessage() {}
+
+func (x *PromoteConfigurationVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteConfigurationVersionRequest.ProtoReflect.Descriptor instead.
+func (*PromoteConfigurationVersionRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PromoteConfigurationVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromoteConfigurationVersionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PromoteConfigurationVersionRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PromoteConfigurationVersionRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PromoteConfigurationVersionRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *PromoteConfigurationVersionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// Alias is a revision of an alias of a configuration, every move of an alias is kept as one
+type Alias struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Name of the configuration
+	Alias         string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Version       int64                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`   // Version the alias points at, 0 once it is cleared
+	Revision      int64                  `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"` // Number of the move, the first move is 1
+	MovedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	MovedBy       string                 `protobuf:"bytes,7,opt,name=moved_by,json=movedBy,proto3" json:"moved_by,omitempty"`                   // Caller that moved the alias, if the request was authenticated
+	ChangeMessage string                 `protobuf:"bytes,8,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"` // Why the alias was moved, if the request said
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alias) Reset() {
+	*x = Alias{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alias) ProtoMessage() {}
+
+func (x *Alias) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alias.ProtoReflect.Descriptor instead.
+func (*Alias) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Alias) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Alias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alias) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Alias) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Alias) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Alias) GetMovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MovedAt
+	}
+	return nil
+}
+
+func (x *Alias) GetMovedBy() string {
+	if x != nil {
+		return x.MovedBy
+	}
+	return ""
+}
+
+func (x *Alias) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+type MoveConfigurationAliasRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias            string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Version          int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	ExpectedRevision int64                  `protobuf:"varint,4,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"` // Optional, the revision of the alias this move replaces
+	ChangeMessage    string                 `protobuf:"bytes,5,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`           // Optional, why the alias is moved
+	Namespace        string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`                                        // Optional, defaults to the default namespace
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MoveConfigurationAliasRequest) Reset() {
+	*x = MoveConfigurationAliasRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveConfigurationAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveConfigurationAliasRequest) ProtoMessage() {}
+
+func (x *MoveConfigurationAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveConfigurationAliasRequest.ProtoReflect.Descriptor instead.
+func (*MoveConfigurationAliasRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MoveConfigurationAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MoveConfigurationAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *MoveConfigurationAliasRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MoveConfigurationAliasRequest) GetExpectedRevision() int64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *MoveConfigurationAliasRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *MoveConfigurationAliasRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ClearConfigurationAliasRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias            string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	ExpectedRevision int64                  `protobuf:"varint,3,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"` // Optional, the revision of the alias this request clears
+	ChangeMessage    string                 `protobuf:"bytes,4,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`           // Optional, why the alias is cleared
+	Namespace        string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`                                        // Optional, defaults to the default namespace
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ClearConfigurationAliasRequest) Reset() {
+	*x = ClearConfigurationAliasRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearConfigurationAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearConfigurationAliasRequest) ProtoMessage() {}
+
+func (x *ClearConfigurationAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearConfigurationAliasRequest.ProtoReflect.Descriptor instead.
+func (*ClearConfigurationAliasRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClearConfigurationAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClearConfigurationAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ClearConfigurationAliasRequest) GetExpectedRevision() int64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *ClearConfigurationAliasRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *ClearConfigurationAliasRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetConfigurationAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`     // Optional, defaults to the default namespace
+	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"` // Optional, return the value this environment saw
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigurationAliasRequest) Reset() {
+	*x = GetConfigurationAliasRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigurationAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationAliasRequest) ProtoMessage() {}
+
+func (x *GetConfigurationAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationAliasRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigurationAliasRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConfigurationAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetConfigurationAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *GetConfigurationAliasRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetConfigurationAliasRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type GetConfigurationAliasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         *Alias                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Config        *Config                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"` // Version the alias points at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigurationAliasResponse) Reset() {
+	*x = GetConfigurationAliasResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigurationAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationAliasResponse) ProtoMessage() {}
+
+func (x *GetConfigurationAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationAliasResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigurationAliasResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetConfigurationAliasResponse) GetAlias() *Alias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+func (x *GetConfigurationAliasResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ListConfigurationAliasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationAliasesRequest) Reset() {
+	*x = ListConfigurationAliasesRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationAliasesRequest) ProtoMessage() {}
+
+func (x *ListConfigurationAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationAliasesRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigurationAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListConfigurationAliasesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListConfigurationAliasesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListConfigurationAliasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aliases       []*Alias               `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"` // Ordered by alias
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationAliasesResponse) Reset() {
+	*x = ListConfigurationAliasesResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationAliasesResponse) ProtoMessage() {}
+
+func (x *ListConfigurationAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigurationAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListConfigurationAliasesResponse) GetAliases() []*Alias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type ListConfigurationAliasRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Skip          uint64                 `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
+	Limit         uint64                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`        // Between 1 and 100
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) Reset() {
+	*x = ListConfigurationAliasRevisionsRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationAliasRevisionsRequest) ProtoMessage() {}
+
+func (x *ListConfigurationAliasRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationAliasRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigurationAliasRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListConfigurationAliasRevisionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListConfigurationAliasRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Alias               `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigurationAliasRevisionsResponse) Reset() {
+	*x = ListConfigurationAliasRevisionsResponse{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigurationAliasRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigurationAliasRevisionsResponse) ProtoMessage() {}
+
+func (x *ListConfigurationAliasRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigurationAliasRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigurationAliasRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListConfigurationAliasRevisionsResponse) GetRevisions() []*Alias {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type DiffConfigurationVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromVersion   int64                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int64                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"` // Optional, defaults to the latest version
+	Format        DiffFormat             `protobuf:"varint,4,opt,name=format,proto3,enum=cms.v1.DiffFormat" json:"format,omitempty"`
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffConfigurationVersionsRequest) Reset() {
+	*x = DiffConfigurationVersionsRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffConfigurationVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigurationVersionsRequest) ProtoMessage() {}
+
+func (x *DiffConfigurationVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigurationVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffConfigurationVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DiffConfigurationVersionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffConfigurationVersionsRequest) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffConfigurationVersionsRequest) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffConfigurationVersionsRequest) GetFormat() DiffFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DiffFormat_DIFF_FORMAT_UNSPECIFIED
+}
+
+func (x *DiffConfigurationVersionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// DiffOperation is an RFC 6902 JSON Patch operation
+type DiffOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // Unset for a remove
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffOperation) Reset() {
+	*x = DiffOperation{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffOperation) ProtoMessage() {}
+
+func (x *DiffOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffOperation.ProtoReflect.Descriptor instead.
+func (*DiffOperation) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiffOperation) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *DiffOperation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiffOperation) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ConfigDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromVersion   int64                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int64                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	FromType      string                 `protobuf:"bytes,4,opt,name=from_type,json=fromType,proto3" json:"from_type,omitempty"`
+	ToType        string                 `protobuf:"bytes,5,opt,name=to_type,json=toType,proto3" json:"to_type,omitempty"`
+	Operations    []*DiffOperation       `protobuf:"bytes,6,rep,name=operations,proto3" json:"operations,omitempty"` // For the patch format
+	Unified       string                 `protobuf:"bytes,7,opt,name=unified,proto3" json:"unified,omitempty"`       // For the unified format
+	Namespace     string                 `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigDiff) Reset() {
+	*x = ConfigDiff{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDiff) ProtoMessage() {}
+
+func (x *ConfigDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDiff.ProtoReflect.Descriptor instead.
+func (*ConfigDiff) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConfigDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *ConfigDiff) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *ConfigDiff) GetFromType() string {
+	if x != nil {
+		return x.FromType
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetToType() string {
+	if x != nil {
+		return x.ToType
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetOperations() []*DiffOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *ConfigDiff) GetUnified() string {
+	if x != nil {
+		return x.Unified
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DeleteConfigurationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Optional, the version this request deletes
+	ChangeMessage   string                 `protobuf:"bytes,3,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"`        // Optional, why the configuration is deleted
+	Namespace       string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                                     // Optional, defaults to the default namespace
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteConfigurationRequest) Reset() {
+	*x = DeleteConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConfigurationRequest) ProtoMessage() {}
+
+func (x *DeleteConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteConfigurationRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DeleteConfigurationRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *DeleteConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type RestoreConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChangeMessage string                 `protobuf:"bytes,2,opt,name=change_message,json=changeMessage,proto3" json:"change_message,omitempty"` // Optional, why the configuration is restored
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`                              // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreConfigurationRequest) Reset() {
+	*x = RestoreConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreConfigurationRequest) ProtoMessage() {}
+
+func (x *RestoreConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*RestoreConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreConfigurationRequest) GetChangeMessage() string {
+	if x != nil {
+		return x.ChangeMessage
+	}
+	return ""
+}
+
+func (x *RestoreConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type PurgeConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // Optional, defaults to the default namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeConfigurationRequest) Reset() {
+	*x = PurgeConfigurationRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeConfigurationRequest) ProtoMessage() {}
+
+func (x *PurgeConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*PurgeConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PurgeConfigurationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PurgeConfigurationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // Optional, a configuration name
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`                                  // Optional, a configuration name prefix
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                      // Optional, a config type
+	LastRevision  uint64                 `protobuf:"varint,4,opt,name=last_revision,json=lastRevision,proto3" json:"last_revision,omitempty"` // Optional, the revision to resume after
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`                            // Optional, every namespace when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetLastRevision() uint64 {
+	if x != nil {
+		return x.LastRevision
+	}
+	return 0
+}
+
+func (x *WatchRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// ConfigEvent is a change of a configuration
+type ConfigEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      uint64                 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type          ConfigEventType        `protobuf:"varint,2,opt,name=type,proto3,enum=cms.v1.ConfigEventType" json:"type,omitempty"`
+	Config        *Config                `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"` // For a purge, only the namespace and name are set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigEvent) Reset() {
+	*x = ConfigEvent{}
+	mi := &file_cms_v1_configuration_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigEvent) ProtoMessage() {}
+
+func (x *ConfigEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cms_v1_configuration_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigEvent.ProtoReflect.Descriptor instead.
+func (*ConfigEvent) Descriptor() ([]byte, []int) {
+	return file_cms_v1_configuration_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfigEvent) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigEvent) GetType() ConfigEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ConfigEventType_CONFIG_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ConfigEvent) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+var File_cms_v1_configuration_proto protoreflect.FileDescriptor
+
+const file_cms_v1_configuration_proto_rawDesc = "" +
+	"\n" +
+	"\x1acms/v1/configuration.proto\x12\x06cms.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfc\x06\n" +
+	"\x06Config\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12-\n" +
+	"\x05value\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x05value\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\x03R\rschemaVersion\x12-\n" +
+	"\x12rollbacked_version\x18\x06 \x01(\x03R\x11rollbackedVersion\x12\x18\n" +
+	"\adeleted\x18\a \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12%\n" +
+	"\x0echange_message\x18\n" +
+	" \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\v \x01(\tR\tnamespace\x128\n" +
+	"\boverlays\x18\f \x03(\v2\x1c.cms.v1.Config.OverlaysEntryR\boverlays\x12 \n" +
+	"\venvironment\x18\r \x01(\tR\venvironment\x12#\n" +
+	"\rpromoted_from\x18\x0e \x01(\tR\fpromotedFrom\x12)\n" +
+	"\x10promoted_version\x18\x0f \x01(\x03R\x0fpromotedVersion\x122\n" +
+	"\x06labels\x18\x10 \x03(\v2\x1a.cms.v1.Config.LabelsEntryR\x06labels\x12A\n" +
+	"\vannotations\x18\x11 \x03(\v2\x1f.cms.v1.Config.AnnotationsEntryR\vannotations\x1aT\n" +
+	"\rOverlaysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x81\x01\n" +
+	"\tStringMap\x128\n" +
+	"\aentries\x18\x01 \x03(\v2\x1e.cms.v1.StringMap.EntriesEntryR\aentries\x1a:\n" +
+	"\fEntriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc0\x02\n" +
+	"\x17PutConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12-\n" +
+	"\x05value\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x05value\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12%\n" +
+	"\x0echange_message\x18\x05 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12)\n" +
+	"\x06labels\x18\a \x01(\v2\x11.cms.v1.StringMapR\x06labels\x123\n" +
+	"\vannotations\x18\b \x01(\v2\x11.cms.v1.StringMapR\vannotations\"Y\n" +
+	"\tViolation\x12\x18\n" +
+	"\apointer\x18\x01 \x01(\tR\apointer\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa9\x01\n" +
+	"\x1dValidateConfigurationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x03R\rschemaVersion\x121\n" +
+	"\n" +
+	"violations\x18\x04 \x03(\v2\x11.cms.v1.ViolationR\n" +
+	"violations\"\xe7\x01\n" +
+	"\x19PatchConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\n" +
+	"patch_type\x18\x02 \x01(\x0e2\x11.cms.v1.PatchTypeR\tpatchType\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\fR\x05patch\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12%\n" +
+	"\x0echange_message\x18\x05 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\xf9\x01\n" +
+	"\x1ePutConfigurationOverlayRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\venvironment\x18\x02 \x01(\tR\venvironment\x121\n" +
+	"\aoverlay\x18\x03 \x01(\v2\x17.google.protobuf.StructR\aoverlay\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12%\n" +
+	"\x0echange_message\x18\x05 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\x83\x02\n" +
+	"\x1dPutConfigurationLabelsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x06labels\x18\x02 \x01(\v2\x11.cms.v1.StringMapR\x06labels\x123\n" +
+	"\vannotations\x18\x03 \x01(\v2\x11.cms.v1.StringMapR\vannotations\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12%\n" +
+	"\x0echange_message\x18\x05 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\x83\x01\n" +
+	"\x17GetConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12 \n" +
+	"\venvironment\x18\x03 \x01(\tR\venvironment\x12\x14\n" +
+	"\x05alias\x18\x04 \x01(\tR\x05alias\"\xcb\x01\n" +
+	"\x1bWaitForConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rafter_version\x18\x02 \x01(\x03R\fafterVersion\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12 \n" +
+	"\venvironment\x18\x05 \x01(\tR\venvironment\"b\n" +
+	"\x1cWaitForConfigurationResponse\x12\x1a\n" +
+	"\bmodified\x18\x01 \x01(\bR\bmodified\x12&\n" +
+	"\x06config\x18\x02 \x01(\v2\x0e.cms.v1.ConfigR\x06config\"\xd3\x03\n" +
+	"\x19ListConfigurationsRequest\x12\x12\n" +
+	"\x04skip\x18\x01 \x01(\x04R\x04skip\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12%\n" +
+	"\x04sort\x18\x04 \x01(\x0e2\x11.cms.v1.SortFieldR\x04sort\x12'\n" +
+	"\x05order\x18\x05 \x01(\x0e2\x11.cms.v1.SortOrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12\x1f\n" +
+	"\vname_prefix\x18\b \x01(\tR\n" +
+	"namePrefix\x12\x1b\n" +
+	"\tname_glob\x18\t \x01(\tR\bnameGlob\x12?\n" +
+	"\rcreated_after\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x14\n" +
+	"\x05where\x18\f \x03(\tR\x05where\x12\x1a\n" +
+	"\bselector\x18\r \x01(\tR\bselector\"}\n" +
+	"\x1aListConfigurationsResponse\x12(\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x0e.cms.v1.ConfigR\aconfigs\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x04R\x05total\"\x85\x02\n" +
+	" ListConfigurationVersionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04skip\x18\x02 \x01(\x04R\x04skip\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x04R\x05limit\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12%\n" +
+	"\x04sort\x18\x06 \x01(\x0e2\x11.cms.v1.SortFieldR\x04sort\x12'\n" +
+	"\x05order\x18\a \x01(\x0e2\x11.cms.v1.SortOrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"\x84\x01\n" +
+	"!ListConfigurationVersionsResponse\x12(\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x0e.cms.v1.ConfigR\aconfigs\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x04R\x05total\"\x8e\x01\n" +
+	"\x1eGetConfigurationVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12 \n" +
+	"\venvironment\x18\x04 \x01(\tR\venvironment\"\x98\x01\n" +
+	"#RollbackConfigurationVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12%\n" +
+	"\x0echange_message\x18\x03 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"\xbb\x01\n" +
+	"\"PromoteConfigurationVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12%\n" +
+	"\x0echange_message\x18\x05 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\xfe\x01\n" +
+	"\x05Alias\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\x12\x1a\n" +
+	"\brevision\x18\x05 \x01(\x03R\brevision\x125\n" +
+	"\bmoved_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\amovedAt\x12\x19\n" +
+	"\bmoved_by\x18\a \x01(\tR\amovedBy\x12%\n" +
+	"\x0echange_message\x18\b \x01(\tR\rchangeMessage\"\xd5\x01\n" +
+	"\x1dMoveConfigurationAliasRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12+\n" +
+	"\x11expected_revision\x18\x04 \x01(\x03R\x10expectedRevision\x12%\n" +
+	"\x0echange_message\x18\x05 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\xbc\x01\n" +
+	"\x1eClearConfigurationAliasRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12+\n" +
+	"\x11expected_revision\x18\x03 \x01(\x03R\x10expectedRevision\x12%\n" +
+	"\x0echange_message\x18\x04 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"\x88\x01\n" +
+	"\x1cGetConfigurationAliasRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12 \n" +
+	"\venvironment\x18\x04 \x01(\tR\venvironment\"l\n" +
+	"\x1dGetConfigurationAliasResponse\x12#\n" +
+	"\x05alias\x18\x01 \x01(\v2\r.cms.v1.AliasR\x05alias\x12&\n" +
+	"\x06config\x18\x02 \x01(\v2\x0e.cms.v1.ConfigR\x06config\"S\n" +
+	"\x1fListConfigurationAliasesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"K\n" +
+	" ListConfigurationAliasesResponse\x12'\n" +
+	"\aaliases\x18\x01 \x03(\v2\r.cms.v1.AliasR\aaliases\"\x9a\x01\n" +
+	"&ListConfigurationAliasRevisionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\x12\x12\n" +
+	"\x04skip\x18\x03 \x01(\x04R\x04skip\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x04R\x05limit\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"V\n" +
+	"'ListConfigurationAliasRevisionsResponse\x12+\n" +
+	"\trevisions\x18\x01 \x03(\v2\r.cms.v1.AliasR\trevisions\"\xc2\x01\n" +
+	" DiffConfigurationVersionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x03R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x03R\ttoVersion\x12*\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x12.cms.v1.DiffFormatR\x06format\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"a\n" +
+	"\rDiffOperation\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12,\n" +
+	"\x05value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\x87\x02\n" +
+	"\n" +
+	"ConfigDiff\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x03R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x03R\ttoVersion\x12\x1b\n" +
+	"\tfrom_type\x18\x04 \x01(\tR\bfromType\x12\x17\n" +
+	"\ato_type\x18\x05 \x01(\tR\x06toType\x125\n" +
+	"\n" +
+	"operations\x18\x06 \x03(\v2\x15.cms.v1.DiffOperationR\n" +
+	"operations\x12\x18\n" +
+	"\aunified\x18\a \x01(\tR\aunified\x12\x1c\n" +
+	"\tnamespace\x18\b \x01(\tR\tnamespace\"\xa0\x01\n" +
+	"\x1aDeleteConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12%\n" +
+	"\x0echange_message\x18\x03 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"v\n" +
+	"\x1bRestoreConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0echange_message\x18\x02 \x01(\tR\rchangeMessage\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"M\n" +
+	"\x19PurgeConfigurationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x91\x01\n" +
+	"\fWatchRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12#\n" +
+	"\rlast_revision\x18\x04 \x01(\x04R\flastRevision\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\"~\n" +
+	"\vConfigEvent\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x04R\brevision\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.cms.v1.ConfigEventTypeR\x04type\x12&\n" +
+	"\x06config\x18\x03 \x01(\v2\x0e.cms.v1.ConfigR\x06config*R\n" +
+	"\tPatchType\x12\x1a\n" +
+	"\x16PATCH_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10PATCH_TYPE_MERGE\x10\x01\x12\x13\n" +
+	"\x0fPATCH_TYPE_JSON\x10\x02*o\n" +
+	"\tSortField\x12\x1a\n" +
+	"\x16SORT_FIELD_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSORT_FIELD_NAME\x10\x01\x12\x19\n" +
+	"\x15SORT_FIELD_CREATED_AT\x10\x02\x12\x16\n" +
+	"\x12SORT_FIELD_VERSION\x10\x03*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*Y\n" +
+	"\n" +
+	"DiffFormat\x12\x1b\n" +
+	"\x17DIFF_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DIFF_FORMAT_PATCH\x10\x01\x12\x17\n" +
+	"\x13DIFF_FORMAT_UNIFIED\x10\x02*\xe8\x01\n" +
+	"\x0fConfigEventType\x12!\n" +
+	"\x1dCONFIG_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CONFIG_EVENT_TYPE_PUT\x10\x01\x12\x1e\n" +
+	"\x1aCONFIG_EVENT_TYPE_ROLLBACK\x10\x02\x12\x1c\n" +
+	"\x18CONFIG_EVENT_TYPE_DELETE\x10\x03\x12\x1d\n" +
+	"\x19CONFIG_EVENT_TYPE_RESTORE\x10\x04\x12\x1b\n" +
+	"\x17CONFIG_EVENT_TYPE_PURGE\x10\x05\x12\x1d\n" +
+	"\x19CONFIG_EVENT_TYPE_PROMOTE\x10\x062\xa1\x0f\n" +
+	"\x14ConfigurationService\x12C\n" +
+	"\x10PutConfiguration\x12\x1f.cms.v1.PutConfigurationRequest\x1a\x0e.cms.v1.Config\x12_\n" +
+	"\x15ValidateConfiguration\x12\x1f.cms.v1.PutConfigurationRequest\x1a%.cms.v1.ValidateConfigurationResponse\x12G\n" +
+	"\x12PatchConfiguration\x12!.cms.v1.PatchConfigurationRequest\x1a\x0e.cms.v1.Config\x12Q\n" +
+	"\x17PutConfigurationOverlay\x12&.cms.v1.PutConfigurationOverlayRequest\x1a\x0e.cms.v1.Config\x12O\n" +
+	"\x16PutConfigurationLabels\x12%.cms.v1.PutConfigurationLabelsRequest\x1a\x0e.cms.v1.Config\x12C\n" +
+	"\x10GetConfiguration\x12\x1f.cms.v1.GetConfigurationRequest\x1a\x0e.cms.v1.Config\x12a\n" +
+	"\x14WaitForConfiguration\x12#.cms.v1.WaitForConfigurationRequest\x1a$.cms.v1.WaitForConfigurationResponse\x12[\n" +
+	"\x12ListConfigurations\x12!.cms.v1.ListConfigurationsRequest\x1a\".cms.v1.ListConfigurationsResponse\x12p\n" +
+	"\x19ListConfigurationVersions\x12(.cms.v1.ListConfigurationVersionsRequest\x1a).cms.v1.ListConfigurationVersionsResponse\x12Q\n" +
+	"\x17GetConfigurationVersion\x12&.cms.v1.GetConfigurationVersionRequest\x1a\x0e.cms.v1.Config\x12[\n" +
+	"\x1cRollbackConfigurationVersion\x12+.cms.v1.RollbackConfigurationVersionRequest\x1a\x0e.cms.v1.Config\x12Y\n" +
+	"\x1bPromoteConfigurationVersion\x12*.cms.v1.PromoteConfigurationVersionRequest\x1a\x0e.cms.v1.Config\x12N\n" +
+	"\x16MoveConfigurationAlias\x12%.cms.v1.MoveConfigurationAliasRequest\x1a\r.cms.v1.Alias\x12P\n" +
+	"\x17ClearConfigurationAlias\x12&.cms.v1.ClearConfigurationAliasRequest\x1a\r.cms.v1.Alias\x12d\n" +
+	"\x15GetConfigurationAlias\x12$.cms.v1.GetConfigurationAliasRequest\x1a%.cms.v1.GetConfigurationAliasResponse\x12m\n" +
+	"\x18ListConfigurationAliases\x12'.cms.v1.ListConfigurationAliasesRequest\x1a(.cms.v1.ListConfigurationAliasesResponse\x12\x82\x01\n" +
+	"\x1fListConfigurationAliasRevisions\x12..cms.v1.ListConfigurationAliasRevisionsRequest\x1a/.cms.v1.ListConfigurationAliasRevisionsResponse\x12Y\n" +
+	"\x19DiffConfigurationVersions\x12(.cms.v1.DiffConfigurationVersionsRequest\x1a\x12.cms.v1.ConfigDiff\x12I\n" +
+	"\x13DeleteConfiguration\x12\".cms.v1.DeleteConfigurationRequest\x1a\x0e.cms.v1.Config\x12K\n" +
+	"\x14RestoreConfiguration\x12#.cms.v1.RestoreConfigurationRequest\x1a\x0e.cms.v1.Config\x12O\n" +
+	"\x12PurgeConfiguration\x12!.cms.v1.PurgeConfigurationRequest\x1a\x16.google.protobuf.Empty\x124\n" +
+	"\x05Watch\x12\x14.cms.v1.WatchRequest\x1a\x13.cms.v1.ConfigEvent0\x01BHZFgithub.com/arifMasnandar/go-config-management-service/api/cms/v1;cmsv1b\x06proto3"
+
+var (
+	file_cms_v1_configuration_proto_rawDescOnce sync.Once
+	file_cms_v1_configuration_proto_rawDescData []byte
+)
+
+func file_cms_v1_configuration_proto_rawDescGZIP() []byte {
+	file_cms_v1_configuration_proto_rawDescOnce.Do(func() {
+		file_cms_v1_configuration_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cms_v1_configuration_proto_rawDesc), len(file_cms_v1_configuration_proto_rawDesc)))
+	})
+	return file_cms_v1_configuration_proto_rawDescData
+}
+
+var file_cms_v1_configuration_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_cms_v1_configuration_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_cms_v1_configuration_proto_goTypes = []any{
+	(PatchType)(0),                                  // 0: cms.v1.PatchType
+	(SortField)(0),                                  // 1: cms.v1.SortField
+	(SortOrder)(0),                                  // 2: cms.v1.SortOrder
+	(DiffFormat)(0),                                 // 3: cms.v1.DiffFormat
+	(ConfigEventType)(0),                            // 4: cms.v1.ConfigEventType
+	(*Config)(nil),                                  // 5: cms.v1.Config
+	(*StringMap)(nil),                               // 6: cms.v1.StringMap
+	(*PutConfigurationRequest)(nil),                 // 7: cms.v1.PutConfigurationRequest
+	(*Violation)(nil),                               // 8: cms.v1.Violation
+	(*ValidateConfigurationResponse)(nil),           // 9: cms.v1.ValidateConfigurationResponse
+	(*PatchConfigurationRequest)(nil),               // 10: cms.v1.PatchConfigurationRequest
+	(*PutConfigurationOverlayRequest)(nil),          // 11: cms.v1.PutConfigurationOverlayRequest
+	(*PutConfigurationLabelsRequest)(nil),           // 12: cms.v1.PutConfigurationLabelsRequest
+	(*GetConfigurationRequest)(nil),                 // 13: cms.v1.GetConfigurationRequest
+	(*WaitForConfigurationRequest)(nil),             // 14: cms.v1.WaitForConfigurationRequest
+	(*WaitForConfigurationResponse)(nil),            // 15: cms.v1.WaitForConfigurationResponse
+	(*ListConfigurationsRequest)(nil),               // 16: cms.v1.ListConfigurationsRequest
+	(*ListConfigurationsResponse)(nil),              // 17: cms.v1.ListConfigurationsResponse
+	(*ListConfigurationVersionsRequest)(nil),        // 18: cms.v1.ListConfigurationVersionsRequest
+	(*ListConfigurationVersionsResponse)(nil),       // 19: cms.v1.ListConfigurationVersionsResponse
+	(*GetConfigurationVersionRequest)(nil),          // 20: cms.v1.GetConfigurationVersionRequest
+	(*RollbackConfigurationVersionRequest)(nil),     // 21: cms.v1.RollbackConfigurationVersionRequest
+	(*PromoteConfigurationVersionRequest)(nil),      // 22: cms.v1.PromoteConfigurationVersionRequest
+	(*Alias)(nil),                                   // 23: cms.v1.Alias
+	(*MoveConfigurationAliasRequest)(nil),           // 24: cms.v1.MoveConfigurationAliasRequest
+	(*ClearConfigurationAliasRequest)(nil),          // 25: cms.v1.ClearConfigurationAliasRequest
+	(*GetConfigurationAliasRequest)(nil),            // 26: cms.v1.GetConfigurationAliasRequest
+	(*GetConfigurationAliasResponse)(nil),           // 27: cms.v1.GetConfigurationAliasResponse
+	(*ListConfigurationAliasesRequest)(nil),         // 28: cms.v1.ListConfigurationAliasesRequest
+	(*ListConfigurationAliasesResponse)(nil),        // 29: cms.v1.ListConfigurationAliasesResponse
+	(*ListConfigurationAliasRevisionsRequest)(nil),  // 30: cms.v1.ListConfigurationAliasRevisionsRequest
+	(*ListConfigurationAliasRevisionsResponse)(nil), // 31: cms.v1.ListConfigurationAliasRevisionsResponse
+	(*DiffConfigurationVersionsRequest)(nil),        // 32: cms.v1.DiffConfigurationVersionsRequest
+	(*DiffOperation)(nil),                           // 33: cms.v1.DiffOperation
+	(*ConfigDiff)(nil),                              // 34: cms.v1.ConfigDiff
+	(*DeleteConfigurationRequest)(nil),              // 35: cms.v1.DeleteConfigurationRequest
+	(*RestoreConfigurationRequest)(nil),             // 36: cms.v1.RestoreConfigurationRequest
+	(*PurgeConfigurationRequest)(nil),               // 37: cms.v1.PurgeConfigurationRequest
+	(*WatchRequest)(nil),                            // 38: cms.v1.WatchRequest
+	(*ConfigEvent)(nil),                             // 39: cms.v1.ConfigEvent
+	nil,                                             // 40: cms.v1.Config.OverlaysEntry
+	nil,                                             // 41: cms.v1.Config.LabelsEntry
+	nil,                                             // 42: cms.v1.Config.AnnotationsEntry
+	nil,                                             // 43: cms.v1.StringMap.EntriesEntry
+	(*structpb.Struct)(nil),                         // 44: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                   // 45: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                     // 46: google.protobuf.Duration
+	(*structpb.Value)(nil),                          // 47: google.protobuf.Value
+	(*emptypb.Empty)(nil),                           // 48: google.protobuf.Empty
+}
+var file_cms_v1_configuration_proto_depIdxs = []int32{
+	44, // 0: cms.v1.Config.value:type_name -> google.protobuf.Struct
+	45, // 1: cms.v1.Config.created_at:type_name -> google.protobuf.Timestamp
+	40, // 2: cms.v1.Config.overlays:type_name -> cms.v1.Config.OverlaysEntry
+	41, // 3: cms.v1.Config.labels:type_name -> cms.v1.Config.LabelsEntry
+	42, // 4: cms.v1.Config.annotations:type_name -> cms.v1.Config.AnnotationsEntry
+	43, // 5: cms.v1.StringMap.entries:type_name -> cms.v1.StringMap.EntriesEntry
+	44, // 6: cms.v1.PutConfigurationRequest.value:type_name -> google.protobuf.Struct
+	6,  // 7: cms.v1.PutConfigurationRequest.labels:type_name -> cms.v1.StringMap
+	6,  // 8: cms.v1.PutConfigurationRequest.annotations:type_name -> cms.v1.StringMap
+	8,  // 9: cms.v1.ValidateConfigurationResponse.violations:type_name -> cms.v1.Violation
+	0,  // 10: cms.v1.PatchConfigurationRequest.patch_type:type_name -> cms.v1.PatchType
+	44, // 11: cms.v1.PutConfigurationOverlayRequest.overlay:type_name -> google.protobuf.Struct
+	6,  // 12: cms.v1.PutConfigurationLabelsRequest.labels:type_name -> cms.v1.StringMap
+	6,  // 13: cms.v1.PutConfigurationLabelsRequest.annotations:type_name -> cms.v1.StringMap
+	46, // 14: cms.v1.WaitForConfigurationRequest.timeout:type_name -> google.protobuf.Duration
+	5,  // 15: cms.v1.WaitForConfigurationResponse.config:type_name -> cms.v1.Config
+	1,  // 16: cms.v1.ListConfigurationsRequest.sort:type_name -> cms.v1.SortField
+	2,  // 17: cms.v1.ListConfigurationsRequest.order:type_name -> cms.v1.SortOrder
+	45, // 18: cms.v1.ListConfigurationsRequest.created_after:type_name -> google.protobuf.Timestamp
+	45, // 19: cms.v1.ListConfigurationsRequest.created_before:type_name -> google.protobuf.Timestamp
+	5,  // 20: cms.v1.ListConfigurationsResponse.configs:type_name -> cms.v1.Config
+	1,  // 21: cms.v1.ListConfigurationVersionsRequest.sort:type_name -> cms.v1.SortField
+	2,  // 22: cms.v1.ListConfigurationVersionsRequest.order:type_name -> cms.v1.SortOrder
+	5,  // 23: cms.v1.ListConfigurationVersionsResponse.configs:type_name -> cms.v1.Config
+	45, // 24: cms.v1.Alias.moved_at:type_name -> google.protobuf.Timestamp
+	23, // 25: cms.v1.GetConfigurationAliasResponse.alias:type_name -> cms.v1.Alias
+	5,  // 26: cms.v1.GetConfigurationAliasResponse.config:type_name -> cms.v1.Config
+	23, // 27: cms.v1.ListConfigurationAliasesResponse.aliases:type_name -> cms.v1.Alias
+	23, // 28: cms.v1.ListConfigurationAliasRevisionsResponse.revisions:type_name -> cms.v1.Alias
+	3,  // 29: cms.v1.DiffConfigurationVersionsRequest.format:type_name -> cms.v1.DiffFormat
+	47, // 30: cms.v1.DiffOperation.value:type_name -> google.protobuf.Value
+	33, // 31: cms.v1.ConfigDiff.operations:type_name -> cms.v1.DiffOperation
+	4,  // 32: cms.v1.ConfigEvent.type:type_name -> cms.v1.ConfigEventType
+	5,  // 33: cms.v1.ConfigEvent.config:type_name -> cms.v1.Config
+	44, // 34: cms.v1.Config.OverlaysEntry.value:type_name -> google.protobuf.Struct
+	7,  // 35: cms.v1.ConfigurationService.PutConfiguration:input_type -> cms.v1.PutConfigurationRequest
+	7,  // 36: cms.v1.ConfigurationService.ValidateConfiguration:input_type -> cms.v1.PutConfigurationRequest
+	10, // 37: cms.v1.ConfigurationService.PatchConfiguration:input_type -> cms.v1.PatchConfigurationRequest
+	11, // 38: cms.v1.ConfigurationService.PutConfigurationOverlay:input_type -> cms.v1.PutConfigurationOverlayRequest
+	12, // 39: cms.v1.ConfigurationService.PutConfigurationLabels:input_type -> cms.v1.PutConfigurationLabelsRequest
+	13, // 40: cms.v1.ConfigurationService.GetConfiguration:input_type -> cms.v1.GetConfigurationRequest
+	14, // 41: cms.v1.ConfigurationService.WaitForConfiguration:input_type -> cms.v1.WaitForConfigurationRequest
+	16, // 42: cms.v1.ConfigurationService.ListConfigurations:input_type -> cms.v1.ListConfigurationsRequest
+	18, // 43: cms.v1.ConfigurationService.ListConfigurationVersions:input_type -> cms.v1.ListConfigurationVersionsRequest
+	20, // 44: cms.v1.ConfigurationService.GetConfigurationVersion:input_type -> cms.v1.GetConfigurationVersionRequest
+	21, // 45: cms.v1.ConfigurationService.RollbackConfigurationVersion:input_type -> cms.v1.RollbackConfigurationVersionRequest
+	22, // 46: cms.v1.ConfigurationService.PromoteConfigurationVersion:input_type -> cms.v1.PromoteConfigurationVersionRequest
+	24, // 47: cms.v1.ConfigurationService.MoveConfigurationAlias:input_type -> cms.v1.MoveConfigurationAliasRequest
+	25, // 48: cms.v1.ConfigurationService.ClearConfigurationAlias:input_type -> cms.v1.ClearConfigurationAliasRequest
+	26, // 49: cms.v1.ConfigurationService.GetConfigurationAlias:input_type -> cms.v1.GetConfigurationAliasRequest
+	28, // 50: cms.v1.ConfigurationService.ListConfigurationAliases:input_type -> cms.v1.ListConfigurationAliasesRequest
+	30, // 51: cms.v1.ConfigurationService.ListConfigurationAliasRevisions:input_type -> cms.v1.ListConfigurationAliasRevisionsRequest
+	32, // 52: cms.v1.ConfigurationService.DiffConfigurationVersions:input_type -> cms.v1.DiffConfigurationVersionsRequest
+	35, // 53: cms.v1.ConfigurationService.DeleteConfiguration:input_type -> cms.v1.DeleteConfigurationRequest
+	36, // 54: cms.v1.ConfigurationService.RestoreConfiguration:input_type -> cms.v1.RestoreConfigurationRequest
+	37, // 55: cms.v1.ConfigurationService.PurgeConfiguration:input_type -> cms.v1.PurgeConfigurationRequest
+	38, // 56: cms.v1.ConfigurationService.Watch:input_type -> cms.v1.WatchRequest
+	5,  // 57: cms.v1.ConfigurationService.PutConfiguration:output_type -> cms.v1.Config
+	9,  // 58: cms.v1.ConfigurationService.ValidateConfiguration:output_type -> cms.v1.ValidateConfigurationResponse
+	5,  // 59: cms.v1.ConfigurationService.PatchConfiguration:output_type -> cms.v1.Config
+	5,  // 60: cms.v1.ConfigurationService.PutConfigurationOverlay:output_type -> cms.v1.Config
+	5,  // 61: cms.v1.ConfigurationService.PutConfigurationLabels:output_type -> cms.v1.Config
+	5,  // 62: cms.v1.ConfigurationService.GetConfiguration:output_type -> cms.v1.Config
+	15, // 63: cms.v1.ConfigurationService.WaitForConfiguration:output_type -> cms.v1.WaitForConfigurationResponse
+	17, // 64: cms.v1.ConfigurationService.ListConfigurations:output_type -> cms.v1.ListConfigurationsResponse
+	19, // 65: cms.v1.ConfigurationService.ListConfigurationVersions:output_type -> cms.v1.ListConfigurationVersionsResponse
+	5,  // 66: cms.v1.ConfigurationService.GetConfigurationVersion:output_type -> cms.v1.Config
+	5,  // 67: cms.v1.ConfigurationService.RollbackConfigurationVersion:output_type -> cms.v1.Config
+	5,  // 68: cms.v1.ConfigurationService.PromoteConfigurationVersion:output_type -> cms.v1.Config
+	23, // 69: cms.v1.ConfigurationService.MoveConfigurationAlias:output_type -> cms.v1.Alias
+	23, // 70: cms.v1.ConfigurationService.ClearConfigurationAlias:output_type -> cms.v1.Alias
+	27, // 71: cms.v1.ConfigurationService.GetConfigurationAlias:output_type -> cms.v1.GetConfigurationAliasResponse
+	29, // 72: cms.v1.ConfigurationService.ListConfigurationAliases:output_type -> cms.v1.ListConfigurationAliasesResponse
+	31, // 73: cms.v1.ConfigurationService.ListConfigurationAliasRevisions:output_type -> cms.v1.ListConfigurationAliasRevisionsResponse
+	34, // 74: cms.v1.ConfigurationService.DiffConfigurationVersions:output_type -> cms.v1.ConfigDiff
+	5,  // 75: cms.v1.ConfigurationService.DeleteConfiguration:output_type -> cms.v1.Config
+	5,  // 76: cms.v1.ConfigurationService.RestoreConfiguration:output_type -> cms.v1.Config
+	48, // 77: cms.v1.ConfigurationService.PurgeConfiguration:output_type -> google.protobuf.Empty
+	39, // 78: cms.v1.ConfigurationService.Watch:output_type -> cms.v1.ConfigEvent
+	57, // [57:79] is the sub-list for method output_type
+	35, // [35:57] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_cms_v1_configuration_proto_init() }
+func file_cms_v1_configuration_proto_init() {
+	if File_cms_v1_configuration_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cms_v1_configuration_proto_rawDesc), len(file_cms_v1_configuration_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   39,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cms_v1_configuration_proto_goTypes,
+		DependencyIndexes: file_cms_v1_configuration_proto_depIdxs,
+		EnumInfos:         file_cms_v1_configuration_proto_enumTypes,
+		MessageInfos:      file_cms_v1_configuration_proto_msgTypes,
+	}.Build()
+	File_cms_v1_configuration_proto = out.File
+	file_cms_v1_configuration_proto_goTypes = nil
+	file_cms_v1_configuration_proto_depIdxs = nil
+}
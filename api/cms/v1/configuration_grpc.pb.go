@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.33.0
+// source: cms/v1/configuration.proto
+
+package cmsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConfigurationService_PutConfiguration_FullMethodName                = "/cms.v1.ConfigurationService/PutConfiguration"
+	ConfigurationService_ValidateConfiguration_FullMethodName           = "/cms.v1.ConfigurationService/ValidateConfiguration"
+	ConfigurationService_PatchConfiguration_FullMethodName              = "/cms.v1.ConfigurationService/PatchConfiguration"
+	ConfigurationService_PutConfigurationOverlay_FullMethodName         = "/cms.v1.ConfigurationService/PutConfigurationOverlay"
+	ConfigurationService_PutConfigurationLabels_FullMethodName          = "/cms.v1.ConfigurationService/PutConfigurationLabels"
+	ConfigurationService_GetConfiguration_FullMethodName                = "/cms.v1.ConfigurationService/GetConfiguration"
+	ConfigurationService_WaitForConfiguration_FullMethodName            = "/cms.v1.ConfigurationService/WaitForConfiguration"
+	ConfigurationService_ListConfigurations_FullMethodName              = "/cms.v1.ConfigurationService/ListConfigurations"
+	ConfigurationService_ListConfigurationVersions_FullMethodName       = "/cms.v1.ConfigurationService/ListConfigurationVersions"
+	ConfigurationService_GetConfigurationVersion_FullMethodName         = "/cms.v1.ConfigurationService/GetConfigurationVersion"
+	ConfigurationService_RollbackConfigurationVersion_FullMethodName    = "/cms.v1.ConfigurationService/RollbackConfigurationVersion"
+	ConfigurationService_PromoteConfigurationVersion_FullMethodName     = "/cms.v1.ConfigurationService/PromoteConfigurationVersion"
+	ConfigurationService_MoveConfigurationAlias_FullMethodName          = "/cms.v1.ConfigurationService/MoveConfigurationAlias"
+	ConfigurationService_ClearConfigurationAlias_FullMethodName         = "/cms.v1.ConfigurationService/ClearConfigurationAlias"
+	ConfigurationService_GetConfigurationAlias_FullMethodName           = "/cms.v1.ConfigurationService/GetConfigurationAlias"
+	ConfigurationService_ListConfigurationAliases_FullMethodName        = "/cms.v1.ConfigurationService/ListConfigurationAliases"
+	ConfigurationService_ListConfigurationAliasRevisions_FullMethodName = "/cms.v1.ConfigurationService/ListConfigurationAliasRevisions"
+	ConfigurationService_DiffConfigurationVersions_FullMethodName       = "/cms.v1.ConfigurationService/DiffConfigurationVersions"
+	ConfigurationService_DeleteConfiguration_FullMethodName             = "/cms.v1.ConfigurationService/DeleteConfiguration"
+	ConfigurationService_RestoreConfiguration_FullMethodName            = "/cms.v1.ConfigurationService/RestoreConfiguration"
+	ConfigurationService_PurgeConfiguration_FullMethodName              = "/cms.v1.ConfigurationService/PurgeConfiguration"
+	ConfigurationService_Watch_FullMethodName                           = "/cms.v1.ConfigurationService/Watch"
+)
+
+// ConfigurationServiceClient is the client API for ConfigurationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConfigurationService manages versioned configurations, the same as the /cms/configs HTTP API.
+// Errors use the gRPC status code that corresponds to the HTTP status of the same error.
+type ConfigurationServiceClient interface {
+	// PutConfiguration creates a configuration or a new version of an existing one
+	PutConfiguration(ctx context.Context, in *PutConfigurationRequest, opts ...grpc.CallOption) (*Config, error)
+	// ValidateConfiguration runs every check of PutConfiguration without storing the configuration
+	ValidateConfiguration(ctx context.Context, in *PutConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
+	// PatchConfiguration creates a new version by applying a JSON Merge Patch or a JSON Patch to the latest value
+	PatchConfiguration(ctx context.Context, in *PatchConfigurationRequest, opts ...grpc.CallOption) (*Config, error)
+	// PutConfigurationOverlay creates a new version with another overlay of an environment over the latest value
+	PutConfigurationOverlay(ctx context.Context, in *PutConfigurationOverlayRequest, opts ...grpc.CallOption) (*Config, error)
+	// PutConfigurationLabels creates a new version with other labels or annotations and the latest value
+	PutConfigurationLabels(ctx context.Context, in *PutConfigurationLabelsRequest, opts ...grpc.CallOption) (*Config, error)
+	// GetConfiguration returns the latest version of a configuration
+	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*Config, error)
+	// WaitForConfiguration returns the latest version of a configuration once it is newer than after_version
+	WaitForConfiguration(ctx context.Context, in *WaitForConfigurationRequest, opts ...grpc.CallOption) (*WaitForConfigurationResponse, error)
+	// ListConfigurations returns the latest version of every configuration
+	ListConfigurations(ctx context.Context, in *ListConfigurationsRequest, opts ...grpc.CallOption) (*ListConfigurationsResponse, error)
+	// ListConfigurationVersions returns the versions of a configuration
+	ListConfigurationVersions(ctx context.Context, in *ListConfigurationVersionsRequest, opts ...grpc.CallOption) (*ListConfigurationVersionsResponse, error)
+	// GetConfigurationVersion returns a particular version of a configuration
+	GetConfigurationVersion(ctx context.Context, in *GetConfigurationVersionRequest, opts ...grpc.CallOption) (*Config, error)
+	// RollbackConfigurationVersion creates a new version with the value of an earlier one
+	RollbackConfigurationVersion(ctx context.Context, in *RollbackConfigurationVersionRequest, opts ...grpc.CallOption) (*Config, error)
+	// PromoteConfigurationVersion creates a new version with the overlay an environment had in an earlier version
+	// copied to another environment
+	PromoteConfigurationVersion(ctx context.Context, in *PromoteConfigurationVersionRequest, opts ...grpc.CallOption) (*Config, error)
+	// MoveConfigurationAlias points an alias such as stable or canary at a version of a configuration
+	MoveConfigurationAlias(ctx context.Context, in *MoveConfigurationAliasRequest, opts ...grpc.CallOption) (*Alias, error)
+	// ClearConfigurationAlias points an alias at no version
+	ClearConfigurationAlias(ctx context.Context, in *ClearConfigurationAliasRequest, opts ...grpc.CallOption) (*Alias, error)
+	// GetConfigurationAlias returns an alias of a configuration with the version it points at
+	GetConfigurationAlias(ctx context.Context, in *GetConfigurationAliasRequest, opts ...grpc.CallOption) (*GetConfigurationAliasResponse, error)
+	// ListConfigurationAliases returns the aliases of a configuration that point at a version
+	ListConfigurationAliases(ctx context.Context, in *ListConfigurationAliasesRequest, opts ...grpc.CallOption) (*ListConfigurationAliasesResponse, error)
+	// ListConfigurationAliasRevisions returns every move of an alias, oldest first
+	ListConfigurationAliasRevisions(ctx context.Context, in *ListConfigurationAliasRevisionsRequest, opts ...grpc.CallOption) (*ListConfigurationAliasRevisionsResponse, error)
+	// DiffConfigurationVersions returns the changes between two versions of a configuration
+	DiffConfigurationVersions(ctx context.Context, in *DiffConfigurationVersionsRequest, opts ...grpc.CallOption) (*ConfigDiff, error)
+	// DeleteConfiguration writes a tombstone version, the configuration can be restored until it is purged
+	DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*Config, error)
+	// RestoreConfiguration creates a new version with the value the configuration had before it was deleted
+	RestoreConfiguration(ctx context.Context, in *RestoreConfigurationRequest, opts ...grpc.CallOption) (*Config, error)
+	// PurgeConfiguration erases every version of a configuration
+	PurgeConfiguration(ctx context.Context, in *PurgeConfigurationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Watch streams the changes of configurations until the client cancels it. A client that resumes with
+	// last_revision receives the changes it missed, or OUT_OF_RANGE if they are no longer kept. The stream also
+	// ends with UNAVAILABLE when the client falls too far behind, it can resume from the last revision it received
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConfigEvent], error)
+}
+
+type configurationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigurationServiceClient(cc grpc.ClientConnInterface) ConfigurationServiceClient {
+	return &configurationServiceClient{cc}
+}
+
+func (c *configurationServiceClient) PutConfiguration(ctx context.Context, in *PutConfigurationRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_PutConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) ValidateConfiguration(ctx context.Context, in *PutConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_ValidateConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) PatchConfiguration(ctx context.Context, in *PatchConfigurationRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_PatchConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) PutConfigurationOverlay(ctx context.Context, in *PutConfigurationOverlayRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_PutConfigurationOverlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) PutConfigurationLabels(ctx context.Context, in *PutConfigurationLabelsRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_PutConfigurationLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_GetConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) WaitForConfiguration(ctx context.Context, in *WaitForConfigurationRequest, opts ...grpc.CallOption) (*WaitForConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForConfigurationResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_WaitForConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) ListConfigurations(ctx context.Context, in *ListConfigurationsRequest, opts ...grpc.CallOption) (*ListConfigurationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigurationsResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_ListConfigurations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) ListConfigurationVersions(ctx context.Context, in *ListConfigurationVersionsRequest, opts ...grpc.CallOption) (*ListConfigurationVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigurationVersionsResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_ListConfigurationVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) GetConfigurationVersion(ctx context.Context, in *GetConfigurationVersionRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_GetConfigurationVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) RollbackConfigurationVersion(ctx context.Context, in *RollbackConfigurationVersionRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_RollbackConfigurationVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) PromoteConfigurationVersion(ctx context.Context, in *PromoteConfigurationVersionRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_PromoteConfigurationVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) MoveConfigurationAlias(ctx context.Context, in *MoveConfigurationAliasRequest, opts ...grpc.CallOption) (*Alias, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alias)
+	err := c.cc.Invoke(ctx, ConfigurationService_MoveConfigurationAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) ClearConfigurationAlias(ctx context.Context, in *ClearConfigurationAliasRequest, opts ...grpc.CallOption) (*Alias, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alias)
+	err := c.cc.Invoke(ctx, ConfigurationService_ClearConfigurationAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) GetConfigurationAlias(ctx context.Context, in *GetConfigurationAliasRequest, opts ...grpc.CallOption) (*GetConfigurationAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigurationAliasResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_GetConfigurationAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) ListConfigurationAliases(ctx context.Context, in *ListConfigurationAliasesRequest, opts ...grpc.CallOption) (*ListConfigurationAliasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigurationAliasesResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_ListConfigurationAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) ListConfigurationAliasRevisions(ctx context.Context, in *ListConfigurationAliasRevisionsRequest, opts ...grpc.CallOption) (*ListConfigurationAliasRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigurationAliasRevisionsResponse)
+	err := c.cc.Invoke(ctx, ConfigurationService_ListConfigurationAliasRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) DiffConfigurationVersions(ctx context.Context, in *DiffConfigurationVersionsRequest, opts ...grpc.CallOption) (*ConfigDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigDiff)
+	err := c.cc.Invoke(ctx, ConfigurationService_DiffConfigurationVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_DeleteConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) RestoreConfiguration(ctx context.Context, in *RestoreConfigurationRequest, opts ...grpc.CallOption) (*Config, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Config)
+	err := c.cc.Invoke(ctx, ConfigurationService_RestoreConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) PurgeConfiguration(ctx context.Context, in *PurgeConfigurationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConfigurationService_PurgeConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConfigEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConfigurationService_ServiceDesc.Streams[0], ConfigurationService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, ConfigEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigurationService_WatchClient = grpc.ServerStreamingClient[ConfigEvent]
+
+// ConfigurationServiceServer is the server API for ConfigurationService service.
+// All implementations must embed UnimplementedConfigurationServiceServer
+// for forward compatibility.
+//
+// ConfigurationService manages versioned configurations, the same as the /cms/configs HTTP API.
+// Errors use the gRPC status code that corresponds to the HTTP status of the same error.
+type ConfigurationServiceServer interface {
+	// PutConfiguration creates a configuration or a new version of an existing one
+	PutConfiguration(context.Context, *PutConfigurationRequest) (*Config, error)
+	// ValidateConfiguration runs every check of PutConfiguration without storing the configuration
+	ValidateConfiguration(context.Context, *PutConfigurationRequest) (*ValidateConfigurationResponse, error)
+	// PatchConfiguration creates a new version by applying a JSON Merge Patch or a JSON Patch to the latest value
+	PatchConfiguration(context.Context, *PatchConfigurationRequest) (*Config, error)
+	// PutConfigurationOverlay creates a new version with another overlay of an environment over the latest value
+	PutConfigurationOverlay(context.Context, *PutConfigurationOverlayRequest) (*Config, error)
+	// PutConfigurationLabels creates a new version with other labels or annotations and the latest value
+	PutConfigurationLabels(context.Context, *PutConfigurationLabelsRequest) (*Config, error)
+	// GetConfiguration returns the latest version of a configuration
+	GetConfiguration(context.Context, *GetConfigurationRequest) (*Config, error)
+	// WaitForConfiguration returns the latest version of a configuration once it is newer than after_version
+	WaitForConfiguration(context.Context, *WaitForConfigurationRequest) (*WaitForConfigurationResponse, error)
+	// ListConfigurations returns the latest version of every configuration
+	ListConfigurations(context.Context, *ListConfigurationsRequest) (*ListConfigurationsResponse, error)
+	// ListConfigurationVersions returns the versions of a configuration
+	ListConfigurationVersions(context.Context, *ListConfigurationVersionsRequest) (*ListConfigurationVersionsResponse, error)
+	// GetConfigurationVersion returns a particular version of a configuration
+	GetConfigurationVersion(context.Context, *GetConfigurationVersionRequest) (*Config, error)
+	// RollbackConfigurationVersion creates a new version with the value of an earlier one
+	RollbackConfigurationVersion(context.Context, *RollbackConfigurationVersionRequest) (*Config, error)
+	// PromoteConfigurationVersion creates a new version with the overlay an environment had in an earlier version
+	// copied to another environment
+	PromoteConfigurationVersion(context.Context, *PromoteConfigurationVersionRequest) (*Config, error)
+	// MoveConfigurationAlias points an alias such as stable or canary at a version of a configuration
+	MoveConfigurationAlias(context.Context, *MoveConfigurationAliasRequest) (*Alias, error)
+	// ClearConfigurationAlias points an alias at no version
+	ClearConfigurationAlias(context.Context, *ClearConfigurationAliasRequest) (*Alias, error)
+	// GetConfigurationAlias returns an alias of a configuration with the version it points at
+	GetConfigurationAlias(context.Context, *GetConfigurationAliasRequest) (*GetConfigurationAliasResponse, error)
+	// ListConfigurationAliases returns the aliases of a configuration that point at a version
+	ListConfigurationAliases(context.Context, *ListConfigurationAliasesRequest) (*ListConfigurationAliasesResponse, error)
+	// ListConfigurationAliasRevisions returns every move of an alias, oldest first
+	ListConfigurationAliasRevisions(context.Context, *ListConfigurationAliasRevisionsRequest) (*ListConfigurationAliasRevisionsResponse, error)
+	// DiffConfigurationVersions returns the changes between two versions of a configuration
+	DiffConfigurationVersions(context.Context, *DiffConfigurationVersionsRequest) (*ConfigDiff, error)
+	// DeleteConfiguration writes a tombstone version, the configuration can be restored until it is purged
+	DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*Config, error)
+	// RestoreConfiguration creates a new version with the value the configuration had before it was deleted
+	RestoreConfiguration(context.Context, *RestoreConfigurationRequest) (*Config, error)
+	// PurgeConfiguration erases every version of a configuration
+	PurgeConfiguration(context.Context, *PurgeConfigurationRequest) (*emptypb.Empty, error)
+	// Watch streams the changes of configurations until the client cancels it. A client that resumes with
+	// last_revision receives the changes it missed, or OUT_OF_RANGE if they are no longer kept. The stream also
+	// ends with UNAVAILABLE when the client falls too far behind, it can resume from the last revision it received
+	Watch(*WatchRequest, grpc.ServerStreamingServer[ConfigEvent]) error
+	mustEmbedUnimplementedConfigurationServiceServer()
+}
+
+// UnimplementedConfigurationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConfigurationServiceServer struct{}
+
+func (UnimplementedConfigurationServiceServer) PutConfiguration(context.Context, *PutConfigurationRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) ValidateConfiguration(context.Context, *PutConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) PatchConfiguration(context.Context, *PatchConfigurationRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) PutConfigurationOverlay(context.Context, *PutConfigurationOverlayRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutConfigurationOverlay not implemented")
+}
+func (UnimplementedConfigurationServiceServer) PutConfigurationLabels(context.Context, *PutConfigurationLabelsRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutConfigurationLabels not implemented")
+}
+func (UnimplementedConfigurationServiceServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) WaitForConfiguration(context.Context, *WaitForConfigurationRequest) (*WaitForConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) ListConfigurations(context.Context, *ListConfigurationsRequest) (*ListConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigurations not implemented")
+}
+func (UnimplementedConfigurationServiceServer) ListConfigurationVersions(context.Context, *ListConfigurationVersionsRequest) (*ListConfigurationVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigurationVersions not implemented")
+}
+func (UnimplementedConfigurationServiceServer) GetConfigurationVersion(context.Context, *GetConfigurationVersionRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationVersion not implemented")
+}
+func (UnimplementedConfigurationServiceServer) RollbackConfigurationVersion(context.Context, *RollbackConfigurationVersionRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfigurationVersion not implemented")
+}
+func (UnimplementedConfigurationServiceServer) PromoteConfigurationVersion(context.Context, *PromoteConfigurationVersionRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteConfigurationVersion not implemented")
+}
+func (UnimplementedConfigurationServiceServer) MoveConfigurationAlias(context.Context, *MoveConfigurationAliasRequest) (*Alias, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveConfigurationAlias not implemented")
+}
+func (UnimplementedConfigurationServiceServer) ClearConfigurationAlias(context.Context, *ClearConfigurationAliasRequest) (*Alias, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearConfigurationAlias not implemented")
+}
+func (UnimplementedConfigurationServiceServer) GetConfigurationAlias(context.Context, *GetConfigurationAliasRequest) (*GetConfigurationAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationAlias not implemented")
+}
+func (UnimplementedConfigurationServiceServer) ListConfigurationAliases(context.Context, *ListConfigurationAliasesRequest) (*ListConfigurationAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigurationAliases not implemented")
+}
+func (UnimplementedConfigurationServiceServer) ListConfigurationAliasRevisions(context.Context, *ListConfigurationAliasRevisionsRequest) (*ListConfigurationAliasRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigurationAliasRevisions not implemented")
+}
+func (UnimplementedConfigurationServiceServer) DiffConfigurationVersions(context.Context, *DiffConfigurationVersionsRequest) (*ConfigDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfigurationVersions not implemented")
+}
+func (UnimplementedConfigurationServiceServer) DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) RestoreConfiguration(context.Context, *RestoreConfigurationRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) PurgeConfiguration(context.Context, *PurgeConfigurationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeConfiguration not implemented")
+}
+func (UnimplementedConfigurationServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[ConfigEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedConfigurationServiceServer) mustEmbedUnimplementedConfigurationServiceServer() {}
+func (UnimplementedConfigurationServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeConfigurationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigurationServiceServer will
+// result in compilation errors.
+type UnsafeConfigurationServiceServer interface {
+	mustEmbedUnimplementedConfigurationServiceServer()
+}
+
+func RegisterConfigurationServiceServer(s grpc.ServiceRegistrar, srv ConfigurationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConfigurationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConfigurationService_ServiceDesc, srv)
+}
+
+func _ConfigurationService_PutConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).PutConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_PutConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).PutConfiguration(ctx, req.(*PutConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ValidateConfiguration(ctx, req.(*PutConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_PatchConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).PatchConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_PatchConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).PatchConfiguration(ctx, req.(*PatchConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_PutConfigurationOverlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutConfigurationOverlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).PutConfigurationOverlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_PutConfigurationOverlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).PutConfigurationOverlay(ctx, req.(*PutConfigurationOverlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_PutConfigurationLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutConfigurationLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).PutConfigurationLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_PutConfigurationLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).PutConfigurationLabels(ctx, req.(*PutConfigurationLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).GetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_GetConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_WaitForConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).WaitForConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_WaitForConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).WaitForConfiguration(ctx, req.(*WaitForConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_ListConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ListConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_ListConfigurations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ListConfigurations(ctx, req.(*ListConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_ListConfigurationVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigurationVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ListConfigurationVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_ListConfigurationVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ListConfigurationVersions(ctx, req.(*ListConfigurationVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_GetConfigurationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).GetConfigurationVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_GetConfigurationVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).GetConfigurationVersion(ctx, req.(*GetConfigurationVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_RollbackConfigurationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigurationVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).RollbackConfigurationVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_RollbackConfigurationVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).RollbackConfigurationVersion(ctx, req.(*RollbackConfigurationVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_PromoteConfigurationVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteConfigurationVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).PromoteConfigurationVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_PromoteConfigurationVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).PromoteConfigurationVersion(ctx, req.(*PromoteConfigurationVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_MoveConfigurationAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveConfigurationAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).MoveConfigurationAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_MoveConfigurationAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).MoveConfigurationAlias(ctx, req.(*MoveConfigurationAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_ClearConfigurationAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearConfigurationAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ClearConfigurationAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_ClearConfigurationAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ClearConfigurationAlias(ctx, req.(*ClearConfigurationAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_GetConfigurationAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).GetConfigurationAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_GetConfigurationAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).GetConfigurationAlias(ctx, req.(*GetConfigurationAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_ListConfigurationAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigurationAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ListConfigurationAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_ListConfigurationAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ListConfigurationAliases(ctx, req.(*ListConfigurationAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_ListConfigurationAliasRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigurationAliasRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ListConfigurationAliasRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_ListConfigurationAliasRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ListConfigurationAliasRevisions(ctx, req.(*ListConfigurationAliasRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_DiffConfigurationVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfigurationVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).DiffConfigurationVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_DiffConfigurationVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).DiffConfigurationVersions(ctx, req.(*DiffConfigurationVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_DeleteConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).DeleteConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_DeleteConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).DeleteConfiguration(ctx, req.(*DeleteConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_RestoreConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).RestoreConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_RestoreConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).RestoreConfiguration(ctx, req.(*RestoreConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_PurgeConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).PurgeConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationService_PurgeConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).PurgeConfiguration(ctx, req.(*PurgeConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigurationServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, ConfigEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConfigurationService_WatchServer = grpc.ServerStreamingServer[ConfigEvent]
+
+// ConfigurationService_ServiceDesc is the grpc.ServiceDesc for ConfigurationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigurationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cms.v1.ConfigurationService",
+	HandlerType: (*ConfigurationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PutConfiguration",
+			Handler:    _ConfigurationService_PutConfiguration_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _ConfigurationService_ValidateConfiguration_Handler,
+		},
+		{
+			MethodName: "PatchConfiguration",
+			Handler:    _ConfigurationService_PatchConfiguration_Handler,
+		},
+		{
+			MethodName: "PutConfigurationOverlay",
+			Handler:    _ConfigurationService_PutConfigurationOverlay_Handler,
+		},
+		{
+			MethodName: "PutConfigurationLabels",
+			Handler:    _ConfigurationService_PutConfigurationLabels_Handler,
+		},
+		{
+			MethodName: "GetConfiguration",
+			Handler:    _ConfigurationService_GetConfiguration_Handler,
+		},
+		{
+			MethodName: "WaitForConfiguration",
+			Handler:    _ConfigurationService_WaitForConfiguration_Handler,
+		},
+		{
+			MethodName: "ListConfigurations",
+			Handler:    _ConfigurationService_ListConfigurations_Handler,
+		},
+		{
+			MethodName: "ListConfigurationVersions",
+			Handler:    _ConfigurationService_ListConfigurationVersions_Handler,
+		},
+		{
+			MethodName: "GetConfigurationVersion",
+			Handler:    _ConfigurationService_GetConfigurationVersion_Handler,
+		},
+		{
+			MethodName: "RollbackConfigurationVersion",
+			Handler:    _ConfigurationService_RollbackConfigurationVersion_Handler,
+		},
+		{
+			MethodName: "PromoteConfigurationVersion",
+			Handler:    _ConfigurationService_PromoteConfigurationVersion_Handler,
+		},
+		{
+			MethodName: "MoveConfigurationAlias",
+			Handler:    _ConfigurationService_MoveConfigurationAlias_Handler,
+		},
+		{
+			MethodName: "ClearConfigurationAlias",
+			Handler:    _ConfigurationService_ClearConfigurationAlias_Handler,
+		},
+		{
+			MethodName: "GetConfigurationAlias",
+			Handler:    _ConfigurationService_GetConfigurationAlias_Handler,
+		},
+		{
+			MethodName: "ListConfigurationAliases",
+			Handler:    _ConfigurationService_ListConfigurationAliases_Handler,
+		},
+		{
+			MethodName: "ListConfigurationAliasRevisions",
+			Handler:    _ConfigurationService_ListConfigurationAliasRevisions_Handler,
+		},
+		{
+			MethodName: "DiffConfigurationVersions",
+			Handler:    _ConfigurationService_DiffConfigurationVersions_Handler,
+		},
+		{
+			MethodName: "DeleteConfiguration",
+			Handler:    _ConfigurationService_DeleteConfiguration_Handler,
+		},
+		{
+			MethodName: "RestoreConfiguration",
+			Handler:    _ConfigurationService_RestoreConfiguration_Handler,
+		},
+		{
+			MethodName: "PurgeConfiguration",
+			Handler:    _ConfigurationService_PurgeConfiguration_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ConfigurationService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cms/v1/configuration.proto",
+}
@@ -1,18 +1,38 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	nethttp "net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/auth/jwt"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/auth/rbac"
 	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/handler/grpc"
 	"github.com/arifMasnandar/go-config-management-service/internal/adapter/handler/http"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/file"
 	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/memory"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/sqlite"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
 	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
 
 	_ "github.com/arifMasnandar/go-config-management-service/docs"
 )
 
+// shutdownTimeout is how long the servers wait for the requests in flight when the service is stopped
+const shutdownTimeout = 10 * time.Second
+
+// @securityDefinitions.apikey	BearerAuth
+// @in							header
+// @name						Authorization
+// @description				Type "Bearer" followed by a space and a JWT, or "ApiKey" followed by a space and an API key.
 func main() {
 	// Load environment variables
 	config, err := config.New()
@@ -21,27 +41,219 @@ func main() {
 		os.Exit(1)
 	}
 
-	configurationRepo := memory.NewConfigurationRepository()
-	configurationService := service.NewConfigurationService(configurationRepo)
+	// Init storage
+	var configurationRepo port.ConfigurationRepository
+	var schemaRepo port.SchemaRepository
+	var apiKeyRepo port.ApiKeyRepository
+	var namespaceRepo port.NamespaceRepository
+	var storage []io.Closer // Flushed and closed on shutdown
+	switch config.Storage.Driver {
+	case "", "memory":
+		configurationRepo = memory.NewConfigurationRepository()
+		schemaRepo = memory.NewSchemaRepository()
+		apiKeyRepo = memory.NewApiKeyRepository()
+		namespaceRepo = memory.NewNamespaceRepository()
+	case "file":
+		configurations, err := file.NewConfigurationRepository(config.Storage)
+		if err != nil {
+			slog.Error("Error opening file storage", "error", err)
+			os.Exit(1)
+		}
+		schemas, err := file.NewSchemaRepository(config.Storage)
+		if err != nil {
+			slog.Error("Error opening file storage", "error", err)
+			os.Exit(1)
+		}
+		apiKeys, err := file.NewApiKeyRepository(config.Storage)
+		if err != nil {
+			slog.Error("Error opening file storage", "error", err)
+			os.Exit(1)
+		}
+		namespaces, err := file.NewNamespaceRepository(config.Storage)
+		if err != nil {
+			slog.Error("Error opening file storage", "error", err)
+			os.Exit(1)
+		}
+		configurationRepo, schemaRepo, apiKeyRepo, namespaceRepo = configurations, schemas, apiKeys, namespaces
+		storage = append(storage, configurations, schemas, apiKeys, namespaces)
+	case "sqlite":
+		db, err := sqlite.Open(config.Storage)
+		if err != nil {
+			slog.Error("Error opening sqlite storage", "error", err)
+			os.Exit(1)
+		}
+		configurationRepo = sqlite.NewConfigurationRepository(db)
+		schemaRepo = sqlite.NewSchemaRepository(db)
+		apiKeyRepo = sqlite.NewApiKeyRepository(db)
+		namespaceRepo = sqlite.NewNamespaceRepository(db)
+		storage = append(storage, db)
+	default:
+		slog.Error("Unknown storage driver", "driver", config.Storage.Driver)
+		os.Exit(1)
+	}
+	slog.Info("Using storage driver", "driver", config.Storage.Driver)
+
+	// Init authentication
+	var tokenService port.TokenService
+	jwtConfigured := config.Auth.JWTSecret != "" || config.Auth.JWTPublicKeyFile != ""
+	bootstrapConfigured := config.Auth.BootstrapApiKeyHash != ""
+	switch {
+	case config.Auth.Disabled && (jwtConfigured || bootstrapConfigured):
+		slog.Error("AUTH_DISABLED can't be set along with a JWT secret or public key, or a bootstrap API key")
+		os.Exit(1)
+	case config.Auth.Disabled:
+		slog.Warn("Authentication is disabled, requests are not authenticated")
+	case jwtConfigured:
+		tokenService, err = jwt.NewTokenService(config.Auth)
+		if err != nil {
+			slog.Error("Error initializing token service", "error", err)
+			os.Exit(1)
+		}
+	case bootstrapConfigured:
+		slog.Info("No JWT secret or public key is configured, only API keys are accepted")
+	default:
+		slog.Error("No JWT secret, public key or bootstrap API key is configured, set AUTH_DISABLED=true to serve requests without authentication")
+		os.Exit(1)
+	}
+
+	// Watchers that reconnect can resume from any of the latest 1000 changes
+	events := service.NewEventBus(1000)
+	// The schema service holds the locks of the configuration writes while it checks the configurations of a type
+	locks := service.NewWriteLocks()
+
+	configurationService := service.NewConfigurationService(configurationRepo, namespaceRepo, schemaRepo, events, locks)
+	var watchService service.WatchServicer = events
+	apiKeyService := service.NewApiKeyService(apiKeyRepo)
+	namespaceService := service.NewNamespaceService(namespaceRepo, configurationRepo)
+	schemaService := service.NewSchemaService(schemaRepo, configurationRepo, locks)
+
+	// The bootstrap API key lets an administrator create the other keys, even if no JWT is ever accepted
+	if bootstrapConfigured {
+		if _, err := apiKeyService.BootstrapApiKey(context.Background(), config.Auth.BootstrapApiKeyHash); err != nil {
+			slog.Error("Error creating the bootstrap API key", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Check the roles bound to callers, if a policy is configured. Roles are bound to authenticated callers only
+	if config.Auth.RBACPolicyFile != "" {
+		if config.Auth.Disabled {
+			slog.Error("AUTH_RBAC_POLICY_FILE can't be set along with AUTH_DISABLED, roles are bound to authenticated callers")
+			os.Exit(1)
+		}
+
+		bindings, err := rbac.LoadRoleBindings(config.Auth)
+		if err != nil {
+			slog.Error("Error loading role bindings", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("Enforcing role bindings", "bindings", len(bindings))
+
+		authorizer := service.NewAuthorizer(bindings)
+		configurationService = service.NewAuthorizedConfigurationService(configurationService, authorizer)
+		watchService = service.NewAuthorizedWatchService(watchService, authorizer)
+		apiKeyService = service.NewAuthorizedApiKeyService(apiKeyService, authorizer)
+		namespaceService = service.NewAuthorizedNamespaceService(namespaceService, authorizer)
+		schemaService = service.NewAuthorizedSchemaService(schemaService, authorizer)
+	}
+
+	// The API keys are a source of credentials as well, the handlers only go without one when authentication is disabled
+	var credentials service.ApiKeyServicer
+	if !config.Auth.Disabled {
+		credentials = apiKeyService
+	}
+
 	configurationHandler := http.NewConfigurationHandler(configurationService)
 
+	schemaHandler := http.NewSchemaHandler(schemaService)
+
+	watchHandler := http.NewWatchHandler(watchService)
+
+	apiKeyHandler := http.NewApiKeyHandler(apiKeyService)
+
+	namespaceHandler := http.NewNamespaceHandler(namespaceService)
+
 	// Init router
 	router, err := http.NewRouter(
 		config.HTTP,
+		tokenService,
+		credentials,
 		*configurationHandler,
+		*schemaHandler,
+		*watchHandler,
+		*apiKeyHandler,
+		*namespaceHandler,
 	)
 	if err != nil {
 		slog.Error("Error initializing router", "error", err)
 		os.Exit(1)
 	}
 
+	// Stop on an interrupt or a termination request, such as from docker stop
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start the gRPC server alongside, sharing the services so that watchers see the changes made through either API
+	var grpcServer *grpc.Server
+	if config.GRPC.Port != "" {
+		grpcConfigurationHandler := grpc.NewConfigurationHandler(configurationService, watchService)
+
+		grpcServer, err = grpc.NewServer(
+			config.GRPC,
+			tokenService,
+			credentials,
+			grpcConfigurationHandler,
+		)
+		if err != nil {
+			slog.Error("Error initializing gRPC server", "error", err)
+			os.Exit(1)
+		}
+
+		grpcListenAddr := fmt.Sprintf("%s:%s", config.GRPC.URL, config.GRPC.Port)
+		slog.Info("Starting the gRPC server", "listen_address", grpcListenAddr)
+		go func() {
+			if err := grpcServer.Serve(grpcListenAddr); err != nil {
+				slog.Error("Error starting the gRPC server", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// Start server
 	listenAddr := fmt.Sprintf("%s:%s", config.HTTP.URL, config.HTTP.Port)
 	slog.Info("Starting the HTTP server", "listen_address", listenAddr)
-	err = router.Serve(listenAddr)
-	if err != nil {
-		slog.Error("Error starting the HTTP server", "error", err)
-		os.Exit(1)
+	go func() {
+		if err := router.Serve(listenAddr); err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
+			slog.Error("Error starting the HTTP server", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-ctx.Done()
+	stop() // A second signal stops the process right away
+	slog.Info("Shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := router.Shutdown(shutdownCtx); err != nil {
+		slog.Error("Error shutting down the HTTP server", "error", err)
+	}
+	if grpcServer != nil {
+		if err := grpcServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Error shutting down the gRPC server", "error", err)
+		}
 	}
 
+	// Closing the storage flushes the records the sync policy hasn't synced yet
+	failed := false
+	for _, closer := range storage {
+		if err := closer.Close(); err != nil {
+			slog.Error("Error closing storage", "error", err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
 }
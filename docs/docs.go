@@ -15,14 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/cms/configs": {
+        "/cms/admin/apikeys": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a list configurations with pagination support.",
+                "description": "Retrieve a list of API keys, oldest first, with pagination support. Revoked keys are listed too, the keys themselves never are.",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,9 +30,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Configurations"
+                    "Admin"
                 ],
-                "summary": "Retrieve configuration list",
+                "summary": "Retrieve API key list",
                 "parameters": [
                     {
                         "type": "integer",
@@ -50,9 +50,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration found",
+                        "description": "API keys found",
                         "schema": {
-                            "$ref": "#/definitions/http.configurationResponse"
+                            "$ref": "#/definitions/http.apiKeyResponse"
                         }
                     },
                     "400": {
@@ -73,14 +73,63 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a long-lived API key for a machine client, such as a CI job. The key is only returned by this request, keep it safe.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Create an API key",
+                "parameters": [
+                    {
+                        "description": "API key to create",
+                        "name": "createApiKeyRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createApiKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "API key created",
+                        "schema": {
+                            "$ref": "#/definitions/http.apiKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -94,14 +143,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cms/configs/{name}": {
-            "get": {
+        "/cms/admin/apikeys/{id}/revoke": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve the latest version of a configuration by its name",
+                "description": "Revoke an API key for good, e.g. when it leaked. Requests with the key are rejected from now on.",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,23 +158,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Configurations"
+                    "Admin"
                 ],
-                "summary": "Retrieve the latest version of a configuration",
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Configuration name",
-                        "name": "name",
+                        "description": "API key ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration found",
+                        "description": "API key revoked",
                         "schema": {
-                            "$ref": "#/definitions/http.configurationResponse"
+                            "$ref": "#/definitions/http.apiKeyResponse"
                         }
                     },
                     "400": {
@@ -153,7 +202,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Data conflict error",
+                        "description": "API key revoked error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -165,14 +214,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/cms/admin/apikeys/{id}/rotate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new configuration with the specified name and value, or replace an existing",
+                "description": "Replace the secret of an API key, keeping its name, scopes and expiry. The old key stops working at once, the new one is only returned by this request.",
                 "consumes": [
                     "application/json"
                 ],
@@ -180,32 +231,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Configurations"
+                    "Admin"
                 ],
-                "summary": "Create a new configuration or replace an existing one",
+                "summary": "Rotate an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Configuration name",
-                        "name": "name",
+                        "description": "API key ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Create or Replace Configuration request",
-                        "name": "createCategoryRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.putConfigurationRequestJson"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration created",
+                        "description": "API key rotated",
                         "schema": {
-                            "$ref": "#/definitions/http.configurationResponse"
+                            "$ref": "#/definitions/http.apiKeyResponse"
                         }
                     },
                     "400": {
@@ -233,7 +275,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Data conflict error",
+                        "description": "API key revoked error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -247,14 +289,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cms/configs/{name}/versions": {
-            "get": {
+        "/cms/admin/configs/{name}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a historical version list of a configuration by its name",
+                "description": "Erase every version of a configuration, deleted or not, e.g. for a data retention request. This can't be undone.",
                 "consumes": [
                     "application/json"
                 ],
@@ -262,9 +304,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Configurations"
+                    "Admin"
                 ],
-                "summary": "Retrieve a historical version list of a configuration",
+                "summary": "Permanently erase a configuration",
                 "parameters": [
                     {
                         "type": "string",
@@ -272,26 +314,86 @@ const docTemplate = `{
                         "name": "name",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration purged",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/admin/namespaces/{ns}/configs/{name}": {
+            "delete": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "Starting offset",
-                        "name": "skip",
-                        "in": "query"
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Erase every version of a configuration, deleted or not, e.g. for a data retention request. This can't be undone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Permanently erase a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
                     },
                     {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "limit",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration found",
+                        "description": "Configuration purged",
                         "schema": {
-                            "$ref": "#/definitions/http.configurationResponse"
+                            "$ref": "#/definitions/http.response"
                         }
                     },
                     "400": {
@@ -318,12 +420,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -333,14 +429,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cms/configs/{name}/versions/{version}": {
+        "/cms/configs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a particular version of a configuration by its name and version number",
+                "description": "Retrieve a list configurations with pagination support.\nThe list is sorted by name, created_at or version of the latest version, in asc or desc order, ties are broken by name.\nA page that isn't the last returns a next_cursor in its meta, which is passed as cursor with the same sort and order to get the next page.\nThe filters limit the list to the configurations whose latest version passes all of them. A where predicate compares a field of the value,\naddressed by a path such as value.limits[0].max, with a JSON literal using ==, !=, \u003c, \u003c=, \u003e or \u003e=, e.g. value.region == \"eu\".\nA selector selects by labels with comma separated requirements: key=value, key!=value, key in (values), key notin (values), key and !key.",
                 "consumes": [
                     "application/json"
                 ],
@@ -350,21 +446,84 @@ const docTemplate = `{
                 "tags": [
                     "Configurations"
                 ],
-                "summary": "Retrieve a particular version of a configuration",
+                "summary": "Retrieve configuration list",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Configuration name",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Starting offset, after the cursor",
+                        "name": "skip",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Version Number",
-                        "name": "version",
-                        "in": "path",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, created_at or version",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prefix of the name",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glob of the name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the latest version is written after",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the latest version is written before",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Predicates on the value",
+                        "name": "where",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector",
+                        "name": "selector",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -413,14 +572,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cms/configs/{name}/versions/{version}/rollback": {
-            "post": {
+        "/cms/configs/{name}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Rollback a configuration to a previous version",
+                "description": "Retrieve the latest version of a configuration by its name.\nWith wait_for_version_gt the request long-polls: it waits until a version newer than the given one exists, or responds with 304 once the timeout elapses.\nWith env the value is the one the environment sees, the base value with the overlay of the environment merged over it.\nWith alias the version the alias points at is returned instead of the latest one, it can't be combined with wait_for_version_gt.",
                 "consumes": [
                     "application/json"
                 ],
@@ -430,7 +589,7 @@ const docTemplate = `{
                 "tags": [
                     "Configurations"
                 ],
-                "summary": "Rollback a configuration to a previous version",
+                "summary": "Retrieve the latest version of a configuration",
                 "parameters": [
                     {
                         "type": "string",
@@ -441,19 +600,45 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Version Number",
-                        "name": "version",
-                        "in": "path",
-                        "required": true
+                        "description": "Wait for a version newer than this one",
+                        "name": "wait_for_version_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How long to wait, from 1s to 5m, default 30s",
+                        "name": "timeout",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment whose effective value is returned",
+                        "name": "env",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias whose version is returned",
+                        "name": "alias",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Configuration rolled back",
+                        "description": "Configuration found",
                         "schema": {
                             "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the configuration, to send in If-Match when replacing it, left out when read through an alias"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "No newer version before the timeout"
+                    },
                     "400": {
                         "description": "Validation error",
                         "schema": {
@@ -491,27 +676,4622 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "http.configurationResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new configuration with the specified name and value, or replace an existing.\nSend the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.\nWith dry_run=true the request is only validated, and the response is the same as the validate endpoint's.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Create a new configuration or replace an existing one",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate without storing",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration changes",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create or Replace Configuration request",
+                        "name": "createCategoryRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration created",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a configuration by writing a tombstone version. The configuration is left out of listings and can't be retrieved,\nbut its version history is kept and it can be restored. Send the ETag of the latest version in the If-Match header to fail with 409 if someone else has written a newer version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Delete a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being deleted",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration is deleted",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration deleted, the tombstone version",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the tombstone"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply a JSON Merge Patch (RFC 7396, Content-Type application/merge-patch+json) or a JSON Patch (RFC 6902, Content-Type application/json-patch+json)\nto the value of the latest version of a configuration, validate the result against the schema of its type and store it as a new version.\nSend the ETag of the version being patched in the If-Match header to fail with 409 if someone else has written a newer version. A failed JSON Patch test operation is also a 409.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Update the value of a configuration with a patch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration changes",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Merge patch object or JSON Patch operations array",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration patched",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch type error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/aliases": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the aliases of a configuration that point at a version, ordered by alias.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve the aliases of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Aliases found",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/aliases/{alias}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an alias of a configuration with the version it points at.\nWith env the value is the one the environment saw in that version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Resolve an alias of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment whose effective value is returned",
+                        "name": "env",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias found",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Revision of the alias, to send in If-Match when moving it"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Point an alias such as stable or canary at a version of a configuration, creating the alias if it doesn't exist. Every move is kept as a revision of the alias, recording who moved it and why.\nAliases are 1 to 63 lowercase letters, digits, '-' or '_', starting and ending with a letter or digit.\nSend the ETag of the revision being replaced in the If-Match header, or its number in expected_revision, to fail with 409 if someone else has moved the alias since.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Move an alias of a configuration to a version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the revision being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the alias is moved",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Alias request",
+                        "name": "aliasRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.moveConfigurationAliasRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias moved",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Revision of the alias"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Point an alias at no version, the clearing is kept as a revision of the alias like a move.\nSend the ETag of the revision being replaced in the If-Match header to fail with 409 if someone else has moved the alias since.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Clear an alias of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the revision being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the alias is cleared",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias cleared",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Revision of the alias"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/aliases/{alias}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every move of an alias of a configuration, oldest first, including those that cleared it, with who moved it and why.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve the revision history of an alias of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Starting offset",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias revisions found",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare the value and type of two versions of a configuration, e.g. to review a rollback before making it.\nWith format=patch (the default) the changes of the value are the RFC 6902 JSON Patch operations that turn the from version into the to version,\nwith format=unified they are a unified diff of the two values.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Compare two versions of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number to compare from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number to compare to, defaults to the latest",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "patch or unified",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration versions compared",
+                        "schema": {
+                            "$ref": "#/definitions/http.diffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/environments/{env}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the overlay of an environment on the latest version of a configuration and store the result as a new version.\nThe overlay is a JSON Merge Patch (RFC 7396) over the base value, the environment sees the merged value, which is validated against the schema of the type. An empty overlay removes it.\nSend the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Replace the overlay of an environment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment name",
+                        "name": "env",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the overlay changes",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Overlay request",
+                        "name": "overlayRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationOverlayRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Overlay replaced",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/labels": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the labels and the annotations of the latest version of a configuration and store the result as a new version with the same value.\nLabel keys are a name of up to 63 letters, digits, '-', '_' or '.', optionally prefixed by a DNS subdomain and '/', and values are such a name or empty. Annotation values are free-form.\nSend the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Replace the labels and annotations of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the labels change",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Labels request",
+                        "name": "labelsRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationLabelsRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Labels replaced",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a deleted configuration by copying the version before its tombstone as a new version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Restore a deleted configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration is restored",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration restored",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the restored configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Configuration is not deleted error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "The version before the tombstone no longer matches the latest schema, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/validate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Run every check of creating or replacing a configuration (schema lookup, value validation and the If-Match or expected_version check) without storing it.\nA value that doesn't match its schema is reported with valid=false and its violations, a valid one with the version that would be created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Validate a configuration without storing it",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create or Replace Configuration request",
+                        "name": "validateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation result",
+                        "schema": {
+                            "$ref": "#/definitions/http.validationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a historical version list of a configuration by its name.\nWith created_by only the versions written by that caller are listed: the sub claim of a user, or api_key:\u003cid\u003e for an API key.\nThe versions are sorted by name, created_at or version, which all list them in version order, asc or desc.\nA page that isn't the last returns a next_cursor in its meta, which is passed as cursor with the same sort and order to get the next page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve a historical version list of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Starting offset, after the cursor",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Author of the versions",
+                        "name": "created_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, created_at or version",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration found",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/versions/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a particular version of a configuration by its name and version number.\nWith env the value is the one the environment saw in that version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve a particular version of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment whose effective value is returned",
+                        "name": "env",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration found",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/versions/{version}/promote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy the overlay an environment had in a version of a configuration to another environment, and store the result as a new version\nthat records the environment and the version it was promoted from. The base value is shared by every environment, so it isn't copied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Promote a version of a configuration to another environment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the version is promoted",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Promotion request",
+                        "name": "promoteRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.promoteConfigurationVersionRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration promoted",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the promoted configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/configs/{name}/versions/{version}/rollback": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rollback a configuration to a previous version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Rollback a configuration to a previous version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration is rolled back",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the rolled back configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "The version no longer matches the latest schema, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of namespaces, ordered by name, with pagination support.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Retrieve namespace list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Starting offset",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespaces found",
+                        "schema": {
+                            "$ref": "#/definitions/http.namespaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a namespace to group configurations, whose names are unique within it. A name is made of lowercase letters, digits, '-' and '_', up to 63 characters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Create a namespace",
+                "parameters": [
+                    {
+                        "description": "Namespace to create",
+                        "name": "createNamespaceRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createNamespaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace created",
+                        "schema": {
+                            "$ref": "#/definitions/http.namespaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a namespace and its metadata by its name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Retrieve a namespace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace",
+                        "name": "ns",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace found",
+                        "schema": {
+                            "$ref": "#/definitions/http.namespaceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a namespace that holds no configurations, delete or purge them first. The default namespace can't be deleted.\nThe version history of the configurations deleted in the namespace is kept, they can be restored if a namespace of the same name is created again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Namespaces"
+                ],
+                "summary": "Delete a namespace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace",
+                        "name": "ns",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Namespace deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Namespace is not empty or is the default namespace error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list configurations with pagination support.\nThe list is sorted by name, created_at or version of the latest version, in asc or desc order, ties are broken by name.\nA page that isn't the last returns a next_cursor in its meta, which is passed as cursor with the same sort and order to get the next page.\nThe filters limit the list to the configurations whose latest version passes all of them. A where predicate compares a field of the value,\naddressed by a path such as value.limits[0].max, with a JSON literal using ==, !=, \u003c, \u003c=, \u003e or \u003e=, e.g. value.region == \"eu\".\nA selector selects by labels with comma separated requirements: key=value, key!=value, key in (values), key notin (values), key and !key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve configuration list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Starting offset, after the cursor",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, created_at or version",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prefix of the name",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Glob of the name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the latest version is written after",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time the latest version is written before",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Predicates on the value",
+                        "name": "where",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label selector",
+                        "name": "selector",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration found",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the latest version of a configuration by its name.\nWith wait_for_version_gt the request long-polls: it waits until a version newer than the given one exists, or responds with 304 once the timeout elapses.\nWith env the value is the one the environment sees, the base value with the overlay of the environment merged over it.\nWith alias the version the alias points at is returned instead of the latest one, it can't be combined with wait_for_version_gt.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve the latest version of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Wait for a version newer than this one",
+                        "name": "wait_for_version_gt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How long to wait, from 1s to 5m, default 30s",
+                        "name": "timeout",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment whose effective value is returned",
+                        "name": "env",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias whose version is returned",
+                        "name": "alias",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration found",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the configuration, to send in If-Match when replacing it, left out when read through an alias"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "No newer version before the timeout"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new configuration with the specified name and value, or replace an existing.\nSend the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.\nWith dry_run=true the request is only validated, and the response is the same as the validate endpoint's.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Create a new configuration or replace an existing one",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate without storing",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration changes",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create or Replace Configuration request",
+                        "name": "createCategoryRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration created",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a configuration by writing a tombstone version. The configuration is left out of listings and can't be retrieved,\nbut its version history is kept and it can be restored. Send the ETag of the latest version in the If-Match header to fail with 409 if someone else has written a newer version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Delete a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being deleted",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration is deleted",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration deleted, the tombstone version",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the tombstone"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply a JSON Merge Patch (RFC 7396, Content-Type application/merge-patch+json) or a JSON Patch (RFC 6902, Content-Type application/json-patch+json)\nto the value of the latest version of a configuration, validate the result against the schema of its type and store it as a new version.\nSend the ETag of the version being patched in the If-Match header to fail with 409 if someone else has written a newer version. A failed JSON Patch test operation is also a 409.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Update the value of a configuration with a patch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration changes",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Merge patch object or JSON Patch operations array",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration patched",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported patch type error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/aliases": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the aliases of a configuration that point at a version, ordered by alias.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve the aliases of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Aliases found",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/aliases/{alias}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve an alias of a configuration with the version it points at.\nWith env the value is the one the environment saw in that version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Resolve an alias of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment whose effective value is returned",
+                        "name": "env",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias found",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Revision of the alias, to send in If-Match when moving it"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Point an alias such as stable or canary at a version of a configuration, creating the alias if it doesn't exist. Every move is kept as a revision of the alias, recording who moved it and why.\nAliases are 1 to 63 lowercase letters, digits, '-' or '_', starting and ending with a letter or digit.\nSend the ETag of the revision being replaced in the If-Match header, or its number in expected_revision, to fail with 409 if someone else has moved the alias since.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Move an alias of a configuration to a version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the revision being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the alias is moved",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Alias request",
+                        "name": "aliasRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.moveConfigurationAliasRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias moved",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Revision of the alias"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Point an alias at no version, the clearing is kept as a revision of the alias like a move.\nSend the ETag of the revision being replaced in the If-Match header to fail with 409 if someone else has moved the alias since.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Clear an alias of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the revision being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the alias is cleared",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias cleared",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Revision of the alias"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/aliases/{alias}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every move of an alias of a configuration, oldest first, including those that cleared it, with who moved it and why.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve the revision history of an alias of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias name",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Starting offset",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Alias revisions found",
+                        "schema": {
+                            "$ref": "#/definitions/http.aliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare the value and type of two versions of a configuration, e.g. to review a rollback before making it.\nWith format=patch (the default) the changes of the value are the RFC 6902 JSON Patch operations that turn the from version into the to version,\nwith format=unified they are a unified diff of the two values.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Compare two versions of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number to compare from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number to compare to, defaults to the latest",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "patch or unified",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration versions compared",
+                        "schema": {
+                            "$ref": "#/definitions/http.diffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/environments/{env}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the overlay of an environment on the latest version of a configuration and store the result as a new version.\nThe overlay is a JSON Merge Patch (RFC 7396) over the base value, the environment sees the merged value, which is validated against the schema of the type. An empty overlay removes it.\nSend the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Replace the overlay of an environment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment name",
+                        "name": "env",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the overlay changes",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Overlay request",
+                        "name": "overlayRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationOverlayRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Overlay replaced",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/labels": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the labels and the annotations of the latest version of a configuration and store the result as a new version with the same value.\nLabel keys are a name of up to 63 letters, digits, '-', '_' or '.', optionally prefixed by a DNS subdomain and '/', and values are such a name or empty. Annotation values are free-form.\nSend the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Replace the labels and annotations of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the labels change",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Labels request",
+                        "name": "labelsRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationLabelsRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Labels replaced",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the created configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore a deleted configuration by copying the version before its tombstone as a new version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Restore a deleted configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration is restored",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration restored",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the restored configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Configuration is not deleted error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "The version before the tombstone no longer matches the latest schema, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/validate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Run every check of creating or replacing a configuration (schema lookup, value validation and the If-Match or expected_version check) without storing it.\nA value that doesn't match its schema is reported with valid=false and its violations, a valid one with the version that would be created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Validate a configuration without storing it",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create or Replace Configuration request",
+                        "name": "validateRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putConfigurationRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation result",
+                        "schema": {
+                            "$ref": "#/definitions/http.validationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a historical version list of a configuration by its name.\nWith created_by only the versions written by that caller are listed: the sub claim of a user, or api_key:\u003cid\u003e for an API key.\nThe versions are sorted by name, created_at or version, which all list them in version order, asc or desc.\nA page that isn't the last returns a next_cursor in its meta, which is passed as cursor with the same sort and order to get the next page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve a historical version list of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Starting offset, after the cursor",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Author of the versions",
+                        "name": "created_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name, created_at or version",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration found",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/versions/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a particular version of a configuration by its name and version number.\nWith env the value is the one the environment saw in that version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Retrieve a particular version of a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment whose effective value is returned",
+                        "name": "env",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration found",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/versions/{version}/promote": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy the overlay an environment had in a version of a configuration to another environment, and store the result as a new version\nthat records the environment and the version it was promoted from. The base value is shared by every environment, so it isn't copied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Promote a version of a configuration to another environment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the version is promoted",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Promotion request",
+                        "name": "promoteRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.promoteConfigurationVersionRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration promoted",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the promoted configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Schema validation error, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/namespaces/{ns}/configs/{name}/versions/{version}/rollback": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rollback a configuration to a previous version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configurations"
+                ],
+                "summary": "Rollback a configuration to a previous version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace, the default namespace on the routes without one",
+                        "name": "ns",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Why the configuration is rolled back",
+                        "name": "X-Change-Message",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Configuration rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the rolled back configuration"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "The version no longer matches the latest schema, details lists every violation",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/schemas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of the registered schemas ordered by type, with pagination support.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schemas"
+                ],
+                "summary": "Retrieve the registered config types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Starting offset",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schemas found",
+                        "schema": {
+                            "$ref": "#/definitions/http.schemaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/schemas/{type}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the latest version of the JSON schema registered for a config type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schemas"
+                ],
+                "summary": "Retrieve the latest schema version of a config type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema found",
+                        "schema": {
+                            "$ref": "#/definitions/http.schemaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register the JSON schema that validates the value of configurations of a type, or add a new version to the schema of an existing type.\nA new version must follow the compatibility mode (none, backward, forward or full) with respect to the previous version,\nand the latest version of every configuration of the type must match it. Otherwise it is rejected with 409 and a report in details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schemas"
+                ],
+                "summary": "Register a config type or a new version of its schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Register or Replace Schema request",
+                        "name": "putSchemaRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.putSchemaRequestJson"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema registered",
+                        "schema": {
+                            "$ref": "#/definitions/http.schemaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Incompatible schema error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete every version of the schema of a config type. A type can't be deleted while the latest version of a configuration uses it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schemas"
+                ],
+                "summary": "Unregister a config type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Schema in use error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/schemas/{type}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the versions of the schema of a config type, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schemas"
+                ],
+                "summary": "Retrieve the version history of the schema of a config type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Starting offset",
+                        "name": "skip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema versions found",
+                        "schema": {
+                            "$ref": "#/definitions/http.schemaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/schemas/{type}/versions/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a particular version of the schema of a config type, e.g. the one a configuration was validated against",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schemas"
+                ],
+                "summary": "Retrieve a particular schema version of a config type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version Number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema version found",
+                        "schema": {
+                            "$ref": "#/definitions/http.schemaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cms/watch": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream a Server-Sent Event whenever a version of a configuration is created by a create or replace, patch, rollback, delete or restore, or a configuration is purged.\nThe event name is the kind of change, its id the revision of the change and its data the revision, kind and configuration version.\nThe stream can be limited to a namespace, a configuration name, a name prefix or a config type. A client that reconnects with the Last-Event-ID header receives the changes it missed,\nor 410 if they are no longer kept, in which case it should read the configurations again and watch without Last-Event-ID.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Watch"
+                ],
+                "summary": "Stream configuration changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration name prefix",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Config type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision to resume after",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Revision to resume after",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of changes",
+                        "schema": {
+                            "$ref": "#/definitions/http.watchEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Revision no longer available error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "http.aliasResponse": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string",
+                    "example": "stable"
+                },
+                "change_message": {
+                    "description": "Why the alias was moved, if the request said",
+                    "type": "string",
+                    "example": "Release the new limits"
+                },
+                "config": {
+                    "description": "Version the alias points at, when it is resolved",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        }
+                    ]
+                },
+                "moved_at": {
+                    "description": "When the alias was moved",
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "moved_by": {
+                    "description": "Caller that moved the alias",
+                    "type": "string",
+                    "example": "alice"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "person_config"
+                },
+                "namespace": {
+                    "type": "string",
+                    "example": "default"
+                },
+                "revision": {
+                    "description": "Number of the move, to send in If-Match when moving the alias again",
+                    "type": "integer",
+                    "example": 3
+                },
+                "version": {
+                    "description": "Version the alias points at, 0 once it is cleared",
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "http.apiKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "expires_at": {
+                    "description": "Unset if the key doesn't expire",
+                    "type": "string",
+                    "example": "2024-10-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9f86d081884c7d65"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "cms_9f86d081884c7d65_2c26b46b68ffc68ff99b453c1d304134"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "ci-deploy"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2023-12-01T12:00:00Z"
+                },
+                "rotated_at": {
+                    "type": "string",
+                    "example": "2023-11-01T12:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read",
+                        "write"
+                    ]
+                }
+            }
+        },
+        "http.configurationResponse": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "owner": "payments-oncall"
+                    }
+                },
+                "change_message": {
+                    "description": "Why the version was written, if the request said",
+                    "type": "string",
+                    "example": "Raise the age limit"
+                },
+                "created_at": {
                     "description": "Optional field for creation timestamp",
                     "type": "string",
-                    "example": "2023-10-01T12:00:00Z"
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "created_by": {
+                    "description": "Caller that wrote the version, if the request was authenticated",
+                    "type": "string",
+                    "example": "alice"
+                },
+                "deleted": {
+                    "description": "Set on the tombstone version of a deleted configuration",
+                    "type": "boolean",
+                    "example": false
+                },
+                "environment": {
+                    "description": "Environment whose overlay the version wrote",
+                    "type": "string",
+                    "example": "prod"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "team": "payments",
+                        "tier": "critical"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "app_config"
+                },
+                "namespace": {
+                    "type": "string",
+                    "example": "default"
                 },
-                "name": {
+                "overlays": {
+                    "description": "Merge patch over the value by environment, left out when the value of an environment is asked for",
+                    "type": "object"
+                },
+                "promoted_from": {
+                    "description": "Environment the overlay was promoted from",
                     "type": "string",
-                    "example": "app_config"
+                    "example": "staging"
+                },
+                "promoted_version": {
+                    "description": "Version the overlay was promoted from",
+                    "type": "integer",
+                    "example": 3
                 },
                 "rollbacked_version": {
                     "description": "Optional field for copied version",
                     "type": "integer",
                     "example": 0
                 },
+                "schema_version": {
+                    "description": "Version of the type's schema the value was validated against",
+                    "type": "integer",
+                    "example": 1
+                },
                 "type": {
                     "type": "string",
                     "example": "person"
@@ -528,9 +5308,109 @@ const docTemplate = `{
                 }
             }
         },
+        "http.createApiKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "Optional, the key doesn't expire if unset",
+                    "type": "string",
+                    "example": "2024-10-01T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "ci-deploy"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "read",
+                        "write"
+                    ]
+                }
+            }
+        },
+        "http.createNamespaceRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Configurations of the payment services"
+                },
+                "metadata": {
+                    "description": "Optional, free-form details such as the owning team",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "team": "payments"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "http.diffResponse": {
+            "type": "object",
+            "properties": {
+                "from_type": {
+                    "type": "string",
+                    "example": "person"
+                },
+                "from_version": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "name": {
+                    "type": "string",
+                    "example": "person_config"
+                },
+                "namespace": {
+                    "type": "string",
+                    "example": "default"
+                },
+                "operations": {
+                    "description": "JSON Patch that turns the from value into the to value, for the patch format",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "to_type": {
+                    "type": "string",
+                    "example": "person"
+                },
+                "to_version": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "unified": {
+                    "description": "For the unified format",
+                    "type": "string",
+                    "example": "--- person_config version 3 (type person)"
+                }
+            }
+        },
         "http.errorResponse": {
             "type": "object",
             "properties": {
+                "details": {
+                    "description": "Optional, structured details of the error",
+                    "type": "object"
+                },
                 "messages": {
                     "type": "array",
                     "items": {
@@ -547,6 +5427,147 @@ const docTemplate = `{
                 }
             }
         },
+        "http.moveConfigurationAliasRequestJson": {
+            "type": "object",
+            "required": [
+                "version"
+            ],
+            "properties": {
+                "change_message": {
+                    "description": "Optional, why the alias is moved, takes precedence over the X-Change-Message header",
+                    "type": "string",
+                    "example": "Release the new limits"
+                },
+                "expected_revision": {
+                    "description": "Optional, the revision of the alias this move replaces",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "version": {
+                    "description": "Version the alias is moved to",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
+                }
+            }
+        },
+        "http.namespaceResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Configurations of the payment services"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "team": "payments"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "payments"
+                }
+            }
+        },
+        "http.promoteConfigurationVersionRequestJson": {
+            "type": "object",
+            "required": [
+                "from",
+                "to"
+            ],
+            "properties": {
+                "change_message": {
+                    "description": "Optional, why the version is promoted, takes precedence over the X-Change-Message header",
+                    "type": "string",
+                    "example": "Release the new limits"
+                },
+                "from": {
+                    "description": "Environment whose overlay is promoted",
+                    "type": "string",
+                    "example": "staging"
+                },
+                "to": {
+                    "description": "Environment the overlay is copied to",
+                    "type": "string",
+                    "example": "prod"
+                }
+            }
+        },
+        "http.putConfigurationLabelsRequestJson": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "description": "Optional, replaces the annotations, empty to remove them and left out to keep them",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "owner": "payments-oncall"
+                    }
+                },
+                "change_message": {
+                    "description": "Optional, why the labels change, takes precedence over the X-Change-Message header",
+                    "type": "string",
+                    "example": "Hand over to the payments team"
+                },
+                "expected_version": {
+                    "description": "Optional, the version this request replaces",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "labels": {
+                    "description": "Optional, replaces the labels, empty to remove them and left out to keep them",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "team": "payments",
+                        "tier": "critical"
+                    }
+                }
+            }
+        },
+        "http.putConfigurationOverlayRequestJson": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "change_message": {
+                    "description": "Optional, why the overlay changes, takes precedence over the X-Change-Message header",
+                    "type": "string",
+                    "example": "Raise the age in staging"
+                },
+                "expected_version": {
+                    "description": "Optional, the version this request replaces",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "value": {
+                    "description": "Merge patch over the base value, empty to remove the overlay",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "age": "[remove qoute]30[remove qoute]"
+                    }
+                }
+            }
+        },
         "http.putConfigurationRequestJson": {
             "type": "object",
             "required": [
@@ -554,6 +5575,38 @@ const docTemplate = `{
                 "value"
             ],
             "properties": {
+                "annotations": {
+                    "description": "Optional, replaces the annotations, they are kept if left out",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "owner": "payments-oncall"
+                    }
+                },
+                "change_message": {
+                    "description": "Optional, why the configuration changes, takes precedence over the X-Change-Message header",
+                    "type": "string",
+                    "example": "Raise the age limit"
+                },
+                "expected_version": {
+                    "description": "Optional, the version this request replaces",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "labels": {
+                    "description": "Optional, replaces the labels, they are kept if left out",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "team": "payments",
+                        "tier": "critical"
+                    }
+                },
                 "type": {
                     "type": "string",
                     "example": "person"
@@ -569,6 +5622,139 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "http.putSchemaRequestJson": {
+            "type": "object",
+            "required": [
+                "definition"
+            ],
+            "properties": {
+                "compatibility": {
+                    "description": "Optional, defaults to the mode of the previous version, or backward for a new type",
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "backward",
+                        "forward",
+                        "full"
+                    ],
+                    "example": "backward"
+                },
+                "definition": {
+                    "description": "JSON schema that the value of every configuration of the type must match",
+                    "type": "object"
+                }
+            }
+        },
+        "http.response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string",
+                    "example": "Success"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "http.schemaResponse": {
+            "type": "object",
+            "properties": {
+                "compatibility": {
+                    "type": "string",
+                    "example": "backward"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "definition": {
+                    "type": "object"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "person"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "http.validationResponse": {
+            "type": "object",
+            "properties": {
+                "schema_version": {
+                    "description": "Version of the type's schema the value was validated against, if valid",
+                    "type": "integer",
+                    "example": 1
+                },
+                "valid": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "version": {
+                    "description": "Version that would be created, if valid",
+                    "type": "integer",
+                    "example": 2
+                },
+                "violations": {
+                    "description": "Every field that doesn't match the schema, if invalid",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.violationResponse"
+                    }
+                }
+            }
+        },
+        "http.violationResponse": {
+            "type": "object",
+            "properties": {
+                "keyword": {
+                    "type": "string",
+                    "example": "minimum"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "-1 should be at least 0"
+                },
+                "pointer": {
+                    "type": "string",
+                    "example": "/age"
+                }
+            }
+        },
+        "http.watchEventResponse": {
+            "type": "object",
+            "properties": {
+                "config": {
+                    "description": "For a purge, only the name is set",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/http.configurationResponse"
+                        }
+                    ]
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "type": {
+                    "type": "string",
+                    "example": "put"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Type \"Bearer\" followed by a space and a JWT, or \"ApiKey\" followed by a space and an API key.",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
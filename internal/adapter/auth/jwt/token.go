@@ -0,0 +1,114 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+
+	jwtlib "github.com/golang-jwt/jwt/v5"
+)
+
+// errNoKeys is returned when neither a secret nor a public key is configured to verify tokens with
+var errNoKeys = errors.New("a JWT secret or public key file is required")
+
+// adminRole is the role in the roles claim of a token whose caller may perform irreversible operations
+const adminRole = "admin"
+
+// claims are the claims of a bearer token: the registered ones, and the roles the issuer granted the caller
+type claims struct {
+	jwtlib.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// TokenService verifies HS256 and RS256 signed JWT bearer tokens
+type TokenService struct {
+	secret    []byte
+	publicKey *rsa.PublicKey
+	parser    *jwtlib.Parser
+}
+
+// NewTokenService creates a token service that verifies tokens with the configured secret and public key
+func NewTokenService(cfg *config.Auth) (*TokenService, error) {
+	ts := &TokenService{}
+	var methods []string
+
+	if cfg.JWTSecret != "" {
+		ts.secret = []byte(cfg.JWTSecret)
+		methods = append(methods, jwtlib.SigningMethodHS256.Alg())
+	}
+
+	if cfg.JWTPublicKeyFile != "" {
+		pem, err := os.ReadFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		ts.publicKey, err = jwtlib.ParseRSAPublicKeyFromPEM(pem)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public key %s: %w", cfg.JWTPublicKeyFile, err)
+		}
+		methods = append(methods, jwtlib.SigningMethodRS256.Alg())
+	}
+
+	if len(methods) == 0 {
+		return nil, errNoKeys
+	}
+
+	// Only the configured algorithms are accepted, so a token can't pick "none" or sign with the public key as a secret
+	options := []jwtlib.ParserOption{jwtlib.WithValidMethods(methods), jwtlib.WithExpirationRequired()}
+	if cfg.JWTIssuer != "" {
+		options = append(options, jwtlib.WithIssuer(cfg.JWTIssuer))
+	}
+	if cfg.JWTAudience != "" {
+		options = append(options, jwtlib.WithAudience(cfg.JWTAudience))
+	}
+	ts.parser = jwtlib.NewParser(options...)
+
+	return ts, nil
+}
+
+// VerifyToken checks the signature and claims of a bearer token, returning the caller it was issued to. Every caller
+// may read and write, only a caller with the admin role may perform irreversible operations
+func (ts *TokenService) VerifyToken(token string) (*domain.Principal, error) {
+	claims := &claims{}
+
+	_, err := ts.parser.ParseWithClaims(token, claims, ts.key)
+	if errors.Is(err, jwtlib.ErrTokenExpired) {
+		return nil, domain.ErrExpiredToken
+	}
+	if err != nil {
+		return nil, domain.ErrInvalidToken
+	}
+
+	if claims.Subject == "" {
+		return nil, domain.ErrInvalidToken
+	}
+
+	scopes := []domain.ApiKeyScope{domain.ApiKeyScopeRead, domain.ApiKeyScopeWrite}
+	if slices.Contains(claims.Roles, adminRole) {
+		scopes = append(scopes, domain.ApiKeyScopeAdmin)
+	}
+
+	return &domain.Principal{
+		Kind:    domain.PrincipalUser,
+		Subject: claims.Subject,
+		Scopes:  scopes,
+	}, nil
+}
+
+// key returns the key that verifies the signature of a token
+func (ts *TokenService) key(token *jwtlib.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwtlib.SigningMethodHS256.Alg():
+		return ts.secret, nil
+	case jwtlib.SigningMethodRS256.Alg():
+		return ts.publicKey, nil
+	}
+
+	return nil, jwtlib.ErrTokenSignatureInvalid
+}
@@ -0,0 +1,192 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+
+	jwtlib "github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+// writePublicKey writes the public key of a new RSA key pair to a PEM file, returning the private key and the file path
+func writePublicKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("Failed to marshal public key: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "public.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("Failed to write public key: %v", err)
+	}
+
+	return key, path
+}
+
+func sign(t *testing.T, method jwtlib.SigningMethod, key any, claims jwtlib.Claims) string {
+	t.Helper()
+
+	token, err := jwtlib.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	return token
+}
+
+func validClaims() jwtlib.RegisteredClaims {
+	return jwtlib.RegisteredClaims{
+		Subject:   "alice",
+		Issuer:    "issuer",
+		Audience:  jwtlib.ClaimStrings{"cms"},
+		ExpiresAt: jwtlib.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+	privateKey, publicKeyFile := writePublicKey(t)
+
+	ts, err := NewTokenService(&config.Auth{
+		JWTSecret:        testSecret,
+		JWTPublicKeyFile: publicKeyFile,
+		JWTIssuer:        "issuer",
+		JWTAudience:      "cms",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create token service: %v", err)
+	}
+
+	expired := validClaims()
+	expired.ExpiresAt = jwtlib.NewNumericDate(time.Now().Add(-time.Minute))
+
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+
+	noSubject := validClaims()
+	noSubject.Subject = ""
+
+	otherIssuer := validClaims()
+	otherIssuer.Issuer = "other"
+
+	otherAudience := validClaims()
+	otherAudience.Audience = jwtlib.ClaimStrings{"other"}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"HS256", sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), validClaims()), nil},
+		{"RS256", sign(t, jwtlib.SigningMethodRS256, privateKey, validClaims()), nil},
+		{"Expired", sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), expired), domain.ErrExpiredToken},
+		{"WrongSecret", sign(t, jwtlib.SigningMethodHS256, []byte("other-secret"), validClaims()), domain.ErrInvalidToken},
+		{"UnsupportedAlgorithm", sign(t, jwtlib.SigningMethodHS512, []byte(testSecret), validClaims()), domain.ErrInvalidToken},
+		{"Unsigned", sign(t, jwtlib.SigningMethodNone, jwtlib.UnsafeAllowNoneSignatureType, validClaims()), domain.ErrInvalidToken},
+		{"NoExpiry", sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), noExpiry), domain.ErrInvalidToken},
+		{"NoSubject", sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), noSubject), domain.ErrInvalidToken},
+		{"OtherIssuer", sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), otherIssuer), domain.ErrInvalidToken},
+		{"OtherAudience", sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), otherAudience), domain.ErrInvalidToken},
+		{"Malformed", "not-a-token", domain.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := ts.VerifyToken(tt.token)
+			if err != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if principal.Kind != domain.PrincipalUser || principal.Subject != "alice" {
+				t.Fatalf("Expected user alice, got %+v", principal)
+			}
+			if !principal.HasScope(domain.ApiKeyScopeRead) || !principal.HasScope(domain.ApiKeyScopeWrite) || principal.HasScope(domain.ApiKeyScopeAdmin) {
+				t.Fatalf("Expected alice to read and write but not administer, got %+v", principal)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenAdminRole(t *testing.T) {
+	ts, err := NewTokenService(&config.Auth{JWTSecret: testSecret})
+	if err != nil {
+		t.Fatalf("Failed to create token service: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		roles     []string
+		wantAdmin bool
+	}{
+		{"Admin", []string{"developer", "admin"}, true},
+		{"OtherRoles", []string{"developer"}, false},
+		{"NoRoles", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := sign(t, jwtlib.SigningMethodHS256, []byte(testSecret), &claims{validClaims(), tt.roles})
+
+			principal, err := ts.VerifyToken(token)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if principal.HasScope(domain.ApiKeyScopeAdmin) != tt.wantAdmin {
+				t.Fatalf("Expected the admin scope to be %v, got %+v", tt.wantAdmin, principal)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenOnlyConfiguredAlgorithms(t *testing.T) {
+	_, publicKeyFile := writePublicKey(t)
+
+	ts, err := NewTokenService(&config.Auth{JWTPublicKeyFile: publicKeyFile})
+	if err != nil {
+		t.Fatalf("Failed to create token service: %v", err)
+	}
+
+	// Without a secret, an HS256 token signed with the public key itself must not pass
+	publicKey, err := os.ReadFile(publicKeyFile)
+	if err != nil {
+		t.Fatalf("Failed to read public key: %v", err)
+	}
+
+	token := sign(t, jwtlib.SigningMethodHS256, publicKey, validClaims())
+	if _, err := ts.VerifyToken(token); err != domain.ErrInvalidToken {
+		t.Fatalf("Expected error %v, got %v", domain.ErrInvalidToken, err)
+	}
+}
+
+func TestNewTokenServiceRequiresKey(t *testing.T) {
+	if _, err := NewTokenService(&config.Auth{}); err != errNoKeys {
+		t.Fatalf("Expected error %v, got %v", errNoKeys, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "public.pem")
+	if err := os.WriteFile(path, []byte("not a key"), 0o600); err != nil {
+		t.Fatalf("Failed to write public key: %v", err)
+	}
+	if _, err := NewTokenService(&config.Auth{JWTPublicKeyFile: path}); err == nil {
+		t.Fatalf("Expected an error for an invalid public key")
+	}
+}
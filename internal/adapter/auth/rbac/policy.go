@@ -0,0 +1,61 @@
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// policy is the content of a policy file
+type policy struct {
+	Bindings []domain.RoleBinding `json:"bindings"`
+}
+
+// LoadRoleBindings reads the role bindings of the configured policy file, rejecting any binding that would never apply
+func LoadRoleBindings(cfg *config.Auth) ([]domain.RoleBinding, error) {
+	data, err := os.ReadFile(cfg.RBACPolicyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var p policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing policy %s: %w", cfg.RBACPolicyFile, err)
+	}
+
+	for i, binding := range p.Bindings {
+		if err := validate(binding); err != nil {
+			return nil, fmt.Errorf("role binding %d of policy %s: %w", i, cfg.RBACPolicyFile, err)
+		}
+	}
+
+	return p.Bindings, nil
+}
+
+func validate(binding domain.RoleBinding) error {
+	if !binding.Role.Known() {
+		return fmt.Errorf("unknown role %q", binding.Role)
+	}
+
+	if binding.Kind != domain.PrincipalUser && binding.Kind != domain.PrincipalApiKey {
+		return fmt.Errorf("unknown kind %q", binding.Kind)
+	}
+
+	if binding.Subject == "" {
+		return fmt.Errorf("subject is required")
+	}
+
+	if binding.Namespace != "" && !domain.ValidNamespaceName(binding.Namespace) {
+		return fmt.Errorf("invalid namespace %q", binding.Namespace)
+	}
+
+	if _, err := path.Match(binding.Names, ""); err != nil {
+		return fmt.Errorf("names %q: %w", binding.Names, err)
+	}
+
+	return nil
+}
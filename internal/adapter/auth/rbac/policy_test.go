@@ -0,0 +1,67 @@
+package rbac
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// writePolicy writes a policy file, returning its path
+func writePolicy(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write policy: %v", err)
+	}
+
+	return path
+}
+
+func TestLoadRoleBindings(t *testing.T) {
+	path := writePolicy(t, `{"bindings": [
+		{"role": "editor", "kind": "user", "subject": "alice", "names": "payments_*"},
+		{"role": "viewer", "kind": "api_key", "subject": "9f86d081884c7d65", "type": "person"},
+		{"role": "admin", "kind": "user", "subject": "carol", "namespace": "payments"}
+	]}`)
+
+	bindings, err := LoadRoleBindings(&config.Auth{RBACPolicyFile: path})
+	if err != nil {
+		t.Fatalf("Failed to load role bindings: %v", err)
+	}
+
+	want := []domain.RoleBinding{
+		{Role: domain.RoleEditor, Kind: domain.PrincipalUser, Subject: "alice", Names: "payments_*"},
+		{Role: domain.RoleViewer, Kind: domain.PrincipalApiKey, Subject: "9f86d081884c7d65", Type: "person"},
+		{Role: domain.RoleAdmin, Kind: domain.PrincipalUser, Subject: "carol", Namespace: "payments"},
+	}
+	if !reflect.DeepEqual(bindings, want) {
+		t.Fatalf("Expected bindings %+v, got %+v", want, bindings)
+	}
+}
+
+func TestLoadRoleBindingsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"Malformed", `{"bindings": [`},
+		{"UnknownRole", `{"bindings": [{"role": "owner", "kind": "user", "subject": "alice"}]}`},
+		{"UnknownKind", `{"bindings": [{"role": "viewer", "kind": "group", "subject": "ops"}]}`},
+		{"NoSubject", `{"bindings": [{"role": "viewer", "kind": "user"}]}`},
+		{"BadNamespace", `{"bindings": [{"role": "viewer", "kind": "user", "subject": "alice", "namespace": "Payments/EU"}]}`},
+		{"BadGlob", `{"bindings": [{"role": "viewer", "kind": "user", "subject": "alice", "names": "[payments"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadRoleBindings(&config.Auth{RBACPolicyFile: writePolicy(t, tt.content)}); err == nil {
+				t.Fatalf("Expected an error for policy %s", tt.content)
+			}
+		})
+	}
+}
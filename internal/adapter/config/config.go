@@ -6,11 +6,14 @@ import (
 	"github.com/joho/godotenv"
 )
 
-// Container contains environment variables for the application, and http server
+// Container contains environment variables for the application, http server, grpc server, authentication, and storage
 type (
 	Container struct {
-		App  *App
-		HTTP *HTTP
+		App     *App
+		HTTP    *HTTP
+		GRPC    *GRPC
+		Auth    *Auth
+		Storage *Storage
 	}
 	// App contains all the environment variables for the application
 	App struct {
@@ -25,6 +28,34 @@ type (
 		Port           string
 		AllowedOrigins string
 	}
+
+	// GRPC contains all the environment variables for the grpc server
+	GRPC struct {
+		Env  string
+		URL  string
+		Port string // The grpc server only starts if a port is set
+	}
+
+	// Auth contains all the environment variables for authenticating callers.
+	// A JWT secret or public key, or a bootstrap API key, is required unless authentication is disabled
+	Auth struct {
+		Disabled            bool   // Serve every request unauthenticated, only meant for local development
+		JWTSecret           string // HS256 signing secret
+		JWTPublicKeyFile    string // PEM file with the RS256 public key
+		JWTIssuer           string // Optional, the required iss claim
+		JWTAudience         string // Optional, the required aud claim
+		RBACPolicyFile      string // Optional, JSON file with the role bindings. Authenticated callers may do anything without it
+		BootstrapApiKeyHash string // Optional, hex SHA-256 of the secret of an API key with every scope created on startup
+	}
+
+	// Storage contains all the environment variables for the configuration storage
+	Storage struct {
+		Driver            string
+		FileDir           string
+		FileSync          string
+		FileSnapshotEvery string
+		SQLitePath        string
+	}
 )
 
 // New creates a new container instance
@@ -48,8 +79,35 @@ func New() (*Container, error) {
 		AllowedOrigins: os.Getenv("HTTP_ALLOWED_ORIGINS"),
 	}
 
+	grpc := &GRPC{
+		Env:  os.Getenv("APP_ENV"),
+		URL:  os.Getenv("GRPC_URL"),
+		Port: os.Getenv("GRPC_PORT"),
+	}
+
+	auth := &Auth{
+		Disabled:            os.Getenv("AUTH_DISABLED") == "true",
+		JWTSecret:           os.Getenv("AUTH_JWT_SECRET"),
+		JWTPublicKeyFile:    os.Getenv("AUTH_JWT_PUBLIC_KEY_FILE"),
+		JWTIssuer:           os.Getenv("AUTH_JWT_ISSUER"),
+		JWTAudience:         os.Getenv("AUTH_JWT_AUDIENCE"),
+		RBACPolicyFile:      os.Getenv("AUTH_RBAC_POLICY_FILE"),
+		BootstrapApiKeyHash: os.Getenv("AUTH_BOOTSTRAP_API_KEY_HASH"),
+	}
+
+	storage := &Storage{
+		Driver:            os.Getenv("STORAGE_DRIVER"),
+		FileDir:           os.Getenv("STORAGE_FILE_DIR"),
+		FileSync:          os.Getenv("STORAGE_FILE_SYNC"),
+		FileSnapshotEvery: os.Getenv("STORAGE_FILE_SNAPSHOT_EVERY"),
+		SQLitePath:        os.Getenv("STORAGE_SQLITE_PATH"),
+	}
+
 	return &Container{
 		app,
 		http,
+		grpc,
+		auth,
+		storage,
 	}, nil
 }
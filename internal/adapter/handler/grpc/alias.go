@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+
+	cmsv1 "github.com/arifMasnandar/go-config-management-service/api/cms/v1"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// MoveConfigurationAlias points an alias such as stable or canary at a version of a configuration
+func (ch *ConfigurationHandler) MoveConfigurationAlias(ctx context.Context, req *cmsv1.MoveConfigurationAliasRequest) (*cmsv1.Alias, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetAlias() == "" {
+		return nil, validationError(errAliasRequired)
+	}
+	if req.GetVersion() < 1 {
+		return nil, validationError(errInvalidVersion)
+	}
+	if req.GetExpectedRevision() < 0 {
+		return nil, validationError(errInvalidExpectedRevision)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	alias, err := ch.svc.MoveConfigurationAlias(ctx, namespace(req), req.GetName(), req.GetAlias(), int(req.GetVersion()), int(req.GetExpectedRevision()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return newAliasResponse(alias), nil
+}
+
+// ClearConfigurationAlias points an alias at no version
+func (ch *ConfigurationHandler) ClearConfigurationAlias(ctx context.Context, req *cmsv1.ClearConfigurationAliasRequest) (*cmsv1.Alias, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetAlias() == "" {
+		return nil, validationError(errAliasRequired)
+	}
+	if req.GetExpectedRevision() < 0 {
+		return nil, validationError(errInvalidExpectedRevision)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	alias, err := ch.svc.ClearConfigurationAlias(ctx, namespace(req), req.GetName(), req.GetAlias(), int(req.GetExpectedRevision()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return newAliasResponse(alias), nil
+}
+
+// GetConfigurationAlias returns an alias of a configuration with the version it points at
+func (ch *ConfigurationHandler) GetConfigurationAlias(ctx context.Context, req *cmsv1.GetConfigurationAliasRequest) (*cmsv1.GetConfigurationAliasResponse, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetAlias() == "" {
+		return nil, validationError(errAliasRequired)
+	}
+	if err := checkEnvironment(req); err != nil {
+		return nil, err
+	}
+
+	alias, config, err := ch.svc.GetConfigurationAlias(ctx, namespace(req), req.GetName(), req.GetAlias())
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	configRsp, err := configResponse(forEnvironment(config, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmsv1.GetConfigurationAliasResponse{Alias: newAliasResponse(alias), Config: configRsp}, nil
+}
+
+// ListConfigurationAliases returns the aliases of a configuration that point at a version
+func (ch *ConfigurationHandler) ListConfigurationAliases(ctx context.Context, req *cmsv1.ListConfigurationAliasesRequest) (*cmsv1.ListConfigurationAliasesResponse, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+
+	aliases, err := ch.svc.ListConfigurationAliases(ctx, namespace(req), req.GetName())
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &cmsv1.ListConfigurationAliasesResponse{Aliases: newAliasesResponse(aliases)}, nil
+}
+
+// ListConfigurationAliasRevisions returns every move of an alias, oldest first
+func (ch *ConfigurationHandler) ListConfigurationAliasRevisions(ctx context.Context, req *cmsv1.ListConfigurationAliasRevisionsRequest) (*cmsv1.ListConfigurationAliasRevisionsResponse, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetAlias() == "" {
+		return nil, validationError(errAliasRequired)
+	}
+	if req.GetLimit() < 1 || req.GetLimit() > 100 {
+		return nil, validationError(errInvalidRevisionsLimit)
+	}
+
+	revisions, err := ch.svc.ListConfigurationAliasRevisions(ctx, namespace(req), req.GetName(), req.GetAlias(), req.GetSkip(), req.GetLimit())
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &cmsv1.ListConfigurationAliasRevisionsResponse{Revisions: newAliasesResponse(revisions)}, nil
+}
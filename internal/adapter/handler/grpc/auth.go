@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	cmsv1 "github.com/arifMasnandar/go-config-management-service/api/cms/v1"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// authorizationMetadataKey is the key for authorization metadata in the call
+	authorizationMetadataKey = "authorization"
+	// authorizationTypeBearer is the authorization type of JWT bearer tokens
+	authorizationTypeBearer = "bearer"
+	// authorizationTypeApiKey is the authorization type of API keys
+	authorizationTypeApiKey = "apikey"
+	// reflectionServicePrefix is the method prefix of the reflection service, which is served without authentication
+	reflectionServicePrefix = "/grpc.reflection."
+)
+
+// methodScopes are the scopes API keys need for the methods that don't change anything or that can't be undone.
+// Every other method needs the write scope
+var methodScopes = map[string]domain.ApiKeyScope{
+	cmsv1.ConfigurationService_GetConfiguration_FullMethodName:                domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_WaitForConfiguration_FullMethodName:            domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_ListConfigurations_FullMethodName:              domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_ListConfigurationVersions_FullMethodName:       domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_GetConfigurationVersion_FullMethodName:         domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_GetConfigurationAlias_FullMethodName:           domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_ListConfigurationAliases_FullMethodName:        domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_ListConfigurationAliasRevisions_FullMethodName: domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_DiffConfigurationVersions_FullMethodName:       domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_Watch_FullMethodName:                           domain.ApiKeyScopeRead,
+	cmsv1.ConfigurationService_PurgeConfiguration_FullMethodName:              domain.ApiKeyScopeAdmin,
+}
+
+// authenticate verifies the bearer token or API key in the metadata of a call and checks that the caller may call
+// the method, returning a context that carries the caller's identity
+func authenticate(ctx context.Context, method string, token port.TokenService, apiKeys service.ApiKeyServicer) (context.Context, error) {
+	values := metadata.ValueFromIncomingContext(ctx, authorizationMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, handleError(domain.ErrEmptyAuthorizationHeader)
+	}
+
+	fields := strings.Fields(values[0])
+	if len(fields) != 2 {
+		return nil, handleError(domain.ErrInvalidAuthorizationHeader)
+	}
+
+	var principal *domain.Principal
+	var err error
+
+	switch strings.ToLower(fields[0]) {
+	case authorizationTypeBearer:
+		if token == nil {
+			err = domain.ErrInvalidAuthorizationType // Bearer tokens can't be verified without a JWT key
+			break
+		}
+		principal, err = token.VerifyToken(fields[1])
+	case authorizationTypeApiKey:
+		if apiKeys == nil {
+			err = domain.ErrInvalidAuthorizationType
+			break
+		}
+		principal, err = apiKeys.VerifyApiKey(ctx, fields[1])
+	default:
+		err = domain.ErrInvalidAuthorizationType
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	scope, ok := methodScopes[method]
+	if !ok {
+		scope = domain.ApiKeyScopeWrite
+	}
+	if !principal.HasScope(scope) {
+		return nil, handleError(domain.ErrForbidden)
+	}
+
+	return domain.ContextWithPrincipal(ctx, principal), nil
+}
+
+// authUnary is an interceptor to check if the caller of a unary call is authenticated
+func authUnary(token port.TokenService, apiKeys service.ApiKeyServicer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, reflectionServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, info.FullMethod, token, apiKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// authStream is an interceptor to check if the caller of a streaming call is authenticated
+func authStream(token port.TokenService, apiKeys service.ApiKeyServicer) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, reflectionServicePrefix) {
+			return handler(srv, stream)
+		}
+
+		ctx, err := authenticate(stream.Context(), info.FullMethod, token, apiKeys)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{stream, ctx})
+	}
+}
+
+// authenticatedStream is a server stream whose context carries the caller's identity
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	cmsv1 "github.com/arifMasnandar/go-config-management-service/api/cms/v1"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/memory"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadOnlyApiKey(t *testing.T) {
+	apiKeys := service.NewApiKeyService(memory.NewApiKeyRepository())
+
+	_, key, err := apiKeys.CreateApiKey(context.Background(), &domain.ApiKey{Name: "reader", Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, "ApiKey "+key))
+
+	// Reading configurations and their aliases only needs the read scope
+	for _, method := range []string{
+		cmsv1.ConfigurationService_GetConfiguration_FullMethodName,
+		cmsv1.ConfigurationService_ListConfigurations_FullMethodName,
+		cmsv1.ConfigurationService_GetConfigurationAlias_FullMethodName,
+		cmsv1.ConfigurationService_ListConfigurationAliases_FullMethodName,
+		cmsv1.ConfigurationService_ListConfigurationAliasRevisions_FullMethodName,
+		cmsv1.ConfigurationService_Watch_FullMethodName,
+	} {
+		if _, err := authenticate(ctx, method, nil, apiKeys); err != nil {
+			t.Errorf("Expected %s to be allowed with the read scope, got %v", method, err)
+		}
+	}
+
+	for _, method := range []string{
+		cmsv1.ConfigurationService_PutConfiguration_FullMethodName,
+		cmsv1.ConfigurationService_MoveConfigurationAlias_FullMethodName,
+		cmsv1.ConfigurationService_ClearConfigurationAlias_FullMethodName,
+		cmsv1.ConfigurationService_PurgeConfiguration_FullMethodName,
+	} {
+		if _, err := authenticate(ctx, method, nil, apiKeys); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("Expected %s to be denied with the read scope, got %v", method, err)
+		}
+	}
+}
@@ -0,0 +1,646 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	cmsv1 "github.com/arifMasnandar/go-config-management-service/api/cms/v1"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	// errNameRequired is returned when a request doesn't name a configuration
+	errNameRequired = errors.New("name is required")
+	// errTypeRequired is returned when a create or replace request doesn't have a config type
+	errTypeRequired = errors.New("type is required")
+	// errValueRequired is returned when a create or replace request doesn't have a value
+	errValueRequired = errors.New("value is required")
+	// errInvalidExpectedVersion is returned when the expected version is negative
+	errInvalidExpectedVersion = errors.New("expected_version must be at least 0")
+	// errInvalidVersion is returned when a request doesn't name a version of a configuration
+	errInvalidVersion = errors.New("version must be at least 1")
+	// errInvalidAfterVersion is returned when a wait is for a version newer than a negative one
+	errInvalidAfterVersion = errors.New("after_version must be at least 0")
+	// errInvalidTimeout is returned when a wait is shorter than a second or longer than five minutes
+	errInvalidTimeout = errors.New("timeout must be between 1s and 5m")
+	// errInvalidFromVersion is returned when a diff doesn't start at a version
+	errInvalidFromVersion = errors.New("from_version must be at least 1")
+	// errInvalidToVersion is returned when a diff ends at a negative version
+	errInvalidToVersion = errors.New("to_version must be at least 0")
+	// errInvalidConfigsLimit is returned when a page of configurations is empty or larger than 100
+	errInvalidConfigsLimit = errors.New("limit must be between 1 and 100")
+	// errInvalidVersionsLimit is returned when a page of versions is smaller than 5 or larger than 100
+	errInvalidVersionsLimit = errors.New("limit must be between 5 and 100")
+	// errAliasRequired is returned when a request on an alias doesn't name one
+	errAliasRequired = errors.New("alias is required")
+	// errInvalidExpectedRevision is returned when the expected revision of an alias is negative
+	errInvalidExpectedRevision = errors.New("expected_revision must be at least 0")
+	// errInvalidRevisionsLimit is returned when a page of alias revisions is empty or larger than 100
+	errInvalidRevisionsLimit = errors.New("limit must be between 1 and 100")
+	// errWatcherBehind is returned when a watcher falls so far behind that its stream is ended
+	errWatcherBehind = errors.New("watcher fell too far behind, resume from the last revision received")
+)
+
+const (
+	// defaultWaitTimeout is how long a wait for a newer version lasts when the request doesn't say
+	defaultWaitTimeout = 30 * time.Second
+	minWaitTimeout     = time.Second
+	maxWaitTimeout     = 5 * time.Minute
+)
+
+// patchTypes maps the patch type of a request to the kind of patch document
+var patchTypes = map[cmsv1.PatchType]domain.PatchType{
+	cmsv1.PatchType_PATCH_TYPE_MERGE: domain.PatchTypeMerge,
+	cmsv1.PatchType_PATCH_TYPE_JSON:  domain.PatchTypeJSON,
+}
+
+// diffFormats maps the diff format of a request to the representation of the changes
+var diffFormats = map[cmsv1.DiffFormat]domain.DiffFormat{
+	cmsv1.DiffFormat_DIFF_FORMAT_UNSPECIFIED: domain.DiffFormatPatch,
+	cmsv1.DiffFormat_DIFF_FORMAT_PATCH:       domain.DiffFormatPatch,
+	cmsv1.DiffFormat_DIFF_FORMAT_UNIFIED:     domain.DiffFormatUnified,
+}
+
+// sortFields maps the sort field of a list request to the order of the list
+var sortFields = map[cmsv1.SortField]domain.SortField{
+	cmsv1.SortField_SORT_FIELD_UNSPECIFIED: domain.SortByName,
+	cmsv1.SortField_SORT_FIELD_NAME:        domain.SortByName,
+	cmsv1.SortField_SORT_FIELD_CREATED_AT:  domain.SortByCreatedAt,
+	cmsv1.SortField_SORT_FIELD_VERSION:     domain.SortByVersion,
+}
+
+// sortOrders maps the sort order of a list request to the direction of the list
+var sortOrders = map[cmsv1.SortOrder]domain.SortOrder{
+	cmsv1.SortOrder_SORT_ORDER_UNSPECIFIED: domain.SortAscending,
+	cmsv1.SortOrder_SORT_ORDER_ASC:         domain.SortAscending,
+	cmsv1.SortOrder_SORT_ORDER_DESC:        domain.SortDescending,
+}
+
+// pagedRequest is a request for a page of a sorted list
+type pagedRequest interface {
+	GetSkip() uint64
+	GetLimit() uint64
+	GetSort() cmsv1.SortField
+	GetOrder() cmsv1.SortOrder
+	GetCursor() string
+}
+
+// pageRequest returns the page a list request asks for
+func pageRequest(req pagedRequest) (domain.PageRequest, error) {
+	sort, ok := sortFields[req.GetSort()]
+	if !ok {
+		return domain.PageRequest{}, domain.ErrInvalidSort
+	}
+	order, ok := sortOrders[req.GetOrder()]
+	if !ok {
+		return domain.PageRequest{}, domain.ErrInvalidSort
+	}
+
+	page := domain.PageRequest{Sort: sort, Order: order, Skip: req.GetSkip(), Limit: req.GetLimit()}
+
+	if req.GetCursor() != "" {
+		var err error
+		if page.Cursor, err = domain.ParseCursor(req.GetCursor()); err != nil {
+			return domain.PageRequest{}, err
+		}
+	}
+
+	return page, nil
+}
+
+// nextCursor returns the token of the cursor of the next page, or "" on the last page
+func nextCursor(cursor *domain.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return cursor.Token()
+}
+
+// configQuery returns the filters of a request to list configurations
+func configQuery(req *cmsv1.ListConfigurationsRequest) (domain.ConfigQuery, error) {
+	query := domain.ConfigQuery{
+		Type:       req.GetType(),
+		NamePrefix: req.GetNamePrefix(),
+		NameGlob:   req.GetNameGlob(),
+	}
+
+	if req.GetCreatedAfter() != nil {
+		query.CreatedAfter = req.GetCreatedAfter().AsTime()
+	}
+	if req.GetCreatedBefore() != nil {
+		query.CreatedBefore = req.GetCreatedBefore().AsTime()
+	}
+
+	for _, where := range req.GetWhere() {
+		predicate, err := domain.ParseValuePredicate(where)
+		if err != nil {
+			return domain.ConfigQuery{}, err
+		}
+		query.Values = append(query.Values, predicate)
+	}
+
+	selector, err := domain.ParseLabelSelector(req.GetSelector())
+	if err != nil {
+		return domain.ConfigQuery{}, err
+	}
+	query.Labels = selector
+
+	return query, nil
+}
+
+// stringMap returns the entries of a map that is set, which may be empty, or nil if it isn't set
+func stringMap(m *cmsv1.StringMap) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetEntries() == nil {
+		return map[string]string{}
+	}
+
+	return m.GetEntries()
+}
+
+// namespacedRequest is a request that may name the namespace of a configuration
+type namespacedRequest interface {
+	GetNamespace() string
+}
+
+// namespace returns the namespace of a request, the default namespace if it doesn't name one
+func namespace(req namespacedRequest) string {
+	if namespace := req.GetNamespace(); namespace != "" {
+		return namespace
+	}
+
+	return domain.DefaultNamespace
+}
+
+// environmentRequest is a read that may ask for the value an environment sees
+type environmentRequest interface {
+	GetEnvironment() string
+}
+
+// checkEnvironment checks the environment a read asks for, if any
+func checkEnvironment(req environmentRequest) error {
+	if environment := req.GetEnvironment(); environment != "" && !domain.ValidEnvironmentName(environment) {
+		return handleError(domain.ErrInvalidEnvironment)
+	}
+
+	return nil
+}
+
+// forEnvironment returns a config as the environment of a read sees it, or as it is stored if the read doesn't name one
+func forEnvironment(config *domain.Config, req environmentRequest) *domain.Config {
+	if req.GetEnvironment() == "" {
+		return config
+	}
+
+	return config.ForEnvironment(req.GetEnvironment())
+}
+
+// ConfigurationHandler represents the gRPC handler for configuration-related requests
+type ConfigurationHandler struct {
+	cmsv1.UnimplementedConfigurationServiceServer
+
+	svc      service.ConfigurationServicer
+	watchSvc service.WatchServicer
+}
+
+// NewConfigurationHandler creates a new ConfigurationHandler instance
+func NewConfigurationHandler(svc service.ConfigurationServicer, watchSvc service.WatchServicer) *ConfigurationHandler {
+	return &ConfigurationHandler{
+		svc:      svc,
+		watchSvc: watchSvc,
+	}
+}
+
+// bindPutConfiguration checks a create or replace request, returning the config and the version it replaces
+func bindPutConfiguration(req *cmsv1.PutConfigurationRequest) (*domain.Config, int, error) {
+	switch {
+	case req.GetName() == "":
+		return nil, 0, validationError(errNameRequired)
+	case req.GetType() == "":
+		return nil, 0, validationError(errTypeRequired)
+	case req.GetValue() == nil:
+		return nil, 0, validationError(errValueRequired)
+	case req.GetExpectedVersion() < 0:
+		return nil, 0, validationError(errInvalidExpectedVersion)
+	}
+
+	config := &domain.Config{
+		Namespace:   namespace(req),
+		Name:        req.GetName(),
+		Type:        req.GetType(),
+		Value:       req.GetValue().AsMap(),
+		Labels:      stringMap(req.GetLabels()),
+		Annotations: stringMap(req.GetAnnotations()),
+	}
+
+	return config, int(req.GetExpectedVersion()), nil
+}
+
+// PutConfiguration creates a configuration or a new version of an existing one
+func (ch *ConfigurationHandler) PutConfiguration(ctx context.Context, req *cmsv1.PutConfigurationRequest) (*cmsv1.Config, error) {
+	config, expectedVersion, err := bindPutConfiguration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	createdConfig, err := ch.svc.PutConfiguration(ctx, config, expectedVersion)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(createdConfig)
+}
+
+// ValidateConfiguration runs every check of PutConfiguration without storing the configuration
+func (ch *ConfigurationHandler) ValidateConfiguration(ctx context.Context, req *cmsv1.PutConfigurationRequest) (*cmsv1.ValidateConfigurationResponse, error) {
+	config, expectedVersion, err := bindPutConfiguration(req)
+	if err != nil {
+		return nil, err
+	}
+
+	validatedConfig, err := ch.svc.ValidateConfiguration(ctx, config, expectedVersion)
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return &cmsv1.ValidateConfigurationResponse{
+			Valid:      false,
+			Violations: newViolationsResponse(validationErr.Violations),
+		}, nil
+	}
+
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &cmsv1.ValidateConfigurationResponse{
+		Valid:         true,
+		Version:       int64(validatedConfig.Version),
+		SchemaVersion: int64(validatedConfig.SchemaVersion),
+	}, nil
+}
+
+// PatchConfiguration creates a new version by applying a JSON Merge Patch or a JSON Patch to the latest value
+func (ch *ConfigurationHandler) PatchConfiguration(ctx context.Context, req *cmsv1.PatchConfigurationRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetExpectedVersion() < 0 {
+		return nil, validationError(errInvalidExpectedVersion)
+	}
+
+	patchType, ok := patchTypes[req.GetPatchType()]
+	if !ok {
+		return nil, handleError(domain.ErrUnsupportedPatchType)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	patchedConfig, err := ch.svc.PatchConfiguration(ctx, namespace(req), req.GetName(), patchType, req.GetPatch(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(patchedConfig)
+}
+
+// PutConfigurationOverlay creates a new version with another overlay of an environment over the latest value
+func (ch *ConfigurationHandler) PutConfigurationOverlay(ctx context.Context, req *cmsv1.PutConfigurationOverlayRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetExpectedVersion() < 0 {
+		return nil, validationError(errInvalidExpectedVersion)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	config, err := ch.svc.PutConfigurationOverlay(ctx, namespace(req), req.GetName(), req.GetEnvironment(), req.GetOverlay().AsMap(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(config)
+}
+
+// PutConfigurationLabels creates a new version with other labels or annotations and the latest value
+func (ch *ConfigurationHandler) PutConfigurationLabels(ctx context.Context, req *cmsv1.PutConfigurationLabelsRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetExpectedVersion() < 0 {
+		return nil, validationError(errInvalidExpectedVersion)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	config, err := ch.svc.PutConfigurationLabels(ctx, namespace(req), req.GetName(), stringMap(req.GetLabels()), stringMap(req.GetAnnotations()), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(config)
+}
+
+// GetConfiguration returns the latest version of a configuration, or the version an alias points at
+func (ch *ConfigurationHandler) GetConfiguration(ctx context.Context, req *cmsv1.GetConfigurationRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if err := checkEnvironment(req); err != nil {
+		return nil, err
+	}
+
+	var (
+		config *domain.Config
+		err    error
+	)
+	if req.GetAlias() != "" {
+		_, config, err = ch.svc.GetConfigurationAlias(ctx, namespace(req), req.GetName(), req.GetAlias())
+	} else {
+		config, err = ch.svc.GetConfiguration(ctx, namespace(req), req.GetName())
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(forEnvironment(config, req))
+}
+
+// WaitForConfiguration returns the latest version of a configuration once it is newer than after_version
+func (ch *ConfigurationHandler) WaitForConfiguration(ctx context.Context, req *cmsv1.WaitForConfigurationRequest) (*cmsv1.WaitForConfigurationResponse, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetAfterVersion() < 0 {
+		return nil, validationError(errInvalidAfterVersion)
+	}
+	if err := checkEnvironment(req); err != nil {
+		return nil, err
+	}
+
+	timeout := defaultWaitTimeout
+	if req.GetTimeout() != nil {
+		timeout = req.GetTimeout().AsDuration()
+		if timeout < minWaitTimeout || timeout > maxWaitTimeout {
+			return nil, validationError(errInvalidTimeout)
+		}
+	}
+
+	// The context is done when the client cancels the call or its deadline passes, which ends the wait
+	config, err := ch.svc.WaitForConfiguration(ctx, namespace(req), req.GetName(), int(req.GetAfterVersion()), timeout)
+	if err == domain.ErrNotModified {
+		return &cmsv1.WaitForConfigurationResponse{Modified: false}, nil
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	rsp, err := configResponse(forEnvironment(config, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmsv1.WaitForConfigurationResponse{Modified: true, Config: rsp}, nil
+}
+
+// ListConfigurations returns the latest version of every configuration that passes the filters of the request
+func (ch *ConfigurationHandler) ListConfigurations(ctx context.Context, req *cmsv1.ListConfigurationsRequest) (*cmsv1.ListConfigurationsResponse, error) {
+	if req.GetLimit() < 1 || req.GetLimit() > 100 {
+		return nil, validationError(errInvalidConfigsLimit)
+	}
+
+	page, err := pageRequest(req)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	query, err := configQuery(req)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	configs, err := ch.svc.ListConfigurations(ctx, namespace(req), query, page)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	rsp, err := newConfigsResponse(configs.Configs)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &cmsv1.ListConfigurationsResponse{Configs: rsp, NextCursor: nextCursor(configs.Next), Total: configs.Total}, nil
+}
+
+// ListConfigurationVersions returns the versions of a configuration
+func (ch *ConfigurationHandler) ListConfigurationVersions(ctx context.Context, req *cmsv1.ListConfigurationVersionsRequest) (*cmsv1.ListConfigurationVersionsResponse, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetLimit() < 5 || req.GetLimit() > 100 {
+		return nil, validationError(errInvalidVersionsLimit)
+	}
+
+	page, err := pageRequest(req)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	configs, err := ch.svc.ListConfigurationVersions(ctx, namespace(req), req.GetName(), domain.VersionFilter{CreatedBy: req.GetCreatedBy()}, page)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	rsp, err := newConfigsResponse(configs.Configs)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &cmsv1.ListConfigurationVersionsResponse{Configs: rsp, NextCursor: nextCursor(configs.Next), Total: configs.Total}, nil
+}
+
+// GetConfigurationVersion returns a particular version of a configuration
+func (ch *ConfigurationHandler) GetConfigurationVersion(ctx context.Context, req *cmsv1.GetConfigurationVersionRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetVersion() < 1 {
+		return nil, validationError(errInvalidVersion)
+	}
+	if err := checkEnvironment(req); err != nil {
+		return nil, err
+	}
+
+	config, err := ch.svc.GetConfigurationVersion(ctx, namespace(req), req.GetName(), int(req.GetVersion()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(forEnvironment(config, req))
+}
+
+// RollbackConfigurationVersion creates a new version with the value of an earlier one
+func (ch *ConfigurationHandler) RollbackConfigurationVersion(ctx context.Context, req *cmsv1.RollbackConfigurationVersionRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetVersion() < 1 {
+		return nil, validationError(errInvalidVersion)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	config, err := ch.svc.RollbackConfigurationVersion(ctx, namespace(req), req.GetName(), int(req.GetVersion()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(config)
+}
+
+// PromoteConfigurationVersion creates a new version with the overlay an environment had in an earlier version
+// copied to another environment
+func (ch *ConfigurationHandler) PromoteConfigurationVersion(ctx context.Context, req *cmsv1.PromoteConfigurationVersionRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetVersion() < 1 {
+		return nil, validationError(errInvalidVersion)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	config, err := ch.svc.PromoteConfigurationVersion(ctx, namespace(req), req.GetName(), int(req.GetVersion()), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(config)
+}
+
+// DiffConfigurationVersions returns the changes between two versions of a configuration
+func (ch *ConfigurationHandler) DiffConfigurationVersions(ctx context.Context, req *cmsv1.DiffConfigurationVersionsRequest) (*cmsv1.ConfigDiff, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetFromVersion() < 1 {
+		return nil, validationError(errInvalidFromVersion)
+	}
+	if req.GetToVersion() < 0 {
+		return nil, validationError(errInvalidToVersion)
+	}
+
+	format, ok := diffFormats[req.GetFormat()]
+	if !ok {
+		return nil, handleError(domain.ErrUnsupportedDiffFormat)
+	}
+
+	diff, err := ch.svc.DiffConfigurationVersions(ctx, namespace(req), req.GetName(), int(req.GetFromVersion()), int(req.GetToVersion()), format)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	rsp, err := newDiffResponse(diff)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return rsp, nil
+}
+
+// DeleteConfiguration writes a tombstone version, the configuration can be restored until it is purged
+func (ch *ConfigurationHandler) DeleteConfiguration(ctx context.Context, req *cmsv1.DeleteConfigurationRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+	if req.GetExpectedVersion() < 0 {
+		return nil, validationError(errInvalidExpectedVersion)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	config, err := ch.svc.DeleteConfiguration(ctx, namespace(req), req.GetName(), int(req.GetExpectedVersion()))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(config)
+}
+
+// RestoreConfiguration creates a new version with the value the configuration had before it was deleted
+func (ch *ConfigurationHandler) RestoreConfiguration(ctx context.Context, req *cmsv1.RestoreConfigurationRequest) (*cmsv1.Config, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+
+	ctx = domain.ContextWithChangeMessage(ctx, req.GetChangeMessage())
+	config, err := ch.svc.RestoreConfiguration(ctx, namespace(req), req.GetName())
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return configResponse(config)
+}
+
+// PurgeConfiguration erases every version of a configuration
+func (ch *ConfigurationHandler) PurgeConfiguration(ctx context.Context, req *cmsv1.PurgeConfigurationRequest) (*emptypb.Empty, error) {
+	if req.GetName() == "" {
+		return nil, validationError(errNameRequired)
+	}
+
+	if err := ch.svc.PurgeConfiguration(ctx, namespace(req), req.GetName()); err != nil {
+		return nil, handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Watch streams the changes of configurations until the client cancels the call
+func (ch *ConfigurationHandler) Watch(req *cmsv1.WatchRequest, stream cmsv1.ConfigurationService_WatchServer) error {
+	filter := domain.WatchFilter{
+		Namespace:  req.GetNamespace(),
+		Name:       req.GetName(),
+		NamePrefix: req.GetPrefix(),
+		Type:       req.GetType(),
+	}
+
+	// The stream context is done when the client cancels the call, which ends the watch
+	ctx := stream.Context()
+	events, err := ch.watchSvc.Watch(ctx, filter, req.GetLastRevision())
+	if err != nil {
+		return handleError(err)
+	}
+
+	for event := range events {
+		rsp, err := newConfigEventResponse(event)
+		if err != nil {
+			return handleError(err)
+		}
+
+		if err := stream.Send(rsp); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return handleError(ctx.Err())
+	}
+
+	// The watcher fell behind, the client resumes from the last revision it received
+	return status.Error(codes.Unavailable, errWatcherBehind.Error())
+}
+
+// configResponse converts a configuration into its protobuf message
+func configResponse(config *domain.Config) (*cmsv1.Config, error) {
+	rsp, err := newConfigResponse(config)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,261 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	cmsv1 "github.com/arifMasnandar/go-config-management-service/api/cms/v1"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newConfigResponse(config *domain.Config) (*cmsv1.Config, error) {
+	rsp := &cmsv1.Config{
+		Namespace:         config.Namespace,
+		Name:              config.Name,
+		Type:              config.Type,
+		Version:           int64(config.Version),
+		SchemaVersion:     int64(config.SchemaVersion),
+		RollbackedVersion: int64(config.RollbackedVersion),
+		Deleted:           config.Deleted,
+		CreatedBy:         config.CreatedBy,
+		ChangeMessage:     config.ChangeMessage,
+		Environment:       config.Environment,
+		PromotedFrom:      config.PromotedFrom,
+		PromotedVersion:   int64(config.PromotedVersion),
+		Labels:            config.Labels,
+		Annotations:       config.Annotations,
+	}
+
+	// A tombstone or a purge event has no value
+	if config.Value != nil {
+		value, err := structpb.NewStruct(config.Value)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Value = value
+	}
+
+	if len(config.Overlays) > 0 {
+		rsp.Overlays = make(map[string]*structpb.Struct, len(config.Overlays))
+		for environment, overlay := range config.Overlays {
+			value, err := structpb.NewStruct(overlay)
+			if err != nil {
+				return nil, err
+			}
+			rsp.Overlays[environment] = value
+		}
+	}
+
+	if !config.CreatedAt.IsZero() {
+		rsp.CreatedAt = timestamppb.New(config.CreatedAt)
+	}
+
+	return rsp, nil
+}
+
+func newConfigsResponse(configs []*domain.Config) ([]*cmsv1.Config, error) {
+	rsp := make([]*cmsv1.Config, 0, len(configs))
+	for _, config := range configs {
+		configRsp, err := newConfigResponse(config)
+		if err != nil {
+			return nil, err
+		}
+		rsp = append(rsp, configRsp)
+	}
+
+	return rsp, nil
+}
+
+func newAliasResponse(alias *domain.Alias) *cmsv1.Alias {
+	return &cmsv1.Alias{
+		Namespace:     alias.Namespace,
+		Name:          alias.Name,
+		Alias:         alias.Alias,
+		Version:       int64(alias.Version),
+		Revision:      int64(alias.Revision),
+		MovedAt:       timestamppb.New(alias.MovedAt),
+		MovedBy:       alias.MovedBy,
+		ChangeMessage: alias.ChangeMessage,
+	}
+}
+
+func newAliasesResponse(aliases []*domain.Alias) []*cmsv1.Alias {
+	rsp := make([]*cmsv1.Alias, 0, len(aliases))
+	for _, alias := range aliases {
+		rsp = append(rsp, newAliasResponse(alias))
+	}
+
+	return rsp
+}
+
+func newViolationsResponse(violations []domain.Violation) []*cmsv1.Violation {
+	rsp := make([]*cmsv1.Violation, 0, len(violations))
+	for _, v := range violations {
+		rsp = append(rsp, &cmsv1.Violation{Pointer: v.Pointer, Keyword: v.Keyword, Message: v.Message})
+	}
+
+	return rsp
+}
+
+func newDiffResponse(diff *domain.ConfigDiff) (*cmsv1.ConfigDiff, error) {
+	rsp := &cmsv1.ConfigDiff{
+		Namespace:   diff.Namespace,
+		Name:        diff.Name,
+		FromVersion: int64(diff.FromVersion),
+		ToVersion:   int64(diff.ToVersion),
+		FromType:    diff.FromType,
+		ToType:      diff.ToType,
+		Unified:     diff.Unified,
+	}
+
+	for _, operation := range diff.Operations {
+		operationRsp := &cmsv1.DiffOperation{Op: operation.Op, Path: operation.Path}
+		if operation.Op != "remove" {
+			value, err := structpb.NewValue(operation.Value) // A nil value becomes a null value
+			if err != nil {
+				return nil, err
+			}
+			operationRsp.Value = value
+		}
+		rsp.Operations = append(rsp.Operations, operationRsp)
+	}
+
+	return rsp, nil
+}
+
+// eventTypes maps the kinds of configuration changes to their protobuf enum
+var eventTypes = map[domain.ConfigEventType]cmsv1.ConfigEventType{
+	domain.ConfigEventPut:      cmsv1.ConfigEventType_CONFIG_EVENT_TYPE_PUT,
+	domain.ConfigEventRollback: cmsv1.ConfigEventType_CONFIG_EVENT_TYPE_ROLLBACK,
+	domain.ConfigEventDelete:   cmsv1.ConfigEventType_CONFIG_EVENT_TYPE_DELETE,
+	domain.ConfigEventRestore:  cmsv1.ConfigEventType_CONFIG_EVENT_TYPE_RESTORE,
+	domain.ConfigEventPurge:    cmsv1.ConfigEventType_CONFIG_EVENT_TYPE_PURGE,
+	domain.ConfigEventPromote:  cmsv1.ConfigEventType_CONFIG_EVENT_TYPE_PROMOTE,
+}
+
+func newConfigEventResponse(event *domain.ConfigEvent) (*cmsv1.ConfigEvent, error) {
+	config, err := newConfigResponse(event.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmsv1.ConfigEvent{
+		Revision: event.Revision,
+		Type:     eventTypes[event.Type],
+		Config:   config,
+	}, nil
+}
+
+// errorCodes pairs the defined errors with their gRPC status codes, matching the HTTP status codes of errorStatuses in
+// the HTTP handler. An error that wraps several defined errors gets the code of the first one listed
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{domain.ErrDataNotFound, codes.NotFound},
+	{domain.ErrConflictingData, codes.AlreadyExists},
+	{domain.ErrVersionConflict, codes.Aborted},
+	{domain.ErrAliasConflict, codes.Aborted},
+	{domain.ErrSchemaInUse, codes.FailedPrecondition},
+	{domain.ErrConfigurationNotDeleted, codes.FailedPrecondition},
+	{domain.ErrApiKeyRevoked, codes.FailedPrecondition},
+	{domain.ErrNamespaceNotEmpty, codes.FailedPrecondition},
+	{domain.ErrDefaultNamespace, codes.FailedPrecondition},
+	{domain.ErrNamespaceNotFound, codes.NotFound},
+	{domain.ErrInvalidNamespace, codes.InvalidArgument},
+	{domain.ErrInvalidEnvironment, codes.InvalidArgument},
+	{domain.ErrInvalidPromotion, codes.InvalidArgument},
+	{domain.ErrInvalidSort, codes.InvalidArgument},
+	{domain.ErrInvalidCursor, codes.InvalidArgument},
+	{domain.ErrInvalidQuery, codes.InvalidArgument},
+	{domain.ErrInvalidPredicate, codes.InvalidArgument},
+	{domain.ErrInvalidSelector, codes.InvalidArgument},
+	{domain.ErrInvalidLabel, codes.InvalidArgument},
+	{domain.ErrInvalidAnnotation, codes.InvalidArgument},
+	{domain.ErrInvalidAlias, codes.InvalidArgument},
+	{domain.ErrInvalidCredentials, codes.Unauthenticated},
+	{domain.ErrUnauthorized, codes.Unauthenticated},
+	{domain.ErrEmptyAuthorizationHeader, codes.Unauthenticated},
+	{domain.ErrInvalidAuthorizationHeader, codes.Unauthenticated},
+	{domain.ErrInvalidAuthorizationType, codes.Unauthenticated},
+	{domain.ErrInvalidToken, codes.Unauthenticated},
+	{domain.ErrExpiredToken, codes.Unauthenticated},
+	{domain.ErrForbidden, codes.PermissionDenied},
+	{domain.ErrRevisionUnavailable, codes.OutOfRange},
+	{domain.ErrNoUpdatedData, codes.InvalidArgument},
+	{domain.ErrInvalidSchema, codes.InvalidArgument},
+	{domain.ErrInvalidSchemaDefinition, codes.InvalidArgument},
+	{domain.ErrInvalidPatch, codes.InvalidArgument},
+	{domain.ErrUnsupportedDiffFormat, codes.InvalidArgument},
+	{domain.ErrInvalidApiKey, codes.InvalidArgument},
+	{domain.ErrPatchTestFailed, codes.FailedPrecondition},
+	{domain.ErrUnsupportedPatchType, codes.InvalidArgument},
+	{domain.ErrInsufficientStock, codes.InvalidArgument},
+	{domain.ErrInsufficientPayment, codes.InvalidArgument},
+	{domain.ErrInternal, codes.Internal},
+}
+
+// errorCode determines the gRPC status code of an error
+func errorCode(err error) codes.Code {
+	// Wrapped errors carry the cause of a defined error in their message, errors.Is finds it
+	for _, defined := range errorCodes {
+		if errors.Is(err, defined.err) {
+			return defined.code
+		}
+	}
+
+	return codes.Internal
+}
+
+// handleError converts an error into a gRPC status error, attaching the details of errors that carry more than a message
+func handleError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range validationErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violationField(v.Pointer),
+				Description: v.Message,
+				Reason:      v.Keyword,
+			})
+		}
+
+		st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+		if detailsErr != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return st.Err()
+	}
+
+	return status.Error(errorCode(err), err.Error())
+}
+
+// violationField turns the JSON pointer of a violation into the path of the request field, such as value.address.city
+func violationField(pointer string) string {
+	if pointer == "" {
+		return "value"
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return "value." + strings.Join(tokens, ".")
+}
+
+// validationError returns an error for a malformed request
+func validationError(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"runtime/debug"
+	"time"
+
+	cmsv1 "github.com/arifMasnandar/go-config-management-service/api/cms/v1"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a wrapper for gRPC server
+type Server struct {
+	*grpc.Server
+}
+
+// NewServer creates a new gRPC server
+func NewServer(
+	config *config.GRPC,
+	token port.TokenService,
+	apiKeys service.ApiKeyServicer,
+	configurationHandler *ConfigurationHandler,
+) (*Server, error) {
+	unary := []grpc.UnaryServerInterceptor{logUnary, recoverUnary}
+	stream := []grpc.StreamServerInterceptor{logStream, recoverStream}
+
+	// Authenticate every call and check the scopes of API keys. Calls are only served unauthenticated when there are
+	// no credentials to check at all, neither tokens nor API keys
+	if token != nil || apiKeys != nil {
+		unary = append(unary, authUnary(token, apiKeys))
+		stream = append(stream, authStream(token, apiKeys))
+	}
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+
+	cmsv1.RegisterConfigurationServiceServer(server, configurationHandler)
+
+	// Let tools such as grpcurl discover the services outside of production
+	if config.Env != "production" {
+		reflection.Register(server)
+	}
+
+	return &Server{
+		server,
+	}, nil
+}
+
+// Serve starts the gRPC server
+func (s *Server) Serve(listenAddr string) error {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	return s.Server.Serve(listener)
+}
+
+// Shutdown stops the gRPC server from accepting calls and waits for those in flight until ctx is done, when the calls
+// still running, such as watches, are cancelled
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.Stop()
+		return ctx.Err()
+	}
+}
+
+// logUnary logs every unary call with its status code and latency
+func logUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	rsp, err := handler(ctx, req)
+	logCall(info.FullMethod, start, err)
+
+	return rsp, err
+}
+
+// logStream logs every streaming call with its status code and duration
+func logStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	logCall(info.FullMethod, start, err)
+
+	return err
+}
+
+func logCall(method string, start time.Time, err error) {
+	code := status.Code(err)
+
+	level := slog.LevelInfo
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		level = slog.LevelError
+	}
+
+	slog.Log(context.Background(), level, "gRPC call", "method", method, "code", code.String(), "latency", time.Since(start))
+}
+
+// recoverUnary turns a panic of a unary handler into an internal error, so that the server keeps running
+func recoverUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (rsp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// recoverStream turns a panic of a streaming handler into an internal error, so that the server keeps running
+func recoverStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(info.FullMethod, r)
+		}
+	}()
+
+	return handler(srv, stream)
+}
+
+func recovered(method string, r any) error {
+	slog.Error("Panic handling gRPC call", "method", method, "panic", r, "stack", string(debug.Stack()))
+	return status.Error(codes.Internal, "internal error")
+}
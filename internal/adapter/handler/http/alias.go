@@ -0,0 +1,282 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errMismatchedExpectedRevision is returned when the If-Match header and the expected_revision field disagree
+var errMismatchedExpectedRevision = errors.New("If-Match header and expected_revision field refer to different revisions")
+
+type aliasRequestUri struct {
+	Name  string `uri:"name" binding:"required" example:"person_config"`
+	Alias string `uri:"alias" binding:"required" example:"stable"`
+}
+
+type moveConfigurationAliasRequestJson struct {
+	Version          int    `json:"version" binding:"required,min=1" example:"12"`             // Version the alias is moved to
+	ExpectedRevision int    `json:"expected_revision,omitempty" binding:"min=0" example:"2"`   // Optional, the revision of the alias this move replaces
+	ChangeMessage    string `json:"change_message,omitempty" example:"Release the new limits"` // Optional, why the alias is moved, takes precedence over the X-Change-Message header
+}
+
+// MoveConfigurationAlias godoc
+//
+//	@Summary		Move an alias of a configuration to a version
+//	@Description	Point an alias such as stable or canary at a version of a configuration, creating the alias if it doesn't exist. Every move is kept as a revision of the alias, recording who moved it and why.
+//	@Description	Aliases are 1 to 63 lowercase letters, digits, '-' or '_', starting and ending with a letter or digit.
+//	@Description	Send the ETag of the revision being replaced in the If-Match header, or its number in expected_revision, to fail with 409 if someone else has moved the alias since.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns					path		string								false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name				path		string								true	"Configuration name"											example:"person_config"
+//	@Param			alias				path		string								true	"Alias name"													example:"stable"
+//	@Param			If-Match			header		string								false	"ETag of the revision being replaced"
+//	@Param			X-Change-Message	header		string								false	"Why the alias is moved"
+//	@Param			aliasRequest		body		moveConfigurationAliasRequestJson	true	"Alias request"
+//	@Success		200					{object}	aliasResponse						"Alias moved"
+//	@Header			200					{string}	ETag								"Revision of the alias"
+//	@Failure		400					{object}	errorResponse						"Validation error"
+//	@Failure		401					{object}	errorResponse						"Unauthorized error"
+//	@Failure		403					{object}	errorResponse						"Forbidden error"
+//	@Failure		404					{object}	errorResponse						"Data not found error"
+//	@Failure		409					{object}	errorResponse						"Data conflict error"
+//	@Failure		500					{object}	errorResponse						"Internal server error"
+//	@Router			/cms/configs/{name}/aliases/{alias} [put]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/aliases/{alias} [put]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) MoveConfigurationAlias(ctx *gin.Context) {
+	var reqUri aliasRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqJson moveConfigurationAliasRequestJson
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	expectedRevision, err := expectedRevision(ctx, reqJson.ExpectedRevision)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, reqJson.ChangeMessage)
+
+	alias, err := ch.svc.MoveConfigurationAlias(ctx, namespaceParam(ctx), reqUri.Name, reqUri.Alias, reqJson.Version, expectedRevision)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, alias.Revision)
+	rsp := newAliasResponse(alias)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ClearConfigurationAlias godoc
+//
+//	@Summary		Clear an alias of a configuration
+//	@Description	Point an alias at no version, the clearing is kept as a revision of the alias like a move.
+//	@Description	Send the ETag of the revision being replaced in the If-Match header to fail with 409 if someone else has moved the alias since.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns					path		string			false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name				path		string			true	"Configuration name"											example:"person_config"
+//	@Param			alias				path		string			true	"Alias name"													example:"canary"
+//	@Param			If-Match			header		string			false	"ETag of the revision being replaced"
+//	@Param			X-Change-Message	header		string			false	"Why the alias is cleared"
+//	@Success		200					{object}	aliasResponse	"Alias cleared"
+//	@Header			200					{string}	ETag			"Revision of the alias"
+//	@Failure		400					{object}	errorResponse	"Validation error"
+//	@Failure		401					{object}	errorResponse	"Unauthorized error"
+//	@Failure		403					{object}	errorResponse	"Forbidden error"
+//	@Failure		404					{object}	errorResponse	"Data not found error"
+//	@Failure		409					{object}	errorResponse	"Data conflict error"
+//	@Failure		500					{object}	errorResponse	"Internal server error"
+//	@Router			/cms/configs/{name}/aliases/{alias} [delete]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/aliases/{alias} [delete]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) ClearConfigurationAlias(ctx *gin.Context) {
+	var reqUri aliasRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	expectedRevision, err := parseIfMatch(ctx)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, "")
+
+	alias, err := ch.svc.ClearConfigurationAlias(ctx, namespaceParam(ctx), reqUri.Name, reqUri.Alias, expectedRevision)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, alias.Revision)
+	rsp := newAliasResponse(alias)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetConfigurationAlias godoc
+//
+//	@Summary		Resolve an alias of a configuration
+//	@Description	Retrieve an alias of a configuration with the version it points at.
+//	@Description	With env the value is the one the environment saw in that version.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns		path		string			false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name	path		string			true	"Configuration name"											example:"person_config"
+//	@Param			alias	path		string			true	"Alias name"													example:"stable"
+//	@Param			env		query		string			false	"Environment whose effective value is returned"					example:"staging"
+//	@Success		200		{object}	aliasResponse	"Alias found"
+//	@Header			200		{string}	ETag			"Revision of the alias, to send in If-Match when moving it"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/configs/{name}/aliases/{alias} [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/aliases/{alias} [get]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) GetConfigurationAlias(ctx *gin.Context) {
+	var reqUri aliasRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	environment, err := environmentQuery(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	alias, config, err := ch.svc.GetConfigurationAlias(ctx, namespaceParam(ctx), reqUri.Name, reqUri.Alias)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, alias.Revision)
+	rsp := newAliasResponse(alias)
+	configRsp := newConfigResponse(forEnvironment(config, environment))
+	rsp.Config = &configRsp
+
+	handleSuccess(ctx, rsp)
+}
+
+type listConfigurationAliasesRequestUri struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+// ListConfigurationAliases godoc
+//
+//	@Summary		Retrieve the aliases of a configuration
+//	@Description	Retrieve the aliases of a configuration that point at a version, ordered by alias.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns		path		string			false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name	path		string			true	"Configuration name"											example:"person_config"
+//	@Success		200		{object}	aliasResponse	"Aliases found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/configs/{name}/aliases [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/aliases [get]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) ListConfigurationAliases(ctx *gin.Context) {
+	var reqUri listConfigurationAliasesRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	aliases, err := ch.svc.ListConfigurationAliases(ctx, namespaceParam(ctx), reqUri.Name)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	var aliasesList []aliasResponse
+	for _, alias := range aliases {
+		aliasesList = append(aliasesList, newAliasResponse(alias))
+	}
+
+	rsp := map[string]any{"aliases": aliasesList}
+
+	handleSuccess(ctx, rsp)
+}
+
+type listConfigurationAliasRevisionsRequestForm struct {
+	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+}
+
+// ListConfigurationAliasRevisions godoc
+//
+//	@Summary		Retrieve the revision history of an alias of a configuration
+//	@Description	Retrieve every move of an alias of a configuration, oldest first, including those that cleared it, with who moved it and why.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns		path		string			false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name	path		string			true	"Configuration name"											example:"person_config"
+//	@Param			alias	path		string			true	"Alias name"													example:"stable"
+//	@Param			skip	query		int				false	"Starting offset"												example:"0"
+//	@Param			limit	query		int				true	"Page size"														example:"5"
+//	@Success		200		{object}	aliasResponse	"Alias revisions found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/configs/{name}/aliases/{alias}/revisions [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/aliases/{alias}/revisions [get]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) ListConfigurationAliasRevisions(ctx *gin.Context) {
+	var reqUri aliasRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqForm listConfigurationAliasRevisionsRequestForm
+	if err := ctx.ShouldBindQuery(&reqForm); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	revisions, err := ch.svc.ListConfigurationAliasRevisions(ctx, namespaceParam(ctx), reqUri.Name, reqUri.Alias, reqForm.Skip, reqForm.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	var revisionsList []aliasResponse
+	for _, revision := range revisions {
+		revisionsList = append(revisionsList, newAliasResponse(revision))
+	}
+
+	total := uint64(len(revisionsList))
+	meta := newMeta(total, reqForm.Limit, reqForm.Skip)
+	rsp := toMap(meta, revisionsList, "revisions")
+
+	handleSuccess(ctx, rsp)
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ApiKeyHandler represents the HTTP handler for API key-related requests
+type ApiKeyHandler struct {
+	svc service.ApiKeyServicer
+}
+
+// NewApiKeyHandler creates a new ApiKeyHandler instance
+func NewApiKeyHandler(svc service.ApiKeyServicer) *ApiKeyHandler {
+	return &ApiKeyHandler{
+		svc,
+	}
+}
+
+type createApiKeyRequest struct {
+	Name      string     `json:"name" binding:"required" example:"ci-deploy"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,oneof=read write admin" example:"read,write"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2024-10-01T12:00:00Z"` // Optional, the key doesn't expire if unset
+}
+
+// CreateApiKey godoc
+//
+//	@Summary		Create an API key
+//	@Description	Create a long-lived API key for a machine client, such as a CI job. The key is only returned by this request, keep it safe.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			createApiKeyRequest	body		createApiKeyRequest	true	"API key to create"
+//	@Success		200					{object}	apiKeyResponse		"API key created"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/cms/admin/apikeys [post]
+//	@Security		BearerAuth
+func (ah *ApiKeyHandler) CreateApiKey(ctx *gin.Context) {
+	var req createApiKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	key := &domain.ApiKey{
+		Name: req.Name,
+	}
+	for _, scope := range req.Scopes {
+		key.Scopes = append(key.Scopes, domain.ApiKeyScope(scope))
+	}
+	if req.ExpiresAt != nil {
+		key.ExpiresAt = *req.ExpiresAt
+	}
+
+	created, plaintext, err := ah.svc.CreateApiKey(ctx, key)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newApiKeyResponse(created, plaintext)
+
+	handleSuccess(ctx, rsp)
+}
+
+type listApiKeysRequest struct {
+	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+}
+
+// ListApiKeys godoc
+//
+//	@Summary		Retrieve API key list
+//	@Description	Retrieve a list of API keys, oldest first, with pagination support. Revoked keys are listed too, the keys themselves never are.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		int				false	"Starting offset"	example:"0"
+//	@Param			limit	query		int				true	"Page size"			example:"5"
+//	@Success		200		{object}	apiKeyResponse	"API keys found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/admin/apikeys [get]
+//	@Security		BearerAuth
+func (ah *ApiKeyHandler) ListApiKeys(ctx *gin.Context) {
+	var req listApiKeysRequest
+	var keysList []apiKeyResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	keys, err := ah.svc.ListApiKeys(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, key := range keys {
+		keysList = append(keysList, newApiKeyResponse(key, ""))
+	}
+
+	total := uint64(len(keysList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, keysList, "apikeys")
+
+	handleSuccess(ctx, rsp)
+}
+
+type apiKeyRequest struct {
+	ID string `uri:"id" binding:"required" example:"9f86d081884c7d65"`
+}
+
+// RotateApiKey godoc
+//
+//	@Summary		Rotate an API key
+//	@Description	Replace the secret of an API key, keeping its name, scopes and expiry. The old key stops working at once, the new one is only returned by this request.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string			true	"API key ID"	example:"9f86d081884c7d65"
+//	@Success		200	{object}	apiKeyResponse	"API key rotated"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"API key revoked error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/cms/admin/apikeys/{id}/rotate [post]
+//	@Security		BearerAuth
+func (ah *ApiKeyHandler) RotateApiKey(ctx *gin.Context) {
+	var req apiKeyRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	rotated, plaintext, err := ah.svc.RotateApiKey(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newApiKeyResponse(rotated, plaintext)
+
+	handleSuccess(ctx, rsp)
+}
+
+// RevokeApiKey godoc
+//
+//	@Summary		Revoke an API key
+//	@Description	Revoke an API key for good, e.g. when it leaked. Requests with the key are rejected from now on.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string			true	"API key ID"	example:"9f86d081884c7d65"
+//	@Success		200	{object}	apiKeyResponse	"API key revoked"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"API key revoked error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/cms/admin/apikeys/{id}/revoke [post]
+//	@Security		BearerAuth
+func (ah *ApiKeyHandler) RevokeApiKey(ctx *gin.Context) {
+	var req apiKeyRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	revoked, err := ah.svc.RevokeApiKey(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newApiKeyResponse(revoked, "")
+
+	handleSuccess(ctx, rsp)
+}
@@ -1,6 +1,10 @@
 package http
 
 import (
+	"errors"
+	"net/http"
+	"time"
+
 	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
 	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
 	"github.com/gin-gonic/gin"
@@ -23,56 +27,373 @@ type putConfigurationRequestUri struct {
 }
 
 type putConfigurationRequestJson struct {
-	Type  string                 `json:"type" binding:"required" example:"person"`
-	Value map[string]interface{} `json:"value" swaggertype:"object,string" binding:"required" example:"name:John Doe,age:[remove qoute]99[remove qoute]"`
+	Type            string                 `json:"type" binding:"required" example:"person"`
+	Value           map[string]interface{} `json:"value" swaggertype:"object,string" binding:"required" example:"name:John Doe,age:[remove qoute]99[remove qoute]"`
+	ExpectedVersion int                    `json:"expected_version,omitempty" binding:"min=0" example:"1"` // Optional, the version this request replaces
+	ChangeMessage   string                 `json:"change_message,omitempty" example:"Raise the age limit"` // Optional, why the configuration changes, takes precedence over the X-Change-Message header
+	Labels          map[string]string      `json:"labels,omitempty" example:"team:payments,tier:critical"` // Optional, replaces the labels, they are kept if left out
+	Annotations     map[string]string      `json:"annotations,omitempty" example:"owner:payments-oncall"`  // Optional, replaces the annotations, they are kept if left out
+}
+
+// errMismatchedExpectedVersion is returned when the If-Match header and the expected_version field disagree
+var errMismatchedExpectedVersion = errors.New("If-Match header and expected_version field refer to different versions")
+
+type putConfigurationRequestForm struct {
+	DryRun bool `form:"dry_run" example:"false"` // Optional, validate the request without storing it
+}
+
+// bindPutConfiguration binds a create or replace request, returning the config and the version it replaces
+func bindPutConfiguration(ctx *gin.Context) (*domain.Config, int, bool) {
+	var reqUri putConfigurationRequestUri
+
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return nil, 0, false
+	}
+
+	var reqJson putConfigurationRequestJson
+
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		validationError(ctx, err)
+		return nil, 0, false
+	}
+
+	expectedVersion, err := expectedVersion(ctx, reqJson.ExpectedVersion)
+	if err != nil {
+		validationError(ctx, err)
+		return nil, 0, false
+	}
+
+	setChangeMessage(ctx, reqJson.ChangeMessage)
+
+	config := &domain.Config{
+		Namespace:   namespaceParam(ctx),
+		Name:        reqUri.Name,
+		Type:        reqJson.Type,
+		Value:       reqJson.Value,
+		Labels:      reqJson.Labels,
+		Annotations: reqJson.Annotations,
+	}
+
+	return config, expectedVersion, true
 }
 
 // PutConfiguration godoc
 //
 //	@Summary		Create a new configuration or replace an existing one
-//	@Description	Create a new configuration with the specified name and value, or replace an existing
+//	@Description	Create a new configuration with the specified name and value, or replace an existing.
+//	@Description	Send the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.
+//	@Description	With dry_run=true the request is only validated, and the response is the same as the validate endpoint's.
 //	@Tags			Configurations
 //	@Accept			json
 //	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
 //	@Param			name					path		string						true	"Configuration name"	example:"person_config"
+//	@Param			dry_run					query		bool						false	"Validate without storing"
+//	@Param			If-Match				header		string						false	"ETag of the version being replaced"
+//	@Param			X-Change-Message		header		string						false	"Why the configuration changes"
 //	@Param			createCategoryRequest	body		putConfigurationRequestJson	true	"Create or Replace Configuration request"
 //	@Success		200						{object}	configurationResponse		"Configuration created"
+//	@Header			200						{string}	ETag						"Version of the created configuration"
 //	@Failure		400						{object}	errorResponse				"Validation error"
 //	@Failure		401						{object}	errorResponse				"Unauthorized error"
 //	@Failure		403						{object}	errorResponse				"Forbidden error"
 //	@Failure		404						{object}	errorResponse				"Data not found error"
 //	@Failure		409						{object}	errorResponse				"Data conflict error"
+//	@Failure		422						{object}	errorResponse				"Schema validation error, details lists every violation"
 //	@Failure		500						{object}	errorResponse				"Internal server error"
 //	@Router			/cms/configs/{name} [put]
+//	@Router			/cms/namespaces/{ns}/configs/{name} [put]
 //	@Security		BearerAuth
 func (ch *ConfigurationHandler) PutConfiguration(ctx *gin.Context) {
-	var reqUri putConfigurationRequestUri
+	var reqForm putConfigurationRequestForm
+	if err := ctx.ShouldBindQuery(&reqForm); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	config, expectedVersion, ok := bindPutConfiguration(ctx)
+	if !ok {
+		return
+	}
 
+	if reqForm.DryRun {
+		ch.validateConfiguration(ctx, config, expectedVersion)
+		return
+	}
+
+	createdConfig, err := ch.svc.PutConfiguration(ctx, config, expectedVersion)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, createdConfig.Version)
+	rsp := newConfigResponse(createdConfig)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ValidateConfiguration godoc
+//
+//	@Summary		Validate a configuration without storing it
+//	@Description	Run every check of creating or replacing a configuration (schema lookup, value validation and the If-Match or expected_version check) without storing it.
+//	@Description	A value that doesn't match its schema is reported with valid=false and its violations, a valid one with the version that would be created.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name					path		string						true	"Configuration name"	example:"person_config"
+//	@Param			If-Match				header		string						false	"ETag of the version being replaced"
+//	@Param			validateRequest			body		putConfigurationRequestJson	true	"Create or Replace Configuration request"
+//	@Success		200						{object}	validationResponse			"Validation result"
+//	@Failure		400						{object}	errorResponse				"Validation error"
+//	@Failure		401						{object}	errorResponse				"Unauthorized error"
+//	@Failure		403						{object}	errorResponse				"Forbidden error"
+//	@Failure		409						{object}	errorResponse				"Data conflict error"
+//	@Failure		500						{object}	errorResponse				"Internal server error"
+//	@Router			/cms/configs/{name}/validate [post]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/validate [post]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) ValidateConfiguration(ctx *gin.Context) {
+	config, expectedVersion, ok := bindPutConfiguration(ctx)
+	if !ok {
+		return
+	}
+
+	ch.validateConfiguration(ctx, config, expectedVersion)
+}
+
+// validateConfiguration sends the result of validating a create or replace request
+func (ch *ConfigurationHandler) validateConfiguration(ctx *gin.Context, config *domain.Config, expectedVersion int) {
+	validatedConfig, err := ch.svc.ValidateConfiguration(ctx, config, expectedVersion)
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		handleSuccess(ctx, newInvalidResponse(validationErr))
+		return
+	}
+
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, newValidResponse(validatedConfig))
+}
+
+type patchConfigurationRequestUri struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+// patchTypes maps the media type of a patch document to its kind
+var patchTypes = map[string]domain.PatchType{
+	"application/merge-patch+json": domain.PatchTypeMerge,
+	"application/json-patch+json":  domain.PatchTypeJSON,
+}
+
+// PatchConfiguration godoc
+//
+//	@Summary		Update the value of a configuration with a patch
+//	@Description	Apply a JSON Merge Patch (RFC 7396, Content-Type application/merge-patch+json) or a JSON Patch (RFC 6902, Content-Type application/json-patch+json)
+//	@Description	to the value of the latest version of a configuration, validate the result against the schema of its type and store it as a new version.
+//	@Description	Send the ETag of the version being patched in the If-Match header to fail with 409 if someone else has written a newer version. A failed JSON Patch test operation is also a 409.
+//	@Tags			Configurations
+//	@Accept			application/merge-patch+json,application/json-patch+json
+//	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name		path		string					true	"Configuration name"	example:"person_config"
+//	@Param			If-Match	header		string					false	"ETag of the version being patched"
+//	@Param			X-Change-Message	header		string					false	"Why the configuration changes"
+//	@Param			patch		body		object					true	"Merge patch object or JSON Patch operations array"
+//	@Success		200			{object}	configurationResponse	"Configuration patched"
+//	@Header			200			{string}	ETag					"Version of the created configuration"
+//	@Failure		400			{object}	errorResponse			"Validation error"
+//	@Failure		401			{object}	errorResponse			"Unauthorized error"
+//	@Failure		403			{object}	errorResponse			"Forbidden error"
+//	@Failure		404			{object}	errorResponse			"Data not found error"
+//	@Failure		409			{object}	errorResponse			"Data conflict error"
+//	@Failure		415			{object}	errorResponse			"Unsupported patch type error"
+//	@Failure		422			{object}	errorResponse			"Schema validation error, details lists every violation"
+//	@Failure		500			{object}	errorResponse			"Internal server error"
+//	@Router			/cms/configs/{name} [patch]
+//	@Router			/cms/namespaces/{ns}/configs/{name} [patch]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) PatchConfiguration(ctx *gin.Context) {
+	var reqUri patchConfigurationRequestUri
 	if err := ctx.ShouldBindUri(&reqUri); err != nil {
 		validationError(ctx, err)
 		return
 	}
 
-	var reqJson putConfigurationRequestJson
+	patchType, ok := patchTypes[ctx.ContentType()]
+	if !ok {
+		handleError(ctx, domain.ErrUnsupportedPatchType)
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(ctx)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
 
+	patch, err := ctx.GetRawData()
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, "")
+
+	patchedConfig, err := ch.svc.PatchConfiguration(ctx, namespaceParam(ctx), reqUri.Name, patchType, patch, expectedVersion)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, patchedConfig.Version)
+	rsp := newConfigResponse(patchedConfig)
+
+	handleSuccess(ctx, rsp)
+}
+
+type putConfigurationOverlayRequestUri struct {
+	Name        string `uri:"name" binding:"required" example:"person_config"`
+	Environment string `uri:"env" binding:"required" example:"staging"`
+}
+
+type putConfigurationOverlayRequestJson struct {
+	Value           map[string]interface{} `json:"value" swaggertype:"object,string" binding:"required" example:"age:[remove qoute]30[remove qoute]"` // Merge patch over the base value, empty to remove the overlay
+	ExpectedVersion int                    `json:"expected_version,omitempty" binding:"min=0" example:"1"`                                            // Optional, the version this request replaces
+	ChangeMessage   string                 `json:"change_message,omitempty" example:"Raise the age in staging"`                                       // Optional, why the overlay changes, takes precedence over the X-Change-Message header
+}
+
+// PutConfigurationOverlay godoc
+//
+//	@Summary		Replace the overlay of an environment
+//	@Description	Replace the overlay of an environment on the latest version of a configuration and store the result as a new version.
+//	@Description	The overlay is a JSON Merge Patch (RFC 7396) over the base value, the environment sees the merged value, which is validated against the schema of the type. An empty overlay removes it.
+//	@Description	Send the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns					path		string								false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name				path		string								true	"Configuration name"											example:"person_config"
+//	@Param			env					path		string								true	"Environment name"												example:"staging"
+//	@Param			If-Match			header		string								false	"ETag of the version being replaced"
+//	@Param			X-Change-Message	header		string								false	"Why the overlay changes"
+//	@Param			overlayRequest		body		putConfigurationOverlayRequestJson	true	"Overlay request"
+//	@Success		200					{object}	configurationResponse				"Overlay replaced"
+//	@Header			200					{string}	ETag								"Version of the created configuration"
+//	@Failure		400					{object}	errorResponse						"Validation error"
+//	@Failure		401					{object}	errorResponse						"Unauthorized error"
+//	@Failure		403					{object}	errorResponse						"Forbidden error"
+//	@Failure		404					{object}	errorResponse						"Data not found error"
+//	@Failure		409					{object}	errorResponse						"Data conflict error"
+//	@Failure		422					{object}	errorResponse						"Schema validation error, details lists every violation"
+//	@Failure		500					{object}	errorResponse						"Internal server error"
+//	@Router			/cms/configs/{name}/environments/{env} [put]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/environments/{env} [put]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) PutConfigurationOverlay(ctx *gin.Context) {
+	var reqUri putConfigurationOverlayRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqJson putConfigurationOverlayRequestJson
 	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
 		validationError(ctx, err)
 		return
 	}
 
-	config := &domain.Config{
-		Name:  reqUri.Name,
-		Type:  reqJson.Type,
-		Value: reqJson.Value,
+	expectedVersion, err := expectedVersion(ctx, reqJson.ExpectedVersion)
+	if err != nil {
+		validationError(ctx, err)
+		return
 	}
 
-	createdConfig, err := ch.svc.PutConfiguration(ctx, config)
+	setChangeMessage(ctx, reqJson.ChangeMessage)
+
+	config, err := ch.svc.PutConfigurationOverlay(ctx, namespaceParam(ctx), reqUri.Name, reqUri.Environment, reqJson.Value, expectedVersion)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newConfigResponse(createdConfig)
+	setETag(ctx, config.Version)
+	rsp := newConfigResponse(config)
+
+	handleSuccess(ctx, rsp)
+}
+
+type putConfigurationLabelsRequestUri struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+type putConfigurationLabelsRequestJson struct {
+	Labels          map[string]string `json:"labels,omitempty" example:"team:payments,tier:critical"`            // Optional, replaces the labels, empty to remove them and left out to keep them
+	Annotations     map[string]string `json:"annotations,omitempty" example:"owner:payments-oncall"`             // Optional, replaces the annotations, empty to remove them and left out to keep them
+	ExpectedVersion int               `json:"expected_version,omitempty" binding:"min=0" example:"1"`            // Optional, the version this request replaces
+	ChangeMessage   string            `json:"change_message,omitempty" example:"Hand over to the payments team"` // Optional, why the labels change, takes precedence over the X-Change-Message header
+}
+
+// PutConfigurationLabels godoc
+//
+//	@Summary		Replace the labels and annotations of a configuration
+//	@Description	Replace the labels and the annotations of the latest version of a configuration and store the result as a new version with the same value.
+//	@Description	Label keys are a name of up to 63 letters, digits, '-', '_' or '.', optionally prefixed by a DNS subdomain and '/', and values are such a name or empty. Annotation values are free-form.
+//	@Description	Send the ETag of the version being replaced in the If-Match header, or its number in expected_version, to fail with 409 if someone else has written a newer version.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns					path		string								false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name				path		string								true	"Configuration name"											example:"person_config"
+//	@Param			If-Match			header		string								false	"ETag of the version being replaced"
+//	@Param			X-Change-Message	header		string								false	"Why the labels change"
+//	@Param			labelsRequest		body		putConfigurationLabelsRequestJson	true	"Labels request"
+//	@Success		200					{object}	configurationResponse				"Labels replaced"
+//	@Header			200					{string}	ETag								"Version of the created configuration"
+//	@Failure		400					{object}	errorResponse						"Validation error"
+//	@Failure		401					{object}	errorResponse						"Unauthorized error"
+//	@Failure		403					{object}	errorResponse						"Forbidden error"
+//	@Failure		404					{object}	errorResponse						"Data not found error"
+//	@Failure		409					{object}	errorResponse						"Data conflict error"
+//	@Failure		500					{object}	errorResponse						"Internal server error"
+//	@Router			/cms/configs/{name}/labels [put]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/labels [put]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) PutConfigurationLabels(ctx *gin.Context) {
+	var reqUri putConfigurationLabelsRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqJson putConfigurationLabelsRequestJson
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	expectedVersion, err := expectedVersion(ctx, reqJson.ExpectedVersion)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, reqJson.ChangeMessage)
+
+	config, err := ch.svc.PutConfigurationLabels(ctx, namespaceParam(ctx), reqUri.Name, reqJson.Labels, reqJson.Annotations, expectedVersion)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, config.Version)
+	rsp := newConfigResponse(config)
 
 	handleSuccess(ctx, rsp)
 }
@@ -81,22 +402,45 @@ type getConfigurationRequest struct {
 	Name string `uri:"name" binding:"required" example:"app_config"`
 }
 
+type getConfigurationRequestForm struct {
+	WaitForVersionGT *int          `form:"wait_for_version_gt" binding:"omitempty,min=0" example:"3"`                    // Optional, wait until a version newer than this exists
+	Timeout          time.Duration `form:"timeout" binding:"omitempty,min=1s,max=5m" swaggertype:"string" example:"30s"` // Optional, how long to wait, defaults to 30s
+	Alias            string        `form:"alias" example:"stable"`                                                       // Optional, read the version an alias points at instead of the latest
+}
+
+// errAliasWithWait is returned when a read asks both for the version of an alias and to wait for a newer version
+var errAliasWithWait = errors.New("alias can't be combined with wait_for_version_gt")
+
+// defaultWaitTimeout is how long a long-polling request waits for a newer version when it doesn't say
+const defaultWaitTimeout = 30 * time.Second
+
 // GetConfiguration godoc
 //
 //	@Summary		Retrieve the latest version of a configuration
-//	@Description	Retrieve the latest version of a configuration by its name
+//	@Description	Retrieve the latest version of a configuration by its name.
+//	@Description	With wait_for_version_gt the request long-polls: it waits until a version newer than the given one exists, or responds with 304 once the timeout elapses.
+//	@Description	With env the value is the one the environment sees, the base value with the overlay of the environment merged over it.
+//	@Description	With alias the version the alias points at is returned instead of the latest one, it can't be combined with wait_for_version_gt.
 //	@Tags			Configurations
 //	@Accept			json
 //	@Produce		json
-//	@Param			name	path		string					true	"Configuration name"	example:"person_config"
-//	@Success		200		{object}	configurationResponse	"Configuration found"
-//	@Failure		400		{object}	errorResponse			"Validation error"
-//	@Failure		401		{object}	errorResponse			"Unauthorized error"
-//	@Failure		403		{object}	errorResponse			"Forbidden error"
-//	@Failure		404		{object}	errorResponse			"Data not found error"
-//	@Failure		409		{object}	errorResponse			"Data conflict error"
-//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name				path		string					true	"Configuration name"							example:"person_config"
+//	@Param			wait_for_version_gt	query		int						false	"Wait for a version newer than this one"		example:"3"
+//	@Param			timeout				query		string					false	"How long to wait, from 1s to 5m, default 30s"	example:"30s"
+//	@Param			env					query		string					false	"Environment whose effective value is returned"	example:"staging"
+//	@Param			alias				query		string					false	"Alias whose version is returned"				example:"stable"
+//	@Success		200					{object}	configurationResponse	"Configuration found"
+//	@Header			200					{string}	ETag					"Version of the configuration, to send in If-Match when replacing it, left out when read through an alias"
+//	@Success		304					"No newer version before the timeout"
+//	@Failure		400					{object}	errorResponse			"Validation error"
+//	@Failure		401					{object}	errorResponse			"Unauthorized error"
+//	@Failure		403					{object}	errorResponse			"Forbidden error"
+//	@Failure		404					{object}	errorResponse			"Data not found error"
+//	@Failure		409					{object}	errorResponse			"Data conflict error"
+//	@Failure		500					{object}	errorResponse			"Internal server error"
 //	@Router			/cms/configs/{name} [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name} [get]
 //	@Security		BearerAuth
 func (ch *ConfigurationHandler) GetConfiguration(ctx *gin.Context) {
 	var req getConfigurationRequest
@@ -105,31 +449,124 @@ func (ch *ConfigurationHandler) GetConfiguration(ctx *gin.Context) {
 		return
 	}
 
-	config, err := ch.svc.GetConfiguration(ctx, req.Name)
+	var reqForm getConfigurationRequestForm
+	if err := ctx.ShouldBindQuery(&reqForm); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	environment, err := environmentQuery(ctx)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newConfigResponse(config)
+	if reqForm.Alias != "" && reqForm.WaitForVersionGT != nil {
+		validationError(ctx, errAliasWithWait)
+		return
+	}
+
+	var config *domain.Config
+	if reqForm.Alias != "" {
+		_, config, err = ch.svc.GetConfigurationAlias(ctx, namespaceParam(ctx), req.Name, reqForm.Alias)
+	} else if reqForm.WaitForVersionGT != nil {
+		timeout := reqForm.Timeout
+		if timeout == 0 {
+			timeout = defaultWaitTimeout
+		}
+
+		// The request context is done when the client disconnects, which ends the wait
+		config, err = ch.svc.WaitForConfiguration(ctx.Request.Context(), namespaceParam(ctx), req.Name, *reqForm.WaitForVersionGT, timeout)
+	} else {
+		config, err = ch.svc.GetConfiguration(ctx, namespaceParam(ctx), req.Name)
+	}
+	if err == domain.ErrNotModified {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	// The version of an alias can change without the configuration changing, and a write is checked against the
+	// latest version, so the version an alias points at isn't a validator of the response
+	if reqForm.Alias == "" {
+		setETag(ctx, config.Version)
+	}
+	rsp := newConfigResponse(forEnvironment(config, environment))
 
 	handleSuccess(ctx, rsp)
 }
 
 type listConfigurationsRequest struct {
-	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
-	Limit uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+	Skip   uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit  uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=name created_at version" example:"name"` // Optional, defaults to name
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc" example:"asc"`                // Optional, defaults to asc
+	Cursor string `form:"cursor" example:""`                                                     // Optional, the next_cursor of the previous page
+	// Optional filters, a configuration is listed if its latest version passes all of them
+	Type          string    `form:"type" example:"person"`
+	NamePrefix    string    `form:"name_prefix" example:"payments_"`
+	Name          string    `form:"name" example:"payments_*"` // A glob
+	CreatedAfter  time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00" example:"2025-01-01T00:00:00Z"`
+	CreatedBefore time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00" example:"2026-01-01T00:00:00Z"`
+	Where         []string  `form:"where" example:"value.region == \"eu\""`                   // Predicates on the value
+	Selector      string    `form:"selector" example:"team=payments,tier in (critical,high)"` // A label selector
+}
+
+// query returns the filters of the request
+func (req *listConfigurationsRequest) query() (domain.ConfigQuery, error) {
+	query := domain.ConfigQuery{
+		Type:          req.Type,
+		NamePrefix:    req.NamePrefix,
+		NameGlob:      req.Name,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+	}
+
+	for _, where := range req.Where {
+		predicate, err := domain.ParseValuePredicate(where)
+		if err != nil {
+			return domain.ConfigQuery{}, err
+		}
+		query.Values = append(query.Values, predicate)
+	}
+
+	selector, err := domain.ParseLabelSelector(req.Selector)
+	if err != nil {
+		return domain.ConfigQuery{}, err
+	}
+	query.Labels = selector
+
+	return query, nil
 }
 
 // ListConfigurations godoc
 //
 //	@Summary		Retrieve configuration list
 //	@Description	Retrieve a list configurations with pagination support.
+//	@Description	The list is sorted by name, created_at or version of the latest version, in asc or desc order, ties are broken by name.
+//	@Description	A page that isn't the last returns a next_cursor in its meta, which is passed as cursor with the same sort and order to get the next page.
+//	@Description	The filters limit the list to the configurations whose latest version passes all of them. A where predicate compares a field of the value,
+//	@Description	addressed by a path such as value.limits[0].max, with a JSON literal using ==, !=, <, <=, > or >=, e.g. value.region == "eu".
+//	@Description	A selector selects by labels with comma separated requirements: key=value, key!=value, key in (values), key notin (values), key and !key.
 //	@Tags			Configurations
 //	@Accept			json
 //	@Produce		json
-//	@Param			skip	query		int						false	"Starting offset"	example:"0"
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			skip	query		int						false	"Starting offset, after the cursor"	example:"0"
 //	@Param			limit	query		int						true	"Page size"			example:"5"
+//	@Param			sort	query		string					false	"name, created_at or version"	example:"name"
+//	@Param			order	query		string					false	"asc or desc"	example:"asc"
+//	@Param			cursor	query		string					false	"next_cursor of the previous page"
+//	@Param			type	query		string					false	"Config type"	example:"person"
+//	@Param			name_prefix	query	string					false	"Prefix of the name"	example:"payments_"
+//	@Param			name	query		string					false	"Glob of the name"	example:"payments_*"
+//	@Param			created_after	query	string				false	"RFC 3339 time the latest version is written after"	example:"2025-01-01T00:00:00Z"
+//	@Param			created_before	query	string				false	"RFC 3339 time the latest version is written before"	example:"2026-01-01T00:00:00Z"
+//	@Param			where	query		[]string				false	"Predicates on the value"	collectionFormat(multi)
+//	@Param			selector	query	string					false	"Label selector"	example:"team=payments,tier in (critical,high)"
 //	@Success		200		{object}	configurationResponse	"Configuration found"
 //	@Failure		400		{object}	errorResponse			"Validation error"
 //	@Failure		401		{object}	errorResponse			"Unauthorized error"
@@ -138,6 +575,7 @@ type listConfigurationsRequest struct {
 //	@Failure		409		{object}	errorResponse			"Data conflict error"
 //	@Failure		500		{object}	errorResponse			"Internal server error"
 //	@Router			/cms/configs [get]
+//	@Router			/cms/namespaces/{ns}/configs [get]
 //	@Security		BearerAuth
 func (ch *ConfigurationHandler) ListConfigurations(ctx *gin.Context) {
 	var req listConfigurationsRequest
@@ -148,18 +586,30 @@ func (ch *ConfigurationHandler) ListConfigurations(ctx *gin.Context) {
 		return
 	}
 
-	configs, err := ch.svc.ListConfigurations(ctx, req.Skip, req.Limit)
+	page, err := pageRequest(req.Skip, req.Limit, req.Sort, req.Order, req.Cursor)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	for _, config := range configs {
+	query, err := req.query()
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	configs, err := ch.svc.ListConfigurations(ctx, namespaceParam(ctx), query, page)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, config := range configs.Configs {
 		configsList = append(configsList, newConfigResponse(config))
 	}
 
-	total := uint64(len(configsList))
-	meta := newMeta(total, req.Limit, req.Skip)
+	meta := newMeta(configs.Total, req.Limit, req.Skip)
+	meta.NextCursor = nextCursor(configs.Next)
 	rsp := toMap(meta, configsList, "configs")
 
 	handleSuccess(ctx, rsp)
@@ -173,13 +623,17 @@ type getConfigurationVersionRequest struct {
 // GetConfigurationVersion godoc
 //
 //	@Summary		Retrieve a particular version of a configuration
-//	@Description	Retrieve a particular version of a configuration by its name and version number
+//	@Description	Retrieve a particular version of a configuration by its name and version number.
+//	@Description	With env the value is the one the environment saw in that version.
 //	@Tags			Configurations
 //	@Accept			json
 //	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
 //	@Param			name	path		string					true	"Configuration name"	example:"person_config"
 //	@Param			version	path		int						true	"Version Number"	example:"1"
+//	@Param			env		query		string					false	"Environment whose effective value is returned"	example:"staging"
 //	@Success		200		{object}	configurationResponse	"Configuration found"
+//	@Header			200		{string}	ETag					"Version of the configuration"
 //	@Failure		400		{object}	errorResponse			"Validation error"
 //	@Failure		401		{object}	errorResponse			"Unauthorized error"
 //	@Failure		403		{object}	errorResponse			"Forbidden error"
@@ -187,6 +641,7 @@ type getConfigurationVersionRequest struct {
 //	@Failure		409		{object}	errorResponse			"Data conflict error"
 //	@Failure		500		{object}	errorResponse			"Internal server error"
 //	@Router			/cms/configs/{name}/versions/{version} [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/versions/{version} [get]
 //	@Security		BearerAuth
 func (ch *ConfigurationHandler) GetConfigurationVersion(ctx *gin.Context) {
 	var req getConfigurationVersionRequest
@@ -194,12 +649,18 @@ func (ch *ConfigurationHandler) GetConfigurationVersion(ctx *gin.Context) {
 		validationError(ctx, err)
 		return
 	}
-	config, err := ch.svc.GetConfigurationVersion(ctx, req.Name, req.Version)
+	environment, err := environmentQuery(ctx)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
-	rsp := newConfigResponse(config)
+	config, err := ch.svc.GetConfigurationVersion(ctx, namespaceParam(ctx), req.Name, req.Version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+	setETag(ctx, config.Version)
+	rsp := newConfigResponse(forEnvironment(config, environment))
 	handleSuccess(ctx, rsp)
 }
 
@@ -208,20 +669,32 @@ type listConfigurationVersionsRequestUri struct {
 }
 
 type listConfigurationVersionsRequestForm struct {
-	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
-	Limit uint64 `form:"limit" binding:"min=5,max=100" example:"5"`
+	Skip      uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit     uint64 `form:"limit" binding:"min=5,max=100" example:"5"`
+	CreatedBy string `form:"created_by" example:"alice"`                                               // Optional, only list the versions written by this caller
+	Sort      string `form:"sort" binding:"omitempty,oneof=name created_at version" example:"version"` // Optional, defaults to name, which is version order
+	Order     string `form:"order" binding:"omitempty,oneof=asc desc" example:"desc"`                  // Optional, defaults to asc
+	Cursor    string `form:"cursor" example:""`                                                        // Optional, the next_cursor of the previous page
 }
 
 // ListConfigurationVersions godoc
 //
 //	@Summary		Retrieve a historical version list of a configuration
-//	@Description	Retrieve a historical version list of a configuration by its name
+//	@Description	Retrieve a historical version list of a configuration by its name.
+//	@Description	With created_by only the versions written by that caller are listed: the sub claim of a user, or api_key:<id> for an API key.
+//	@Description	The versions are sorted by name, created_at or version, which all list them in version order, asc or desc.
+//	@Description	A page that isn't the last returns a next_cursor in its meta, which is passed as cursor with the same sort and order to get the next page.
 //	@Tags			Configurations
 //	@Accept			json
 //	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
 //	@Param			name	path		string					true	"Configuration name"	example:"person_config"
-//	@Param			skip	query		int						false	"Starting offset"		example:"0"
+//	@Param			skip	query		int						false	"Starting offset, after the cursor"		example:"0"
 //	@Param			limit	query		int						true	"Page size"				example:"5"
+//	@Param			created_by	query	string					false	"Author of the versions"	example:"alice"
+//	@Param			sort	query		string					false	"name, created_at or version"	example:"version"
+//	@Param			order	query		string					false	"asc or desc"	example:"desc"
+//	@Param			cursor	query		string					false	"next_cursor of the previous page"
 //
 //	@Success		200		{object}	configurationResponse	"Configuration found"
 //	@Failure		400		{object}	errorResponse			"Validation error"
@@ -231,6 +704,7 @@ type listConfigurationVersionsRequestForm struct {
 //	@Failure		409		{object}	errorResponse			"Data conflict error"
 //	@Failure		500		{object}	errorResponse			"Internal server error"
 //	@Router			/cms/configs/{name}/versions [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/versions [get]
 //	@Security		BearerAuth
 func (ch *ConfigurationHandler) ListConfigurationVersions(ctx *gin.Context) {
 	var reqUri listConfigurationVersionsRequestUri
@@ -245,24 +719,91 @@ func (ch *ConfigurationHandler) ListConfigurationVersions(ctx *gin.Context) {
 		return
 	}
 
-	configs, err := ch.svc.ListConfigurationVersions(ctx, reqUri.Name, reqForm.Skip, reqForm.Limit)
+	page, err := pageRequest(reqForm.Skip, reqForm.Limit, reqForm.Sort, reqForm.Order, reqForm.Cursor)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	configs, err := ch.svc.ListConfigurationVersions(ctx, namespaceParam(ctx), reqUri.Name, domain.VersionFilter{CreatedBy: reqForm.CreatedBy}, page)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
 	var configsList []configurationResponse
-	for _, config := range configs {
+	for _, config := range configs.Configs {
 		configsList = append(configsList, newConfigResponse(config))
 	}
 
-	total := uint64(len(configsList))
-	meta := newMeta(total, reqForm.Limit, reqForm.Skip)
+	meta := newMeta(configs.Total, reqForm.Limit, reqForm.Skip)
+	meta.NextCursor = nextCursor(configs.Next)
 	rsp := toMap(meta, configsList, "configs")
 
 	handleSuccess(ctx, rsp)
 }
 
+type diffConfigurationVersionsRequestUri struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+type diffConfigurationVersionsRequestForm struct {
+	From   int    `form:"from" binding:"required,min=1" example:"3"`
+	To     int    `form:"to" binding:"min=0" example:"7"`                                 // Optional, defaults to the latest version
+	Format string `form:"format" binding:"omitempty,oneof=patch unified" example:"patch"` // Optional, defaults to patch
+}
+
+// DiffConfigurationVersions godoc
+//
+//	@Summary		Compare two versions of a configuration
+//	@Description	Compare the value and type of two versions of a configuration, e.g. to review a rollback before making it.
+//	@Description	With format=patch (the default) the changes of the value are the RFC 6902 JSON Patch operations that turn the from version into the to version,
+//	@Description	with format=unified they are a unified diff of the two values.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name	path		string			true	"Configuration name"									example:"person_config"
+//	@Param			from	query		int				true	"Version Number to compare from"						example:"3"
+//	@Param			to		query		int				false	"Version Number to compare to, defaults to the latest"	example:"7"
+//	@Param			format	query		string			false	"patch or unified"										example:"patch"
+//	@Success		200		{object}	diffResponse	"Configuration versions compared"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/configs/{name}/diff [get]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/diff [get]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) DiffConfigurationVersions(ctx *gin.Context) {
+	var reqUri diffConfigurationVersionsRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqForm diffConfigurationVersionsRequestForm
+	if err := ctx.ShouldBindQuery(&reqForm); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	format := domain.DiffFormat(reqForm.Format)
+	if format == "" {
+		format = domain.DiffFormatPatch
+	}
+
+	diff, err := ch.svc.DiffConfigurationVersions(ctx, namespaceParam(ctx), reqUri.Name, reqForm.From, reqForm.To, format)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newDiffResponse(diff, format)
+	handleSuccess(ctx, rsp)
+}
+
 type rollbackConfigurationVersionRequest struct {
 	Name    string `uri:"name" binding:"required" example:"app_config"`
 	Version int    `uri:"version" binding:"required" example:"1"`
@@ -275,16 +816,21 @@ type rollbackConfigurationVersionRequest struct {
 //	@Tags			Configurations
 //	@Accept			json
 //	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
 //	@Param			name	path		string					true	"Configuration name"	example:"person_config"
 //	@Param			version	path		int						true	"Version Number"	example:"1"
+//	@Param			X-Change-Message	header	string		false	"Why the configuration is rolled back"
 //	@Success		200		{object}	configurationResponse	"Configuration rolled back"
+//	@Header			200		{string}	ETag					"Version of the rolled back configuration"
 //	@Failure		400		{object}	errorResponse			"Validation error"
 //	@Failure		401		{object}	errorResponse			"Unauthorized error"
 //	@Failure		403		{object}	errorResponse			"Forbidden error"
 //	@Failure		404		{object}	errorResponse			"Data not found error"
 //	@Failure		409		{object}	errorResponse			"Data conflict error"
+//	@Failure		422		{object}	errorResponse			"The version no longer matches the latest schema, details lists every violation"
 //	@Failure		500		{object}	errorResponse			"Internal server error"
 //	@Router			/cms/configs/{name}/versions/{version}/rollback [post]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/versions/{version}/rollback [post]
 //	@Security		BearerAuth
 func (ch *ConfigurationHandler) RollbackConfigurationVersion(ctx *gin.Context) {
 	var req rollbackConfigurationVersionRequest
@@ -293,12 +839,217 @@ func (ch *ConfigurationHandler) RollbackConfigurationVersion(ctx *gin.Context) {
 		return
 	}
 
-	config, err := ch.svc.RollbackConfigurationVersion(ctx, req.Name, req.Version)
+	setChangeMessage(ctx, "")
+
+	config, err := ch.svc.RollbackConfigurationVersion(ctx, namespaceParam(ctx), req.Name, req.Version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, config.Version)
+	rsp := newConfigResponse(config)
+
+	handleSuccess(ctx, rsp)
+}
+
+type promoteConfigurationVersionRequestUri struct {
+	Name    string `uri:"name" binding:"required" example:"person_config"`
+	Version int    `uri:"version" binding:"required" example:"3"`
+}
+
+type promoteConfigurationVersionRequestJson struct {
+	From          string `json:"from" binding:"required" example:"staging"`                 // Environment whose overlay is promoted
+	To            string `json:"to" binding:"required" example:"prod"`                      // Environment the overlay is copied to
+	ChangeMessage string `json:"change_message,omitempty" example:"Release the new limits"` // Optional, why the version is promoted, takes precedence over the X-Change-Message header
+}
+
+// PromoteConfigurationVersion godoc
+//
+//	@Summary		Promote a version of a configuration to another environment
+//	@Description	Copy the overlay an environment had in a version of a configuration to another environment, and store the result as a new version
+//	@Description	that records the environment and the version it was promoted from. The base value is shared by every environment, so it isn't copied.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns					path		string									false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name				path		string									true	"Configuration name"											example:"person_config"
+//	@Param			version				path		int										true	"Version Number"												example:"3"
+//	@Param			X-Change-Message	header		string									false	"Why the version is promoted"
+//	@Param			promoteRequest		body		promoteConfigurationVersionRequestJson	true	"Promotion request"
+//	@Success		200					{object}	configurationResponse					"Configuration promoted"
+//	@Header			200					{string}	ETag									"Version of the promoted configuration"
+//	@Failure		400					{object}	errorResponse							"Validation error"
+//	@Failure		401					{object}	errorResponse							"Unauthorized error"
+//	@Failure		403					{object}	errorResponse							"Forbidden error"
+//	@Failure		404					{object}	errorResponse							"Data not found error"
+//	@Failure		409					{object}	errorResponse							"Data conflict error"
+//	@Failure		422					{object}	errorResponse							"Schema validation error, details lists every violation"
+//	@Failure		500					{object}	errorResponse							"Internal server error"
+//	@Router			/cms/configs/{name}/versions/{version}/promote [post]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/versions/{version}/promote [post]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) PromoteConfigurationVersion(ctx *gin.Context) {
+	var reqUri promoteConfigurationVersionRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqJson promoteConfigurationVersionRequestJson
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, reqJson.ChangeMessage)
+
+	config, err := ch.svc.PromoteConfigurationVersion(ctx, namespaceParam(ctx), reqUri.Name, reqUri.Version, reqJson.From, reqJson.To)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, config.Version)
+	rsp := newConfigResponse(config)
+
+	handleSuccess(ctx, rsp)
+}
+
+type deleteConfigurationRequest struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+// DeleteConfiguration godoc
+//
+//	@Summary		Delete a configuration
+//	@Description	Delete a configuration by writing a tombstone version. The configuration is left out of listings and can't be retrieved,
+//	@Description	but its version history is kept and it can be restored. Send the ETag of the latest version in the If-Match header to fail with 409 if someone else has written a newer version.
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name		path		string					true	"Configuration name"	example:"person_config"
+//	@Param			If-Match	header		string					false	"ETag of the version being deleted"
+//	@Param			X-Change-Message	header		string					false	"Why the configuration is deleted"
+//	@Success		200			{object}	configurationResponse	"Configuration deleted, the tombstone version"
+//	@Header			200			{string}	ETag					"Version of the tombstone"
+//	@Failure		400			{object}	errorResponse			"Validation error"
+//	@Failure		401			{object}	errorResponse			"Unauthorized error"
+//	@Failure		403			{object}	errorResponse			"Forbidden error"
+//	@Failure		404			{object}	errorResponse			"Data not found error"
+//	@Failure		409			{object}	errorResponse			"Data conflict error"
+//	@Failure		500			{object}	errorResponse			"Internal server error"
+//	@Router			/cms/configs/{name} [delete]
+//	@Router			/cms/namespaces/{ns}/configs/{name} [delete]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) DeleteConfiguration(ctx *gin.Context) {
+	var req deleteConfigurationRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(ctx)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, "")
+
+	tombstone, err := ch.svc.DeleteConfiguration(ctx, namespaceParam(ctx), req.Name, expectedVersion)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	setETag(ctx, tombstone.Version)
+	rsp := newConfigResponse(tombstone)
+
+	handleSuccess(ctx, rsp)
+}
+
+type restoreConfigurationRequest struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+// RestoreConfiguration godoc
+//
+//	@Summary		Restore a deleted configuration
+//	@Description	Restore a deleted configuration by copying the version before its tombstone as a new version
+//	@Tags			Configurations
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name	path		string					true	"Configuration name"	example:"person_config"
+//	@Param			X-Change-Message	header	string		false	"Why the configuration is restored"
+//	@Success		200		{object}	configurationResponse	"Configuration restored"
+//	@Header			200		{string}	ETag					"Version of the restored configuration"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		403		{object}	errorResponse			"Forbidden error"
+//	@Failure		404		{object}	errorResponse			"Data not found error"
+//	@Failure		409		{object}	errorResponse			"Configuration is not deleted error"
+//	@Failure		422		{object}	errorResponse			"The version before the tombstone no longer matches the latest schema, details lists every violation"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/cms/configs/{name}/restore [post]
+//	@Router			/cms/namespaces/{ns}/configs/{name}/restore [post]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) RestoreConfiguration(ctx *gin.Context) {
+	var req restoreConfigurationRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	setChangeMessage(ctx, "")
+
+	config, err := ch.svc.RestoreConfiguration(ctx, namespaceParam(ctx), req.Name)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
+	setETag(ctx, config.Version)
 	rsp := newConfigResponse(config)
+
 	handleSuccess(ctx, rsp)
 }
+
+type purgeConfigurationRequest struct {
+	Name string `uri:"name" binding:"required" example:"person_config"`
+}
+
+// PurgeConfiguration godoc
+//
+//	@Summary		Permanently erase a configuration
+//	@Description	Erase every version of a configuration, deleted or not, e.g. for a data retention request. This can't be undone.
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path	string	false	"Namespace, the default namespace on the routes without one"	example:"payments"
+//	@Param			name	path		string			true	"Configuration name"	example:"person_config"
+//	@Success		200		{object}	response		"Configuration purged"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/admin/configs/{name} [delete]
+//	@Router			/cms/admin/namespaces/{ns}/configs/{name} [delete]
+//	@Security		BearerAuth
+func (ch *ConfigurationHandler) PurgeConfiguration(ctx *gin.Context) {
+	var req purgeConfigurationRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if err := ch.svc.PurgeConfiguration(ctx, namespaceParam(ctx), req.Name); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
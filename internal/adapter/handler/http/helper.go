@@ -1,5 +1,17 @@
 package http
 
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// errInvalidETag is returned when an If-Match header doesn't hold a configuration version ETag
+var errInvalidETag = errors.New("If-Match header must be a version ETag such as \"3\"")
+
 // toMap is a helper function to add meta and data to a map
 func toMap(m meta, data any, key string) map[string]any {
 	return map[string]any{
@@ -7,3 +19,130 @@ func toMap(m meta, data any, key string) map[string]any {
 		key:    data,
 	}
 }
+
+// setETag sets the ETag header to the version of a configuration
+func setETag(ctx *gin.Context, version int) {
+	ctx.Header("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// parseIfMatch returns the version in the If-Match header, or 0 if the header is absent or "*"
+func parseIfMatch(ctx *gin.Context) (int, error) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	// Versions are compared as a whole, so a weak validator is as good as a strong one
+	header = strings.TrimPrefix(header, "W/")
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, errInvalidETag
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, errInvalidETag
+	}
+
+	return version, nil
+}
+
+// setChangeMessage stores why a request changes a configuration in its context, from a field of the request body or
+// else the X-Change-Message header
+func setChangeMessage(ctx *gin.Context, message string) {
+	if message == "" {
+		message = strings.TrimSpace(ctx.GetHeader("X-Change-Message"))
+	}
+	if message == "" {
+		return
+	}
+
+	ctx.Request = ctx.Request.WithContext(domain.ContextWithChangeMessage(ctx.Request.Context(), message))
+}
+
+// expectedVersion returns the version a write replaces, from the If-Match header or the expected_version field of its
+// body, which must agree when both are sent
+func expectedVersion(ctx *gin.Context, expectedVersionField int) (int, error) {
+	return ifMatchOrField(ctx, expectedVersionField, errMismatchedExpectedVersion)
+}
+
+// expectedRevision returns the revision of an alias a move replaces, from the If-Match header or the expected_revision
+// field of its body, which must agree when both are sent
+func expectedRevision(ctx *gin.Context, expectedRevisionField int) (int, error) {
+	return ifMatchOrField(ctx, expectedRevisionField, errMismatchedExpectedRevision)
+}
+
+// ifMatchOrField returns the number in the If-Match header or else in a field of the body, failing with errMismatched
+// if both are sent and disagree
+func ifMatchOrField(ctx *gin.Context, field int, errMismatched error) (int, error) {
+	expected, err := parseIfMatch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if field != 0 {
+		if expected != 0 && expected != field {
+			return 0, errMismatched
+		}
+		expected = field
+	}
+
+	return expected, nil
+}
+
+// environmentQuery returns the environment a read asks for in the env query parameter, or "" for the base value
+func environmentQuery(ctx *gin.Context) (string, error) {
+	environment := ctx.Query("env")
+	if environment != "" && !domain.ValidEnvironmentName(environment) {
+		return "", domain.ErrInvalidEnvironment
+	}
+
+	return environment, nil
+}
+
+// forEnvironment returns a config as an environment sees it, or as it is stored when no environment is asked for
+func forEnvironment(config *domain.Config, environment string) *domain.Config {
+	if environment == "" {
+		return config
+	}
+
+	return config.ForEnvironment(environment)
+}
+
+// namespaceParam returns the namespace in the path of a request, the routes without one address the default namespace
+func namespaceParam(ctx *gin.Context) string {
+	if namespace := ctx.Param("ns"); namespace != "" {
+		return namespace
+	}
+
+	return domain.DefaultNamespace
+}
+
+// pageRequest returns the page a list request asks for, the cursor is the next_cursor of the previous page
+func pageRequest(skip, limit uint64, sort, order, cursor string) (domain.PageRequest, error) {
+	page := domain.PageRequest{
+		Sort:  domain.SortField(sort),
+		Order: domain.SortOrder(order),
+		Skip:  skip,
+		Limit: limit,
+	}
+
+	if cursor != "" {
+		var err error
+		if page.Cursor, err = domain.ParseCursor(cursor); err != nil {
+			return domain.PageRequest{}, err
+		}
+	}
+
+	return page, nil
+}
+
+// nextCursor returns the token of the cursor of the next page, or "" on the last page
+func nextCursor(cursor *domain.Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return cursor.Token()
+}
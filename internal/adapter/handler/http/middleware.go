@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// authorizationHeaderKey is the key for authorization header in the request
+	authorizationHeaderKey = "authorization"
+	// authorizationTypeBearer is the authorization type of JWT bearer tokens
+	authorizationTypeBearer = "bearer"
+	// authorizationTypeApiKey is the authorization type of API keys
+	authorizationTypeApiKey = "apikey"
+	// authorizationPayloadKey is the key for the caller's identity in the gin context
+	authorizationPayloadKey = "authorization_payload"
+)
+
+// authMiddleware is a middleware to check if the caller is authenticated with a bearer token or an API key.
+// The caller's identity is stored in the gin context and in the request context, where the core services find it
+func authMiddleware(token port.TokenService, apiKeys service.ApiKeyServicer) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
+		if authorizationHeader == "" {
+			handleAbort(ctx, domain.ErrEmptyAuthorizationHeader)
+			return
+		}
+
+		fields := strings.Fields(authorizationHeader)
+		if len(fields) != 2 {
+			handleAbort(ctx, domain.ErrInvalidAuthorizationHeader)
+			return
+		}
+
+		var principal *domain.Principal
+		var err error
+
+		switch strings.ToLower(fields[0]) {
+		case authorizationTypeBearer:
+			if token == nil {
+				err = domain.ErrInvalidAuthorizationType // Bearer tokens can't be verified without a JWT key
+				break
+			}
+			principal, err = token.VerifyToken(fields[1])
+		case authorizationTypeApiKey:
+			if apiKeys == nil {
+				err = domain.ErrInvalidAuthorizationType
+				break
+			}
+			principal, err = apiKeys.VerifyApiKey(ctx, fields[1])
+		default:
+			err = domain.ErrInvalidAuthorizationType
+		}
+		if err != nil {
+			handleAbort(ctx, err)
+			return
+		}
+
+		ctx.Set(authorizationPayloadKey, principal)
+		ctx.Request = ctx.Request.WithContext(domain.ContextWithPrincipal(ctx.Request.Context(), principal))
+		ctx.Next()
+	}
+}
+
+// scopeMiddleware is a middleware to check if the caller may perform the kind of operation a request asks for.
+// Reading needs the read scope, anything else the write scope
+func scopeMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		scope := domain.ApiKeyScopeWrite
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			scope = domain.ApiKeyScopeRead
+		}
+
+		requireScope(ctx, scope)
+	}
+}
+
+// adminMiddleware is a middleware to check if the caller may perform irreversible operations
+func adminMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requireScope(ctx, domain.ApiKeyScopeAdmin)
+	}
+}
+
+// requireScope aborts the request unless the authenticated caller was granted a scope
+func requireScope(ctx *gin.Context, scope domain.ApiKeyScope) {
+	principal, ok := domain.PrincipalFromContext(ctx.Request.Context())
+	if !ok || !principal.HasScope(scope) {
+		handleAbort(ctx, domain.ErrForbidden)
+		return
+	}
+
+	ctx.Next()
+}
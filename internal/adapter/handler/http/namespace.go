@@ -0,0 +1,181 @@
+package http
+
+import (
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+	"github.com/gin-gonic/gin"
+)
+
+// NamespaceHandler represents the HTTP handler for namespace-related requests
+type NamespaceHandler struct {
+	svc service.NamespaceServicer
+}
+
+// NewNamespaceHandler creates a new NamespaceHandler instance
+func NewNamespaceHandler(svc service.NamespaceServicer) *NamespaceHandler {
+	return &NamespaceHandler{
+		svc,
+	}
+}
+
+type createNamespaceRequest struct {
+	Name        string            `json:"name" binding:"required" example:"payments"`
+	Description string            `json:"description,omitempty" example:"Configurations of the payment services"`
+	Metadata    map[string]string `json:"metadata,omitempty" example:"team:payments"` // Optional, free-form details such as the owning team
+}
+
+// CreateNamespace godoc
+//
+//	@Summary		Create a namespace
+//	@Description	Create a namespace to group configurations, whose names are unique within it. A name is made of lowercase letters, digits, '-' and '_', up to 63 characters.
+//	@Tags			Namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			createNamespaceRequest	body		createNamespaceRequest	true	"Namespace to create"
+//	@Success		200						{object}	namespaceResponse		"Namespace created"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		409						{object}	errorResponse			"Data conflict error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/cms/namespaces [post]
+//	@Security		BearerAuth
+func (nh *NamespaceHandler) CreateNamespace(ctx *gin.Context) {
+	var req createNamespaceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	namespace := &domain.Namespace{
+		Name:        req.Name,
+		Description: req.Description,
+		Metadata:    req.Metadata,
+	}
+
+	created, err := nh.svc.CreateNamespace(ctx, namespace)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newNamespaceResponse(created)
+
+	handleSuccess(ctx, rsp)
+}
+
+type listNamespacesRequest struct {
+	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+}
+
+// ListNamespaces godoc
+//
+//	@Summary		Retrieve namespace list
+//	@Description	Retrieve a list of namespaces, ordered by name, with pagination support.
+//	@Tags			Namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		int					false	"Starting offset"	example:"0"
+//	@Param			limit	query		int					true	"Page size"			example:"5"
+//	@Success		200		{object}	namespaceResponse	"Namespaces found"
+//	@Failure		400		{object}	errorResponse		"Validation error"
+//	@Failure		401		{object}	errorResponse		"Unauthorized error"
+//	@Failure		500		{object}	errorResponse		"Internal server error"
+//	@Router			/cms/namespaces [get]
+//	@Security		BearerAuth
+func (nh *NamespaceHandler) ListNamespaces(ctx *gin.Context) {
+	var req listNamespacesRequest
+	var namespacesList []namespaceResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	namespaces, err := nh.svc.ListNamespaces(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, namespace := range namespaces {
+		namespacesList = append(namespacesList, newNamespaceResponse(namespace))
+	}
+
+	total := uint64(len(namespacesList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, namespacesList, "namespaces")
+
+	handleSuccess(ctx, rsp)
+}
+
+type namespaceRequest struct {
+	Name string `uri:"ns" binding:"required" example:"payments"`
+}
+
+// GetNamespace godoc
+//
+//	@Summary		Retrieve a namespace
+//	@Description	Retrieve a namespace and its metadata by its name
+//	@Tags			Namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path		string				true	"Namespace"	example:"payments"
+//	@Success		200	{object}	namespaceResponse	"Namespace found"
+//	@Failure		400	{object}	errorResponse		"Validation error"
+//	@Failure		401	{object}	errorResponse		"Unauthorized error"
+//	@Failure		404	{object}	errorResponse		"Data not found error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/cms/namespaces/{ns} [get]
+//	@Security		BearerAuth
+func (nh *NamespaceHandler) GetNamespace(ctx *gin.Context) {
+	var req namespaceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	namespace, err := nh.svc.GetNamespace(ctx, req.Name)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newNamespaceResponse(namespace)
+
+	handleSuccess(ctx, rsp)
+}
+
+// DeleteNamespace godoc
+//
+//	@Summary		Delete a namespace
+//	@Description	Delete a namespace that holds no configurations, delete or purge them first. The default namespace can't be deleted.
+//	@Description	The version history of the configurations deleted in the namespace is kept, they can be restored if a namespace of the same name is created again.
+//	@Tags			Namespaces
+//	@Accept			json
+//	@Produce		json
+//	@Param			ns	path		string			true	"Namespace"	example:"payments"
+//	@Success		200	{object}	response		"Namespace deleted"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"Namespace is not empty or is the default namespace error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/cms/namespaces/{ns} [delete]
+//	@Security		BearerAuth
+func (nh *NamespaceHandler) DeleteNamespace(ctx *gin.Context) {
+	var req namespaceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if err := nh.svc.DeleteNamespace(ctx, req.Name); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
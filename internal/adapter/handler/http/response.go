@@ -1,7 +1,9 @@
 package http
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -32,6 +34,8 @@ type meta struct {
 	Total uint64 `json:"total" example:"100"`
 	Limit uint64 `json:"limit" example:"10"`
 	Skip  uint64 `json:"skip" example:"0"`
+	// NextCursor continues a list sorted by the same field and order, it is left out on the last page
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoibmFtZSIsIm8iOiJhc2MiLCJucyI6ImRlZmF1bHQiLCJuIjoiYXBwX2NvbmZpZyIsInYiOjN9"`
 }
 
 // newMeta is a helper function to create metadata for a paginated response
@@ -44,41 +48,325 @@ func newMeta(total, limit, skip uint64) meta {
 }
 
 type configurationResponse struct {
-	Name              string                 `json:"name" example:"app_config"`
-	Type              string                 `json:"type" example:"person"`
-	Value             map[string]interface{} `json:"value" swaggertype:"object,string"`
-	Version           int                    `json:"version" example:"1"`
-	RollbackedVersion int                    `json:"rollbacked_version,omitempty" example:"0"`            // Optional field for copied version
-	CreatedAt         time.Time              `json:"created_at,omitempty" example:"2023-10-01T12:00:00Z"` // Optional field for creation timestamp
+	Namespace         string                            `json:"namespace" example:"default"`
+	Name              string                            `json:"name" example:"app_config"`
+	Type              string                            `json:"type" example:"person"`
+	Value             map[string]interface{}            `json:"value" swaggertype:"object,string"`
+	Overlays          map[string]map[string]interface{} `json:"overlays,omitempty" swaggertype:"object"` // Merge patch over the value by environment, left out when the value of an environment is asked for
+	Version           int                               `json:"version" example:"1"`
+	SchemaVersion     int                               `json:"schema_version,omitempty" example:"1"`                   // Version of the type's schema the value was validated against
+	RollbackedVersion int                               `json:"rollbacked_version,omitempty" example:"0"`               // Optional field for copied version
+	Environment       string                            `json:"environment,omitempty" example:"prod"`                   // Environment whose overlay the version wrote
+	PromotedFrom      string                            `json:"promoted_from,omitempty" example:"staging"`              // Environment the overlay was promoted from
+	PromotedVersion   int                               `json:"promoted_version,omitempty" example:"3"`                 // Version the overlay was promoted from
+	Deleted           bool                              `json:"deleted,omitempty" example:"false"`                      // Set on the tombstone version of a deleted configuration
+	CreatedAt         time.Time                         `json:"created_at,omitempty" example:"2023-10-01T12:00:00Z"`    // Optional field for creation timestamp
+	CreatedBy         string                            `json:"created_by,omitempty" example:"alice"`                   // Caller that wrote the version, if the request was authenticated
+	ChangeMessage     string                            `json:"change_message,omitempty" example:"Raise the age limit"` // Why the version was written, if the request said
+	Labels            map[string]string                 `json:"labels,omitempty" example:"team:payments,tier:critical"`
+	Annotations       map[string]string                 `json:"annotations,omitempty" example:"owner:payments-oncall"`
 }
 
 func newConfigResponse(config *domain.Config) configurationResponse {
 	return configurationResponse{
+		Namespace:         config.Namespace,
 		Name:              config.Name,
 		Type:              config.Type,
 		Value:             config.Value,
+		Overlays:          config.Overlays,
 		Version:           config.Version,
+		SchemaVersion:     config.SchemaVersion,
 		RollbackedVersion: config.RollbackedVersion,
+		Environment:       config.Environment,
+		PromotedFrom:      config.PromotedFrom,
+		PromotedVersion:   config.PromotedVersion,
+		Deleted:           config.Deleted,
 		CreatedAt:         config.CreatedAt,
+		CreatedBy:         config.CreatedBy,
+		ChangeMessage:     config.ChangeMessage,
+		Labels:            config.Labels,
+		Annotations:       config.Annotations,
 	}
 }
 
-// errorStatusMap is a map of defined error messages and their corresponding http status codes
-var errorStatusMap = map[error]int{
-	domain.ErrInternal:                   http.StatusInternalServerError,
-	domain.ErrDataNotFound:               http.StatusNotFound,
-	domain.ErrConflictingData:            http.StatusConflict,
-	domain.ErrInvalidCredentials:         http.StatusUnauthorized,
-	domain.ErrUnauthorized:               http.StatusUnauthorized,
-	domain.ErrEmptyAuthorizationHeader:   http.StatusUnauthorized,
-	domain.ErrInvalidAuthorizationHeader: http.StatusUnauthorized,
-	domain.ErrInvalidAuthorizationType:   http.StatusUnauthorized,
-	domain.ErrInvalidToken:               http.StatusUnauthorized,
-	domain.ErrExpiredToken:               http.StatusUnauthorized,
-	domain.ErrForbidden:                  http.StatusForbidden,
-	domain.ErrNoUpdatedData:              http.StatusBadRequest,
-	domain.ErrInsufficientStock:          http.StatusBadRequest,
-	domain.ErrInsufficientPayment:        http.StatusBadRequest,
+type aliasResponse struct {
+	Namespace     string                 `json:"namespace" example:"default"`
+	Name          string                 `json:"name" example:"person_config"`
+	Alias         string                 `json:"alias" example:"stable"`
+	Version       int                    `json:"version" example:"12"`                                      // Version the alias points at, 0 once it is cleared
+	Revision      int                    `json:"revision" example:"3"`                                      // Number of the move, to send in If-Match when moving the alias again
+	MovedAt       time.Time              `json:"moved_at" example:"2023-10-01T12:00:00Z"`                   // When the alias was moved
+	MovedBy       string                 `json:"moved_by,omitempty" example:"alice"`                        // Caller that moved the alias
+	ChangeMessage string                 `json:"change_message,omitempty" example:"Release the new limits"` // Why the alias was moved, if the request said
+	Config        *configurationResponse `json:"config,omitempty"`                                          // Version the alias points at, when it is resolved
+}
+
+func newAliasResponse(alias *domain.Alias) aliasResponse {
+	return aliasResponse{
+		Namespace:     alias.Namespace,
+		Name:          alias.Name,
+		Alias:         alias.Alias,
+		Version:       alias.Version,
+		Revision:      alias.Revision,
+		MovedAt:       alias.MovedAt,
+		MovedBy:       alias.MovedBy,
+		ChangeMessage: alias.ChangeMessage,
+	}
+}
+
+type schemaResponse struct {
+	Type          string                 `json:"type" example:"person"`
+	Version       int                    `json:"version" example:"1"`
+	Definition    map[string]interface{} `json:"definition" swaggertype:"object"`
+	Compatibility string                 `json:"compatibility" example:"backward"`
+	CreatedAt     time.Time              `json:"created_at" example:"2023-10-01T12:00:00Z"`
+}
+
+func newSchemaResponse(schema *domain.Schema) schemaResponse {
+	return schemaResponse{
+		Type:          schema.Type,
+		Version:       schema.Version,
+		Definition:    schema.Definition,
+		Compatibility: string(schema.Compatibility),
+		CreatedAt:     schema.CreatedAt,
+	}
+}
+
+// compatibilityReport lists why a new schema version was rejected
+type compatibilityReport struct {
+	Compatibility string                       `json:"compatibility" example:"backward"`
+	Issues        []string                     `json:"issues" example:"backward: /age is required by the new version but not by the previous version"`
+	Configs       []incompatibleConfigResponse `json:"configs"`
+}
+
+// incompatibleConfigResponse identifies a configuration that doesn't match a new schema version
+type incompatibleConfigResponse struct {
+	Namespace   string              `json:"namespace" example:"default"`
+	Name        string              `json:"name" example:"person_config"`
+	Version     int                 `json:"version" example:"1"`
+	Environment string              `json:"environment,omitempty" example:"staging"` // Empty when the base value doesn't match
+	Violations  []violationResponse `json:"violations"`
+}
+
+func newCompatibilityReport(err *domain.SchemaCompatibilityError) compatibilityReport {
+	configs := make([]incompatibleConfigResponse, 0, len(err.Configs))
+	for _, config := range err.Configs {
+		configs = append(configs, incompatibleConfigResponse{Namespace: config.Namespace, Name: config.Name, Version: config.Version, Environment: config.Environment, Violations: newViolationsResponse(config.Violations)})
+	}
+
+	return compatibilityReport{
+		Compatibility: string(err.Compatibility),
+		Issues:        append([]string{}, err.Issues...), // Always a list, even if only configurations failed
+		Configs:       configs,
+	}
+}
+
+// violationResponse is a single field of a configuration value that doesn't match its schema
+type violationResponse struct {
+	Pointer string `json:"pointer" example:"/age"`
+	Keyword string `json:"keyword" example:"minimum"`
+	Message string `json:"message" example:"-1 should be at least 0"`
+}
+
+func newViolationsResponse(violations []domain.Violation) []violationResponse {
+	rsp := make([]violationResponse, 0, len(violations))
+	for _, v := range violations {
+		rsp = append(rsp, violationResponse{Pointer: v.Pointer, Keyword: v.Keyword, Message: v.Message})
+	}
+
+	return rsp
+}
+
+// validationResponse is the result of validating a configuration without storing it
+type validationResponse struct {
+	Valid         bool                `json:"valid" example:"true"`
+	Version       int                 `json:"version,omitempty" example:"2"`        // Version that would be created, if valid
+	SchemaVersion int                 `json:"schema_version,omitempty" example:"1"` // Version of the type's schema the value was validated against, if valid
+	Violations    []violationResponse `json:"violations,omitempty"`                 // Every field that doesn't match the schema, if invalid
+}
+
+func newValidResponse(config *domain.Config) validationResponse {
+	return validationResponse{
+		Valid:         true,
+		Version:       config.Version,
+		SchemaVersion: config.SchemaVersion,
+	}
+}
+
+func newInvalidResponse(err *domain.ValidationError) validationResponse {
+	return validationResponse{
+		Valid:      false,
+		Violations: newViolationsResponse(err.Violations),
+	}
+}
+
+// diffResponse lists the changes between two versions of a configuration
+type diffResponse struct {
+	Namespace   string `json:"namespace" example:"default"`
+	Name        string `json:"name" example:"person_config"`
+	FromVersion int    `json:"from_version" example:"3"`
+	ToVersion   int    `json:"to_version" example:"7"`
+	FromType    string `json:"from_type" example:"person"`
+	ToType      string `json:"to_type" example:"person"`
+	Operations  any    `json:"operations,omitempty" swaggertype:"array,object"`                       // JSON Patch that turns the from value into the to value, for the patch format
+	Unified     string `json:"unified,omitempty" example:"--- person_config version 3 (type person)"` // For the unified format
+}
+
+// diffOperationResponse is an RFC 6902 JSON Patch operation
+type diffOperationResponse struct {
+	Op    string `json:"op" example:"replace"`
+	Path  string `json:"path" example:"/age"`
+	Value any    `json:"value,omitempty" swaggertype:"object"`
+}
+
+func newDiffResponse(diff *domain.ConfigDiff, format domain.DiffFormat) diffResponse {
+	rsp := diffResponse{
+		Namespace:   diff.Namespace,
+		Name:        diff.Name,
+		FromVersion: diff.FromVersion,
+		ToVersion:   diff.ToVersion,
+		FromType:    diff.FromType,
+		ToType:      diff.ToType,
+		Unified:     diff.Unified,
+	}
+
+	if format == domain.DiffFormatPatch {
+		// Always a list, so that equal versions are told apart from the unified format
+		operations := make([]diffOperationResponse, 0, len(diff.Operations))
+		for _, operation := range diff.Operations {
+			var value any
+			if operation.Op != "remove" {
+				value = operation.Value
+				if value == nil {
+					value = json.RawMessage("null") // An add or replace with null must still have a value member
+				}
+			}
+			operations = append(operations, diffOperationResponse{Op: operation.Op, Path: operation.Path, Value: value})
+		}
+		rsp.Operations = operations
+	}
+
+	return rsp
+}
+
+// watchEventResponse is the data of a Server-Sent Event about a configuration change
+type watchEventResponse struct {
+	Revision uint64                `json:"revision" example:"42"`
+	Type     string                `json:"type" example:"put"`
+	Config   configurationResponse `json:"config"` // For a purge, only the name is set
+}
+
+func newWatchEventResponse(event *domain.ConfigEvent) watchEventResponse {
+	return watchEventResponse{
+		Revision: event.Revision,
+		Type:     string(event.Type),
+		Config:   newConfigResponse(event.Config),
+	}
+}
+
+// apiKeyResponse describes an API key. The key itself is only set when it's created or rotated, it can't be read back
+type apiKeyResponse struct {
+	ID        string     `json:"id" example:"9f86d081884c7d65"`
+	Name      string     `json:"name" example:"ci-deploy"`
+	Scopes    []string   `json:"scopes" example:"read,write"`
+	Key       string     `json:"key,omitempty" example:"cms_9f86d081884c7d65_2c26b46b68ffc68ff99b453c1d304134"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2024-10-01T12:00:00Z"` // Unset if the key doesn't expire
+	CreatedAt time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty" example:"2023-11-01T12:00:00Z"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" example:"2023-12-01T12:00:00Z"`
+}
+
+func newApiKeyResponse(key *domain.ApiKey, plaintext string) apiKeyResponse {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	return apiKeyResponse{
+		ID:        key.ID,
+		Name:      key.Name,
+		Scopes:    scopes,
+		Key:       plaintext,
+		ExpiresAt: optionalTime(key.ExpiresAt),
+		CreatedAt: key.CreatedAt,
+		RotatedAt: optionalTime(key.RotatedAt),
+		RevokedAt: optionalTime(key.RevokedAt),
+	}
+}
+
+// optionalTime returns nil for the zero time, so that unset times are left out of a response
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// namespaceResponse describes a namespace
+type namespaceResponse struct {
+	Name        string            `json:"name" example:"payments"`
+	Description string            `json:"description,omitempty" example:"Configurations of the payment services"`
+	Metadata    map[string]string `json:"metadata,omitempty" example:"team:payments"`
+	CreatedAt   time.Time         `json:"created_at" example:"2023-10-01T12:00:00Z"`
+}
+
+func newNamespaceResponse(namespace *domain.Namespace) namespaceResponse {
+	return namespaceResponse{
+		Name:        namespace.Name,
+		Description: namespace.Description,
+		Metadata:    namespace.Metadata,
+		CreatedAt:   namespace.CreatedAt,
+	}
+}
+
+// errorStatuses pairs the defined errors with their http status codes. An error that wraps several defined errors gets
+// the status code of the first one listed
+var errorStatuses = []struct {
+	err    error
+	status int
+}{
+	{domain.ErrDataNotFound, http.StatusNotFound},
+	{domain.ErrConflictingData, http.StatusConflict},
+	{domain.ErrVersionConflict, http.StatusConflict},
+	{domain.ErrAliasConflict, http.StatusConflict},
+	{domain.ErrSchemaInUse, http.StatusConflict},
+	{domain.ErrConfigurationNotDeleted, http.StatusConflict},
+	{domain.ErrApiKeyRevoked, http.StatusConflict},
+	{domain.ErrNamespaceNotEmpty, http.StatusConflict},
+	{domain.ErrDefaultNamespace, http.StatusConflict},
+	{domain.ErrNamespaceNotFound, http.StatusNotFound},
+	{domain.ErrInvalidNamespace, http.StatusBadRequest},
+	{domain.ErrInvalidEnvironment, http.StatusBadRequest},
+	{domain.ErrInvalidPromotion, http.StatusBadRequest},
+	{domain.ErrInvalidSort, http.StatusBadRequest},
+	{domain.ErrInvalidCursor, http.StatusBadRequest},
+	{domain.ErrInvalidQuery, http.StatusBadRequest},
+	{domain.ErrInvalidPredicate, http.StatusBadRequest},
+	{domain.ErrInvalidSelector, http.StatusBadRequest},
+	{domain.ErrInvalidLabel, http.StatusBadRequest},
+	{domain.ErrInvalidAnnotation, http.StatusBadRequest},
+	{domain.ErrInvalidAlias, http.StatusBadRequest},
+	{domain.ErrInvalidCredentials, http.StatusUnauthorized},
+	{domain.ErrUnauthorized, http.StatusUnauthorized},
+	{domain.ErrEmptyAuthorizationHeader, http.StatusUnauthorized},
+	{domain.ErrInvalidAuthorizationHeader, http.StatusUnauthorized},
+	{domain.ErrInvalidAuthorizationType, http.StatusUnauthorized},
+	{domain.ErrInvalidToken, http.StatusUnauthorized},
+	{domain.ErrExpiredToken, http.StatusUnauthorized},
+	{domain.ErrForbidden, http.StatusForbidden},
+	{domain.ErrRevisionUnavailable, http.StatusGone},
+	{domain.ErrNoUpdatedData, http.StatusBadRequest},
+	{domain.ErrInvalidSchema, http.StatusBadRequest},
+	{domain.ErrInvalidSchemaDefinition, http.StatusBadRequest},
+	{domain.ErrInvalidPatch, http.StatusBadRequest},
+	{domain.ErrUnsupportedDiffFormat, http.StatusBadRequest},
+	{domain.ErrInvalidApiKey, http.StatusBadRequest},
+	{domain.ErrPatchTestFailed, http.StatusConflict},
+	{domain.ErrUnsupportedPatchType, http.StatusUnsupportedMediaType},
+	{domain.ErrInsufficientStock, http.StatusBadRequest},
+	{domain.ErrInsufficientPayment, http.StatusBadRequest},
+	{domain.ErrInternal, http.StatusInternalServerError},
 }
 
 // validationError sends an error response for some specific request validation error
@@ -88,27 +376,48 @@ func validationError(ctx *gin.Context, err error) {
 	ctx.JSON(http.StatusBadRequest, errRsp)
 }
 
-// handleError determines the status code of an error and returns a JSON response with the error message and status code
-func handleError(ctx *gin.Context, err error) {
-	statusCode, ok := errorStatusMap[err]
-	if !ok {
-		statusCode = http.StatusInternalServerError
-	}
-
+// errorStatus determines the status code of an error and builds its response body,
+// attaching the details of errors that carry more than a message
+func errorStatus(err error) (int, errorResponse) {
 	errMsg := parseError(err)
 	errRsp := newErrorResponse(errMsg)
+
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		// One message per violation for clients that only show messages
+		errRsp.Messages = nil
+		for _, v := range validationErr.Violations {
+			errRsp.Messages = append(errRsp.Messages, fmt.Sprintf("%s: %s", v.Pointer, v.Message))
+		}
+		errRsp.Details = newViolationsResponse(validationErr.Violations)
+		return http.StatusUnprocessableEntity, errRsp
+	}
+
+	var compatibilityErr *domain.SchemaCompatibilityError
+	if errors.As(err, &compatibilityErr) {
+		errRsp.Details = newCompatibilityReport(compatibilityErr)
+		return http.StatusConflict, errRsp
+	}
+
+	// Wrapped errors carry the cause of a defined error in their message, errors.Is finds it
+	for _, defined := range errorStatuses {
+		if errors.Is(err, defined.err) {
+			return defined.status, errRsp
+		}
+	}
+
+	return http.StatusInternalServerError, errRsp
+}
+
+// handleError determines the status code of an error and returns a JSON response with the error message and status code
+func handleError(ctx *gin.Context, err error) {
+	statusCode, errRsp := errorStatus(err)
 	ctx.JSON(statusCode, errRsp)
 }
 
 // handleAbort sends an error response and aborts the request with the specified status code and error message
 func handleAbort(ctx *gin.Context, err error) {
-	statusCode, ok := errorStatusMap[err]
-	if !ok {
-		statusCode = http.StatusInternalServerError
-	}
-
-	errMsg := parseError(err)
-	errRsp := newErrorResponse(errMsg)
+	statusCode, errRsp := errorStatus(err)
 	ctx.AbortWithStatusJSON(statusCode, errRsp)
 }
 
@@ -131,6 +440,7 @@ func parseError(err error) []string {
 type errorResponse struct {
 	Success  bool     `json:"success" example:"false"`
 	Messages []string `json:"messages" example:"Error message 1, Error message 2"`
+	Details  any      `json:"details,omitempty" swaggertype:"object"` // Optional, structured details of the error
 }
 
 // newErrorResponse is a helper function to create an error response body
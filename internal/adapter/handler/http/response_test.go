@@ -0,0 +1,34 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"Defined", domain.ErrDataNotFound, http.StatusNotFound},
+		{"Wrapped", fmt.Errorf("reading configuration: %w", domain.ErrVersionConflict), http.StatusConflict},
+		// The first defined error listed decides, however often the status is asked for
+		{"WrapsSeveral", errors.Join(domain.ErrInternal, domain.ErrForbidden), http.StatusForbidden},
+		{"Undefined", errors.New("disk on fire"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				if status, _ := errorStatus(tt.err); status != tt.expected {
+					t.Fatalf("Expected status %d, got %d", tt.expected, status)
+				}
+			}
+		})
+	}
+}
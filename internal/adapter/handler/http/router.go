@@ -1,10 +1,15 @@
 package http
 
 import (
+	"context"
 	"log/slog"
+	"net"
+	"net/http"
 	"strings"
 
 	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -16,12 +21,19 @@ import (
 // Router is a wrapper for HTTP router
 type Router struct {
 	*gin.Engine
+	server *http.Server
 }
 
 // NewRouter creates a new HTTP router
 func NewRouter(
 	config *config.HTTP,
+	token port.TokenService,
+	apiKeys service.ApiKeyServicer,
 	configurationHandler ConfigurationHandler,
+	schemaHandler SchemaHandler,
+	watchHandler WatchHandler,
+	apiKeyHandler ApiKeyHandler,
+	namespaceHandler NamespaceHandler,
 ) (*Router, error) {
 	// Disable debug mode in production
 	if config.Env == "production" {
@@ -33,31 +45,111 @@ func NewRouter(
 	allowedOrigins := config.AllowedOrigins
 	originsList := strings.Split(allowedOrigins, ",")
 	ginConfig.AllowOrigins = originsList
+	ginConfig.AddAllowHeaders("Authorization", "If-Match", "Last-Event-ID", "X-Change-Message")
+	ginConfig.AddExposeHeaders("ETag")
 
 	router := gin.New()
 	router.Use(sloggin.New(slog.Default()), gin.Recovery(), cors.New(ginConfig))
 
+	// Let the core services reach the values of the request context, such as the caller's identity
+	router.ContextWithFallback = true
+
+	// Authenticate every API request and check the scopes of API keys. Requests are only served unauthenticated when
+	// there are no credentials to check at all, neither tokens nor API keys
+	var auth, admin []gin.HandlerFunc
+	if token != nil || apiKeys != nil {
+		auth = append(auth, authMiddleware(token, apiKeys), scopeMiddleware())
+		admin = append(admin, authMiddleware(token, apiKeys), adminMiddleware())
+	}
+
 	// Swagger
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	configuration := router.Group("/cms")
+	configuration := router.Group("/cms", auth...)
+	{
+		// The routes without a namespace address the default namespace
+		for _, configs := range []*gin.RouterGroup{configuration, configuration.Group("/namespaces/:ns")} {
+			configs.GET("/configs", configurationHandler.ListConfigurations)
+			configs.GET("/configs/", configurationHandler.ListConfigurations)
+			configs.PUT("/configs/:name", configurationHandler.PutConfiguration)
+			configs.GET("/configs/:name", configurationHandler.GetConfiguration)
+			configs.PATCH("/configs/:name", configurationHandler.PatchConfiguration)
+			configs.DELETE("/configs/:name", configurationHandler.DeleteConfiguration)
+			configs.POST("/configs/:name/restore", configurationHandler.RestoreConfiguration)
+			configs.POST("/configs/:name/validate", configurationHandler.ValidateConfiguration)
+			configs.GET("/configs/:name/diff", configurationHandler.DiffConfigurationVersions)
+			configs.GET("/configs/:name/versions", configurationHandler.ListConfigurationVersions)
+			configs.GET("/configs/:name/versions/", configurationHandler.ListConfigurationVersions)
+			configs.GET("/configs/:name/versions/:version", configurationHandler.GetConfigurationVersion)
+			configs.POST("/configs/:name/versions/:version/rollback", configurationHandler.RollbackConfigurationVersion)
+			configs.POST("/configs/:name/versions/:version/promote", configurationHandler.PromoteConfigurationVersion)
+			configs.PUT("/configs/:name/environments/:env", configurationHandler.PutConfigurationOverlay)
+			configs.PUT("/configs/:name/labels", configurationHandler.PutConfigurationLabels)
+			configs.GET("/configs/:name/aliases", configurationHandler.ListConfigurationAliases)
+			configs.GET("/configs/:name/aliases/", configurationHandler.ListConfigurationAliases)
+			configs.GET("/configs/:name/aliases/:alias", configurationHandler.GetConfigurationAlias)
+			configs.PUT("/configs/:name/aliases/:alias", configurationHandler.MoveConfigurationAlias)
+			configs.DELETE("/configs/:name/aliases/:alias", configurationHandler.ClearConfigurationAlias)
+			configs.GET("/configs/:name/aliases/:alias/revisions", configurationHandler.ListConfigurationAliasRevisions)
+		}
+
+		configuration.GET("/namespaces", namespaceHandler.ListNamespaces)
+		configuration.GET("/namespaces/", namespaceHandler.ListNamespaces)
+		configuration.POST("/namespaces", namespaceHandler.CreateNamespace)
+		configuration.GET("/namespaces/:ns", namespaceHandler.GetNamespace)
+		configuration.DELETE("/namespaces/:ns", namespaceHandler.DeleteNamespace)
+
+		configuration.GET("/schemas", schemaHandler.ListSchemas)
+		configuration.GET("/schemas/", schemaHandler.ListSchemas)
+		configuration.PUT("/schemas/:type", schemaHandler.PutSchema)
+		configuration.GET("/schemas/:type", schemaHandler.GetSchema)
+		configuration.DELETE("/schemas/:type", schemaHandler.DeleteSchema)
+		configuration.GET("/schemas/:type/versions", schemaHandler.ListSchemaVersions)
+		configuration.GET("/schemas/:type/versions/", schemaHandler.ListSchemaVersions)
+		configuration.GET("/schemas/:type/versions/:version", schemaHandler.GetSchemaVersion)
+
+		configuration.GET("/watch", watchHandler.Watch)
+	}
+
+	// Irreversible operations, kept apart so that they can be restricted to administrators
+	administration := router.Group("/cms/admin", admin...)
 	{
-		configuration.GET("/configs", configurationHandler.ListConfigurations)
-		configuration.GET("/configs/", configurationHandler.ListConfigurations)
-		configuration.PUT("/configs/:name", configurationHandler.PutConfiguration)
-		configuration.GET("/configs/:name", configurationHandler.GetConfiguration)
-		configuration.GET("/configs/:name/versions", configurationHandler.ListConfigurationVersions)
-		configuration.GET("/configs/:name/versions/", configurationHandler.ListConfigurationVersions)
-		configuration.GET("/configs/:name/versions/:version", configurationHandler.GetConfigurationVersion)
-		configuration.POST("/configs/:name/versions/:version/rollback", configurationHandler.RollbackConfigurationVersion)
+		administration.DELETE("/configs/:name", configurationHandler.PurgeConfiguration)
+		administration.DELETE("/namespaces/:ns/configs/:name", configurationHandler.PurgeConfiguration)
+
+		administration.GET("/apikeys", apiKeyHandler.ListApiKeys)
+		administration.GET("/apikeys/", apiKeyHandler.ListApiKeys)
+		administration.POST("/apikeys", apiKeyHandler.CreateApiKey)
+		administration.POST("/apikeys/:id/rotate", apiKeyHandler.RotateApiKey)
+		administration.POST("/apikeys/:id/revoke", apiKeyHandler.RevokeApiKey)
+	}
+
+	// Requests are served in a context that is done once the server shuts down, which ends the watches and long
+	// polls that would otherwise hold the shutdown until its deadline
+	base, cancel := context.WithCancel(context.Background())
+	server := &http.Server{
+		Handler:     router,
+		BaseContext: func(net.Listener) context.Context { return base },
 	}
+	server.RegisterOnShutdown(cancel)
 
 	return &Router{
 		router,
+		server,
 	}, nil
 }
 
-// Serve starts the HTTP server
+// Serve starts the HTTP server, it returns http.ErrServerClosed once the server is shut down
 func (r *Router) Serve(listenAddr string) error {
-	return r.Run(listenAddr)
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	return r.server.Serve(listener)
+}
+
+// Shutdown stops the HTTP server from accepting requests and waits for those in flight until ctx is done
+func (r *Router) Shutdown(ctx context.Context) error {
+	return r.server.Shutdown(ctx)
 }
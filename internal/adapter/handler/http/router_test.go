@@ -0,0 +1,211 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/auth/jwt"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/memory"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+
+	"github.com/gin-gonic/gin"
+	jwtlib "github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+// newTestRouter creates a router on top of the memory storage, which checks bearer tokens if a token service is given
+// and API keys if withApiKeys is set
+func newTestRouter(t *testing.T, token port.TokenService, withApiKeys bool) *Router {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	configurationRepo := memory.NewConfigurationRepository()
+	schemaRepo := memory.NewSchemaRepository()
+	namespaceRepo := memory.NewNamespaceRepository()
+	events := service.NewEventBus(10)
+	locks := service.NewWriteLocks()
+	apiKeyService := service.NewApiKeyService(memory.NewApiKeyRepository())
+
+	var apiKeys service.ApiKeyServicer
+	if withApiKeys {
+		apiKeys = apiKeyService
+	}
+
+	router, err := NewRouter(
+		&config.HTTP{Env: "test", AllowedOrigins: "*"},
+		token,
+		apiKeys,
+		*NewConfigurationHandler(service.NewConfigurationService(configurationRepo, namespaceRepo, schemaRepo, events, locks)),
+		*NewSchemaHandler(service.NewSchemaService(schemaRepo, configurationRepo, locks)),
+		*NewWatchHandler(events),
+		*NewApiKeyHandler(apiKeyService),
+		*NewNamespaceHandler(service.NewNamespaceService(namespaceRepo, configurationRepo)),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create router: %v", err)
+	}
+
+	return router
+}
+
+func serve(router *Router, method, path, authorization string) *httptest.ResponseRecorder {
+	return serveBody(router, method, path, authorization, "")
+}
+
+// serveBody serves a request with a JSON body
+func serveBody(router *Router, method, path, authorization, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestApiKeysAloneRequireAuthentication(t *testing.T) {
+	// Without a JWT key the API keys are still credentials to check
+	router := newTestRouter(t, nil, true)
+
+	tests := []struct {
+		method        string
+		path          string
+		authorization string
+	}{
+		{http.MethodGet, "/cms/admin/apikeys", ""},
+		{http.MethodPost, "/cms/admin/apikeys", ""},
+		{http.MethodDelete, "/cms/admin/configs/test_config", ""},
+		{http.MethodGet, "/cms/configs", ""},
+		{http.MethodGet, "/cms/admin/apikeys", "Bearer some.jwt.token"},
+		{http.MethodGet, "/cms/admin/apikeys", "ApiKey not-a-key"},
+	}
+
+	for _, tt := range tests {
+		if rec := serve(router, tt.method, tt.path, tt.authorization); rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected %s %s with %q to be unauthorized, got %d", tt.method, tt.path, tt.authorization, rec.Code)
+		}
+	}
+}
+
+func TestAuthenticationDisabled(t *testing.T) {
+	router := newTestRouter(t, nil, false)
+
+	if rec := serve(router, http.MethodGet, "/cms/admin/apikeys?limit=10", ""); rec.Code != http.StatusOK {
+		t.Errorf("Expected the API keys to be listed without credentials, got %d", rec.Code)
+	}
+}
+
+// bearer signs a token for a user with roles and returns it as an authorization header
+func bearer(t *testing.T, subject string, roles ...string) string {
+	t.Helper()
+
+	claims := jwtlib.MapClaims{"sub": subject, "exp": time.Now().Add(time.Hour).Unix()}
+	if len(roles) > 0 {
+		claims["roles"] = roles
+	}
+
+	token, err := jwtlib.NewWithClaims(jwtlib.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	return "Bearer " + token
+}
+
+func TestAdministrationNeedsAdminRole(t *testing.T) {
+	token, err := jwt.NewTokenService(&config.Auth{JWTSecret: testSecret})
+	if err != nil {
+		t.Fatalf("Failed to create token service: %v", err)
+	}
+	router := newTestRouter(t, token, true)
+
+	// A user without the admin role works with configurations but doesn't purge them or manage API keys
+	user := bearer(t, "alice", "developer")
+	if rec := serve(router, http.MethodGet, "/cms/configs?limit=10", user); rec.Code != http.StatusOK {
+		t.Errorf("Expected the configurations to be listed, got %d", rec.Code)
+	}
+	if rec := serve(router, http.MethodDelete, "/cms/admin/configs/test_config", user); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected a purge to be forbidden, got %d", rec.Code)
+	}
+	if rec := serve(router, http.MethodGet, "/cms/admin/apikeys?limit=10", user); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected the API keys to be forbidden, got %d", rec.Code)
+	}
+
+	admin := bearer(t, "root", "admin")
+	if rec := serve(router, http.MethodDelete, "/cms/admin/configs/test_config", admin); rec.Code != http.StatusNotFound {
+		t.Errorf("Expected the purge of a missing configuration to be not found, got %d", rec.Code)
+	}
+	if rec := serve(router, http.MethodGet, "/cms/admin/apikeys?limit=10", admin); rec.Code != http.StatusOK {
+		t.Errorf("Expected the API keys to be listed, got %d", rec.Code)
+	}
+}
+
+func TestAliasReadHasNoETag(t *testing.T) {
+	router := newTestRouter(t, nil, false)
+
+	for _, req := range []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPut, "/cms/schemas/person", `{"definition": {"type": "object"}}`},
+		{http.MethodPut, "/cms/configs/person_config", `{"type": "person", "value": {"name": "John"}}`},
+		{http.MethodPut, "/cms/configs/person_config/aliases/stable", `{"version": 1}`},
+		{http.MethodPut, "/cms/configs/person_config", `{"type": "person", "value": {"name": "Jane"}}`},
+	} {
+		if rec := serveBody(router, req.method, req.path, "", req.body); rec.Code != http.StatusOK && rec.Code != http.StatusCreated {
+			t.Fatalf("Failed to %s %s: %d %s", req.method, req.path, rec.Code, rec.Body)
+		}
+	}
+
+	// The alias may move without the configuration changing, so its read isn't tagged with the version it points at
+	rec := serve(router, http.MethodGet, "/cms/configs/person_config?alias=stable", "")
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != "" {
+		t.Errorf("Expected the aliased version without an ETag, got %d with ETag %q", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	rec = serve(router, http.MethodGet, "/cms/configs/person_config", "")
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != `"2"` {
+		t.Errorf("Expected the latest version with ETag \"2\", got %d with ETag %q", rec.Code, rec.Header().Get("ETag"))
+	}
+}
+
+func TestRollbackAndPromoteSetETag(t *testing.T) {
+	router := newTestRouter(t, nil, false)
+
+	for _, req := range []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPut, "/cms/schemas/person", `{"definition": {"type": "object"}}`},
+		{http.MethodPut, "/cms/configs/person_config", `{"type": "person", "value": {"name": "John"}}`},
+		{http.MethodPut, "/cms/configs/person_config/environments/staging", `{"value": {"name": "Jane"}}`},
+	} {
+		if rec := serveBody(router, req.method, req.path, "", req.body); rec.Code != http.StatusOK && rec.Code != http.StatusCreated {
+			t.Fatalf("Failed to %s %s: %d %s", req.method, req.path, rec.Code, rec.Body)
+		}
+	}
+
+	// Both write a new version, whose ETag the next conditional write sends in If-Match
+	rec := serveBody(router, http.MethodPost, "/cms/configs/person_config/versions/2/promote", "", `{"from": "staging", "to": "prod"}`)
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != `"3"` {
+		t.Errorf("Expected the promoted version with ETag \"3\", got %d with ETag %q", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	rec = serve(router, http.MethodPost, "/cms/configs/person_config/versions/1/rollback", "")
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != `"4"` {
+		t.Errorf("Expected the rolled back version with ETag \"4\", got %d with ETag %q", rec.Code, rec.Header().Get("ETag"))
+	}
+}
@@ -0,0 +1,282 @@
+package http
+
+import (
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+	"github.com/gin-gonic/gin"
+)
+
+// SchemaHandler represents the HTTP handler for schema-related requests
+type SchemaHandler struct {
+	svc service.SchemaServicer
+}
+
+// NewSchemaHandler creates a new SchemaHandler instance
+func NewSchemaHandler(svc service.SchemaServicer) *SchemaHandler {
+	return &SchemaHandler{
+		svc,
+	}
+}
+
+type schemaTypeRequestUri struct {
+	Type string `uri:"type" binding:"required" example:"person"`
+}
+
+type putSchemaRequestJson struct {
+	// JSON schema that the value of every configuration of the type must match
+	Definition map[string]interface{} `json:"definition" swaggertype:"object" binding:"required"`
+	// Optional, defaults to the mode of the previous version, or backward for a new type
+	Compatibility string `json:"compatibility,omitempty" binding:"omitempty,oneof=none backward forward full" example:"backward"`
+}
+
+// PutSchema godoc
+//
+//	@Summary		Register a config type or a new version of its schema
+//	@Description	Register the JSON schema that validates the value of configurations of a type, or add a new version to the schema of an existing type.
+//	@Description	A new version must follow the compatibility mode (none, backward, forward or full) with respect to the previous version,
+//	@Description	and the latest version of every configuration of the type must match it. Otherwise it is rejected with 409 and a report in details.
+//	@Tags			Schemas
+//	@Accept			json
+//	@Produce		json
+//	@Param			type				path		string					true	"Config type"	example:"person"
+//	@Param			putSchemaRequest	body		putSchemaRequestJson	true	"Register or Replace Schema request"
+//	@Success		200					{object}	schemaResponse			"Schema registered"
+//	@Failure		400					{object}	errorResponse			"Validation error"
+//	@Failure		401					{object}	errorResponse			"Unauthorized error"
+//	@Failure		403					{object}	errorResponse			"Forbidden error"
+//	@Failure		409					{object}	errorResponse			"Incompatible schema error"
+//	@Failure		500					{object}	errorResponse			"Internal server error"
+//	@Router			/cms/schemas/{type} [put]
+//	@Security		BearerAuth
+func (sh *SchemaHandler) PutSchema(ctx *gin.Context) {
+	var reqUri schemaTypeRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqJson putSchemaRequestJson
+	if err := ctx.ShouldBindJSON(&reqJson); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	schema := &domain.Schema{
+		Type:          reqUri.Type,
+		Definition:    reqJson.Definition,
+		Compatibility: domain.SchemaCompatibility(reqJson.Compatibility),
+	}
+
+	createdSchema, err := sh.svc.PutSchema(ctx, schema)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemaResponse(createdSchema)
+	handleSuccess(ctx, rsp)
+}
+
+// GetSchema godoc
+//
+//	@Summary		Retrieve the latest schema version of a config type
+//	@Description	Retrieve the latest version of the JSON schema registered for a config type
+//	@Tags			Schemas
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string			true	"Config type"	example:"person"
+//	@Success		200		{object}	schemaResponse	"Schema found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/schemas/{type} [get]
+//	@Security		BearerAuth
+func (sh *SchemaHandler) GetSchema(ctx *gin.Context) {
+	var req schemaTypeRequestUri
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	schema, err := sh.svc.GetSchema(ctx, req.Type)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemaResponse(schema)
+	handleSuccess(ctx, rsp)
+}
+
+type listSchemasRequest struct {
+	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+}
+
+// ListSchemas godoc
+//
+//	@Summary		Retrieve the registered config types
+//	@Description	Retrieve a list of the registered schemas ordered by type, with pagination support.
+//	@Tags			Schemas
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		int				false	"Starting offset"	example:"0"
+//	@Param			limit	query		int				true	"Page size"			example:"5"
+//	@Success		200		{object}	schemaResponse	"Schemas found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/schemas [get]
+//	@Security		BearerAuth
+func (sh *SchemaHandler) ListSchemas(ctx *gin.Context) {
+	var req listSchemasRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	schemas, err := sh.svc.ListSchemas(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	var schemasList []schemaResponse
+	for _, schema := range schemas {
+		schemasList = append(schemasList, newSchemaResponse(schema))
+	}
+
+	total := uint64(len(schemasList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, schemasList, "schemas")
+
+	handleSuccess(ctx, rsp)
+}
+
+type listSchemaVersionsRequestForm struct {
+	Skip  uint64 `form:"skip" binding:"min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"min=1,max=100" example:"5"`
+}
+
+// ListSchemaVersions godoc
+//
+//	@Summary		Retrieve the version history of the schema of a config type
+//	@Description	Retrieve the versions of the schema of a config type, oldest first
+//	@Tags			Schemas
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string			true	"Config type"		example:"person"
+//	@Param			skip	query		int				false	"Starting offset"	example:"0"
+//	@Param			limit	query		int				true	"Page size"			example:"5"
+//	@Success		200		{object}	schemaResponse	"Schema versions found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/schemas/{type}/versions [get]
+//	@Security		BearerAuth
+func (sh *SchemaHandler) ListSchemaVersions(ctx *gin.Context) {
+	var reqUri schemaTypeRequestUri
+	if err := ctx.ShouldBindUri(&reqUri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var reqForm listSchemaVersionsRequestForm
+	if err := ctx.ShouldBindQuery(&reqForm); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	schemas, err := sh.svc.ListSchemaVersions(ctx, reqUri.Type, reqForm.Skip, reqForm.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	var schemasList []schemaResponse
+	for _, schema := range schemas {
+		schemasList = append(schemasList, newSchemaResponse(schema))
+	}
+
+	total := uint64(len(schemasList))
+	meta := newMeta(total, reqForm.Limit, reqForm.Skip)
+	rsp := toMap(meta, schemasList, "schemas")
+
+	handleSuccess(ctx, rsp)
+}
+
+type getSchemaVersionRequest struct {
+	Type    string `uri:"type" binding:"required" example:"person"`
+	Version int    `uri:"version" binding:"required" example:"1"`
+}
+
+// GetSchemaVersion godoc
+//
+//	@Summary		Retrieve a particular schema version of a config type
+//	@Description	Retrieve a particular version of the schema of a config type, e.g. the one a configuration was validated against
+//	@Tags			Schemas
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string			true	"Config type"		example:"person"
+//	@Param			version	path		int				true	"Version Number"	example:"1"
+//	@Success		200		{object}	schemaResponse	"Schema version found"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/schemas/{type}/versions/{version} [get]
+//	@Security		BearerAuth
+func (sh *SchemaHandler) GetSchemaVersion(ctx *gin.Context) {
+	var req getSchemaVersionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	schema, err := sh.svc.GetSchemaVersion(ctx, req.Type, req.Version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newSchemaResponse(schema)
+	handleSuccess(ctx, rsp)
+}
+
+// DeleteSchema godoc
+//
+//	@Summary		Unregister a config type
+//	@Description	Delete every version of the schema of a config type. A type can't be deleted while the latest version of a configuration uses it.
+//	@Tags			Schemas
+//	@Accept			json
+//	@Produce		json
+//	@Param			type	path		string			true	"Config type"	example:"person"
+//	@Success		200		{object}	response		"Schema deleted"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		409		{object}	errorResponse	"Schema in use error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/cms/schemas/{type} [delete]
+//	@Security		BearerAuth
+func (sh *SchemaHandler) DeleteSchema(ctx *gin.Context) {
+	var req schemaTypeRequestUri
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if err := sh.svc.DeleteSchema(ctx, req.Type); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/service"
+	"github.com/gin-gonic/gin"
+)
+
+// watchHeartbeat is how often an idle stream sends a comment, so that proxies don't close the connection
+const watchHeartbeat = 30 * time.Second
+
+// errInvalidLastEventID is returned when a Last-Event-ID header doesn't hold a revision
+var errInvalidLastEventID = errors.New("Last-Event-ID header must be a revision such as 42")
+
+// WatchHandler represents the HTTP handler for streaming configuration changes
+type WatchHandler struct {
+	svc service.WatchServicer
+}
+
+// NewWatchHandler creates a new WatchHandler instance
+func NewWatchHandler(svc service.WatchServicer) *WatchHandler {
+	return &WatchHandler{
+		svc,
+	}
+}
+
+type watchRequest struct {
+	Namespace   string `form:"namespace" example:"payments"` // Optional, every namespace when empty
+	Name        string `form:"name" example:"person_config"`
+	Prefix      string `form:"prefix" example:"person_"`
+	Type        string `form:"type" example:"person"`
+	LastEventID uint64 `form:"last_event_id" example:"42"` // Optional, for clients that can't send the Last-Event-ID header
+}
+
+// Watch godoc
+//
+//	@Summary		Stream configuration changes
+//	@Description	Stream a Server-Sent Event whenever a version of a configuration is created by a create or replace, patch, rollback, delete or restore, or a configuration is purged.
+//	@Description	The event name is the kind of change, its id the revision of the change and its data the revision, kind and configuration version.
+//	@Description	The stream can be limited to a namespace, a configuration name, a name prefix or a config type. A client that reconnects with the Last-Event-ID header receives the changes it missed,
+//	@Description	or 410 if they are no longer kept, in which case it should read the configurations again and watch without Last-Event-ID.
+//	@Tags			Watch
+//	@Produce		text/event-stream
+//	@Param			namespace		query		string				false	"Namespace"					example:"payments"
+//	@Param			name			query		string				false	"Configuration name"		example:"person_config"
+//	@Param			prefix			query		string				false	"Configuration name prefix"	example:"person_"
+//	@Param			type			query		string				false	"Config type"				example:"person"
+//	@Param			last_event_id	query		int					false	"Revision to resume after"	example:"42"
+//	@Param			Last-Event-ID	header		string				false	"Revision to resume after"
+//	@Success		200				{object}	watchEventResponse	"Stream of changes"
+//	@Failure		400				{object}	errorResponse		"Validation error"
+//	@Failure		401				{object}	errorResponse		"Unauthorized error"
+//	@Failure		403				{object}	errorResponse		"Forbidden error"
+//	@Failure		410				{object}	errorResponse		"Revision no longer available error"
+//	@Failure		500				{object}	errorResponse		"Internal server error"
+//	@Router			/cms/watch [get]
+//	@Security		BearerAuth
+func (wh *WatchHandler) Watch(ctx *gin.Context) {
+	var req watchRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	lastRevision := req.LastEventID
+	if header := ctx.GetHeader("Last-Event-ID"); header != "" {
+		revision, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			validationError(ctx, errInvalidLastEventID)
+			return
+		}
+		lastRevision = revision
+	}
+
+	filter := domain.WatchFilter{
+		Namespace:  req.Namespace,
+		Name:       req.Name,
+		NamePrefix: req.Prefix,
+		Type:       req.Type,
+	}
+
+	// The request context is done when the client disconnects, which ends the watch
+	events, err := wh.svc.Watch(ctx.Request.Context(), filter, lastRevision)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no") // Keep reverse proxies from buffering the stream
+	ctx.Writer.WriteHeaderNow()
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(watchHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false // The client is gone, or fell behind and has to resume
+			}
+
+			data, err := json.Marshal(newWatchEventResponse(event))
+			if err != nil {
+				slog.Error("Error encoding configuration event", "error", err)
+				return false
+			}
+
+			fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Revision, event.Type, data)
+			return true
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			return true
+		}
+	})
+}
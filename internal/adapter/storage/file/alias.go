@@ -0,0 +1,144 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+const opMove = "move"
+
+// aliasState holds the revisions of every alias by configurationKey and alias
+type aliasState map[string]map[string][]*domain.Alias
+
+// applyAlias replays a logged move or purge of aliases onto the in-memory state
+func (r *ConfigurationRepository) applyAlias(op string, data json.RawMessage) error {
+	switch op {
+	case opMove:
+		var alias domain.Alias
+		if err := json.Unmarshal(data, &alias); err != nil {
+			return err
+		}
+		r.appendAlias(&alias)
+		return nil
+	case opPurge:
+		var purge purgeEntry
+		if err := json.Unmarshal(data, &purge); err != nil {
+			return err
+		}
+		delete(r.aliases, configurationKey(purge.Namespace, purge.Name))
+		return nil
+	default:
+		return fmt.Errorf("unknown log operation %q", op)
+	}
+}
+
+// appendAlias adds the latest revision of an alias to the in-memory state. The caller must hold the write lock
+func (r *ConfigurationRepository) appendAlias(alias *domain.Alias) {
+	key := configurationKey(alias.Namespace, alias.Name)
+	if r.aliases[key] == nil {
+		r.aliases[key] = make(map[string][]*domain.Alias)
+	}
+	r.aliases[key][alias.Alias] = append(r.aliases[key][alias.Alias], alias)
+}
+
+func (r *ConfigurationRepository) MoveAlias(ctx context.Context, alias *domain.Alias, expectedRevision int) (*domain.Alias, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	revisions := r.aliases[configurationKey(alias.Namespace, alias.Name)][alias.Alias]
+
+	latestRevision := 0
+	if len(revisions) > 0 {
+		latestRevision = revisions[len(revisions)-1].Revision
+	}
+
+	if expectedRevision != 0 && expectedRevision != latestRevision {
+		return nil, domain.ErrAliasConflict // Someone else has moved the alias in the meantime
+	}
+
+	moved := *alias // Store a copy so the caller can't modify the history
+	moved.Revision = latestRevision + 1
+	moved.MovedAt = time.Now()
+
+	if err := r.aliasStore.append(opMove, &moved); err != nil {
+		return nil, err
+	}
+
+	r.appendAlias(&moved)
+	if err := r.aliasStore.snapshotIfDue(r.aliases); err != nil {
+		// The move is already durable in the log, so a failed snapshot is retried on the next move
+		slog.Error("Error writing alias snapshot", "error", err)
+	}
+
+	return &moved, nil
+}
+
+func (r *ConfigurationRepository) GetAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := r.aliases[configurationKey(namespace, name)][alias]
+	if len(revisions) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return revisions[len(revisions)-1], nil
+}
+
+func (r *ConfigurationRepository) ListAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error) {
+	r.mu.RLock()
+	var set []*domain.Alias
+	for _, revisions := range r.aliases[configurationKey(namespace, name)] {
+		if latest := revisions[len(revisions)-1]; latest.IsSet() {
+			set = append(set, latest)
+		}
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that the list is stable
+	sort.Slice(set, func(i, j int) bool {
+		return set[i].Alias < set[j].Alias
+	})
+
+	return set, nil
+}
+
+func (r *ConfigurationRepository) ListAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error) {
+	r.mu.RLock()
+	revisions := r.aliases[configurationKey(namespace, name)][alias]
+	r.mu.RUnlock()
+
+	if skip >= uint64(len(revisions)) {
+		return nil, nil // No revisions to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(revisions)) {
+		end = uint64(len(revisions))
+	}
+
+	return revisions[skip:end], nil
+}
+
+// purgeAliases erases every revision of the aliases of a configuration, snapshotting so that they don't linger in
+// the log. The caller must hold the write lock
+func (r *ConfigurationRepository) purgeAliases(namespace, name string) error {
+	key := configurationKey(namespace, name)
+	if _, ok := r.aliases[key]; !ok {
+		return nil
+	}
+
+	if err := r.aliasStore.append(opPurge, purgeEntry{namespace, name}); err != nil {
+		return err
+	}
+
+	delete(r.aliases, key)
+
+	return r.aliasStore.snapshot(r.aliases)
+}
@@ -0,0 +1,80 @@
+package file
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func aliasVersions(aliases []*domain.Alias) []int {
+	var versions []int
+	for _, alias := range aliases {
+		versions = append(versions, alias.Version)
+	}
+
+	return versions
+}
+
+func TestAliasesSurviveRestart(t *testing.T) {
+	for _, snapshotEvery := range []string{"", "2"} {
+		t.Run("SnapshotEvery"+snapshotEvery, func(t *testing.T) {
+			dir := t.TempDir()
+			repo := newTestRepository(t, dir, snapshotEvery)
+			ctx := context.Background()
+
+			move := func(alias string, version, expectedRevision int) (*domain.Alias, error) {
+				return repo.MoveAlias(ctx, &domain.Alias{Namespace: domain.DefaultNamespace, Name: "app_config", Alias: alias, Version: version, MovedBy: "alice", ChangeMessage: "Release"}, expectedRevision)
+			}
+
+			for _, m := range []struct {
+				alias            string
+				version          int
+				expectedRevision int
+			}{{"stable", 3, 0}, {"stable", 4, 1}, {"canary", 5, 0}, {"beta", 2, 0}, {"beta", 0, 1}} {
+				if _, err := move(m.alias, m.version, m.expectedRevision); err != nil {
+					t.Fatalf("Failed to move %s to %d: %v", m.alias, m.version, err)
+				}
+			}
+			if _, err := move("stable", 5, 1); err != domain.ErrAliasConflict {
+				t.Errorf("Expected ErrAliasConflict for a stale revision, got %v", err)
+			}
+
+			// The moves are read back from the log, or the snapshot and the log
+			repo.Close()
+			repo = newTestRepository(t, dir, snapshotEvery)
+
+			stable, err := repo.GetAlias(ctx, domain.DefaultNamespace, "app_config", "stable")
+			if err != nil || stable.Version != 4 || stable.Revision != 2 || stable.MovedBy != "alice" || stable.ChangeMessage != "Release" || stable.MovedAt.IsZero() {
+				t.Errorf("Expected stable at 4 in revision 2, got %v, %v", stable, err)
+			}
+
+			aliases, err := repo.ListAliases(ctx, domain.DefaultNamespace, "app_config")
+			if err != nil || len(aliases) != 2 || aliases[0].Alias != "canary" || aliases[1].Alias != "stable" {
+				t.Errorf("Expected canary and stable, got %v, %v", aliases, err)
+			}
+
+			revisions, err := repo.ListAliasRevisions(ctx, domain.DefaultNamespace, "app_config", "beta", 0, 10)
+			if err != nil || !reflect.DeepEqual(aliasVersions(revisions), []int{2, 0}) {
+				t.Errorf("Expected beta moved to 2 and cleared, got %v, %v", aliasVersions(revisions), err)
+			}
+
+			// A purge erases the aliases with the configuration, also after the next restart
+			if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "app_config", Value: map[string]interface{}{"name": "John"}}, 0); err != nil {
+				t.Fatalf("Failed to put configuration: %v", err)
+			}
+			if err := repo.PurgeConfiguration(ctx, domain.DefaultNamespace, "app_config"); err != nil {
+				t.Fatalf("Failed to purge configuration: %v", err)
+			}
+
+			repo.Close()
+			repo = newTestRepository(t, dir, snapshotEvery)
+			defer repo.Close()
+
+			if _, err := repo.GetAlias(ctx, domain.DefaultNamespace, "app_config", "stable"); err != domain.ErrDataNotFound {
+				t.Errorf("Expected the purged alias to be gone, got %v", err)
+			}
+		})
+	}
+}
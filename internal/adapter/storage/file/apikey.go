@@ -0,0 +1,177 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// ApiKeyRepository keeps the API keys in memory and persists every change to a write-ahead log on local disk,
+// so they survive a restart. Every change logs the whole key
+type ApiKeyRepository struct {
+	mu    sync.RWMutex
+	keys  map[string]*domain.ApiKey
+	store *store
+}
+
+// NewApiKeyRepository opens the file-backed API key repository,
+// restoring the latest snapshot and replaying the log written after it
+func NewApiKeyRepository(cfg *config.Storage) (*ApiKeyRepository, error) {
+	opts, err := parseOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ApiKeyRepository{
+		keys: make(map[string]*domain.ApiKey),
+	}
+
+	store, err := openStore(opts, "apikeys", &r.keys, r.apply)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store = store
+	return r, nil
+}
+
+// apply replays a logged change onto the in-memory state
+func (r *ApiKeyRepository) apply(op string, data json.RawMessage) error {
+	switch op {
+	case opPut:
+		var key domain.ApiKey
+		if err := json.Unmarshal(data, &key); err != nil {
+			return err
+		}
+		r.keys[key.ID] = &key
+		return nil
+	default:
+		return fmt.Errorf("unknown log operation %q", op)
+	}
+}
+
+// put logs a new state of a key and applies it. The caller must hold the write lock
+func (r *ApiKeyRepository) put(key *domain.ApiKey) (*domain.ApiKey, error) {
+	if err := r.store.append(opPut, key); err != nil {
+		return nil, err
+	}
+
+	r.keys[key.ID] = key
+	if err := r.store.snapshotIfDue(r.keys); err != nil {
+		// The change is already durable in the log, so a failed snapshot is retried on the next write
+		slog.Error("Error writing API key snapshot", "error", err)
+	}
+
+	stored := *key // Return a copy so the caller can't modify the state
+	return &stored, nil
+}
+
+func (r *ApiKeyRepository) CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.keys[key.ID]; ok {
+		return nil, domain.ErrConflictingData
+	}
+
+	newKey := *key                // Store a copy so the caller can't modify it
+	newKey.CreatedAt = time.Now() // Set the creation timestamp
+
+	return r.put(&newKey)
+}
+
+func (r *ApiKeyRepository) GetApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	found := *key
+	return &found, nil
+}
+
+func (r *ApiKeyRepository) ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error) {
+	r.mu.RLock()
+	keys := make([]*domain.ApiKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		found := *key
+		keys = append(keys, &found)
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that pages are stable
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+		}
+		return keys[i].ID < keys[j].ID
+	})
+
+	if skip >= uint64(len(keys)) {
+		return nil, nil // No keys to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(keys)) {
+		end = uint64(len(keys))
+	}
+
+	return keys[skip:end], nil
+}
+
+func (r *ApiKeyRepository) RotateApiKey(ctx context.Context, id, hash string) (*domain.ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if key.Revoked() {
+		return nil, domain.ErrApiKeyRevoked
+	}
+
+	rotated := *key
+	rotated.Hash = hash
+	rotated.RotatedAt = time.Now()
+
+	return r.put(&rotated)
+}
+
+func (r *ApiKeyRepository) RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if key.Revoked() {
+		return nil, domain.ErrApiKeyRevoked
+	}
+
+	revoked := *key
+	revoked.RevokedAt = time.Now()
+
+	return r.put(&revoked)
+}
+
+// Close flushes pending log records and releases the log file
+func (r *ApiKeyRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.close()
+}
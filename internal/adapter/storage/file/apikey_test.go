@@ -0,0 +1,78 @@
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func newTestApiKeyRepository(t *testing.T, dir string) *ApiKeyRepository {
+	t.Helper()
+
+	repo, err := NewApiKeyRepository(&config.Storage{
+		FileDir:  dir,
+		FileSync: "always",
+	})
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	return repo
+}
+
+func TestApiKeyReplayAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestApiKeyRepository(t, dir)
+
+	_, err := repo.CreateApiKey(context.Background(), &domain.ApiKey{ID: "a", Name: "ci", Hash: "hash-1", Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	_, err = repo.CreateApiKey(context.Background(), &domain.ApiKey{ID: "b", Name: "sidecar", Hash: "hash-2", Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeWrite}})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	if _, err := repo.RotateApiKey(context.Background(), "a", "hash-3"); err != nil {
+		t.Fatalf("Failed to rotate API key: %v", err)
+	}
+	if _, err := repo.RevokeApiKey(context.Background(), "b"); err != nil {
+		t.Fatalf("Failed to revoke API key: %v", err)
+	}
+
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	repo = newTestApiKeyRepository(t, dir)
+	defer repo.Close()
+
+	rotated, err := repo.GetApiKey(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("Failed to get API key: %v", err)
+	}
+	if rotated.Hash != "hash-3" || rotated.RotatedAt.IsZero() || rotated.Revoked() || !rotated.HasScope(domain.ApiKeyScopeRead) {
+		t.Errorf("Expected the rotated key, got %v", rotated)
+	}
+
+	revoked, err := repo.GetApiKey(context.Background(), "b")
+	if err != nil {
+		t.Fatalf("Failed to get API key: %v", err)
+	}
+	if !revoked.Revoked() {
+		t.Errorf("Expected the revoked key, got %v", revoked)
+	}
+
+	if _, err := repo.RotateApiKey(context.Background(), "b", "hash-4"); err != domain.ErrApiKeyRevoked {
+		t.Errorf("Expected error %v, got %v", domain.ErrApiKeyRevoked, err)
+	}
+
+	keys, err := repo.ListApiKeys(context.Background(), 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list API keys: %v", err)
+	}
+	if len(keys) != 2 || keys[0].ID != "a" || keys[1].ID != "b" {
+		t.Errorf("Expected keys a and b oldest first, got %v", keys)
+	}
+}
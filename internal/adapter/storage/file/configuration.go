@@ -0,0 +1,387 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/index"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+const (
+	opPut       = "put"
+	opRollback  = "rollback"
+	opTombstone = "tombstone"
+	opRestore   = "restore"
+	opPurge     = "purge"
+)
+
+// configurationKey identifies a configuration in the state, names are unique within a namespace.
+// Namespace names can't contain a '/', so the key is unambiguous
+func configurationKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// configurationState holds the version history of every configuration by configurationKey
+type configurationState map[string][]*domain.Config
+
+// UnmarshalJSON reads a snapshot. Snapshots written before namespaces key the configurations by name and their
+// versions have no namespace, they are read into the default namespace
+func (s *configurationState) UnmarshalJSON(data []byte) error {
+	var histories map[string][]*domain.Config
+	if err := json.Unmarshal(data, &histories); err != nil {
+		return err
+	}
+
+	*s = make(configurationState, len(histories))
+	for _, versions := range histories {
+		for _, v := range versions {
+			if v.Namespace == "" {
+				v.Namespace = domain.DefaultNamespace
+			}
+		}
+		if len(versions) > 0 {
+			(*s)[configurationKey(versions[0].Namespace, versions[0].Name)] = versions
+		}
+	}
+
+	return nil
+}
+
+// purgeEntry is the log data of a purge
+type purgeEntry struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ConfigurationRepository keeps configurations in memory and persists every change
+// to a write-ahead log on local disk, so they survive a restart
+type ConfigurationRepository struct {
+	mu             sync.RWMutex
+	configurations configurationState
+	index          *index.Configurations // The latest versions, sorted for listing
+	store          *store
+	aliases        aliasState // Kept in a store of their own, moving an alias doesn't write a version
+	aliasStore     *store
+}
+
+// NewConfigurationRepository opens the file-backed configuration repository,
+// restoring the latest snapshot and replaying the log written after it
+func NewConfigurationRepository(cfg *config.Storage) (*ConfigurationRepository, error) {
+	opts, err := parseOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ConfigurationRepository{
+		configurations: make(configurationState),
+		aliases:        make(aliasState),
+	}
+
+	store, err := openStore(opts, "configurations", &r.configurations, r.apply)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasStore, err := openStore(opts, "aliases", &r.aliases, r.applyAlias)
+	if err != nil {
+		store.close()
+		return nil, err
+	}
+	r.aliasStore = aliasStore
+
+	// The index is built once the state is restored, replaying the log doesn't keep it
+	r.index = index.NewConfigurations()
+	for _, versions := range r.configurations {
+		r.index.Replace(nil, versions[len(versions)-1])
+	}
+
+	r.store = store
+	return r, nil
+}
+
+// apply replays a logged change onto the in-memory state
+func (r *ConfigurationRepository) apply(op string, data json.RawMessage) error {
+	switch op {
+	case opPut, opRollback, opTombstone, opRestore:
+		var config domain.Config
+		if err := json.Unmarshal(data, &config); err != nil {
+			return err
+		}
+		if config.Namespace == "" {
+			config.Namespace = domain.DefaultNamespace // Logged before namespaces
+		}
+		key := configurationKey(config.Namespace, config.Name)
+		r.configurations[key] = append(r.configurations[key], &config)
+		return nil
+	case opPurge:
+		var purge purgeEntry
+		if err := json.Unmarshal(data, &purge); err != nil {
+			// Logged before namespaces, when a purge only held the name
+			if err := json.Unmarshal(data, &purge.Name); err != nil {
+				return err
+			}
+			purge.Namespace = domain.DefaultNamespace
+		}
+		delete(r.configurations, configurationKey(purge.Namespace, purge.Name))
+		return nil
+	default:
+		return fmt.Errorf("unknown log operation %q", op)
+	}
+}
+
+// latest returns the latest version of a configuration, or nil if it doesn't exist.
+// The caller must hold the lock
+func (r *ConfigurationRepository) latest(namespace, name string) *domain.Config {
+	versions := r.configurations[configurationKey(namespace, name)]
+	if len(versions) == 0 {
+		return nil
+	}
+
+	return versions[len(versions)-1]
+}
+
+// commit logs the new version, appends it to the in-memory state, and snapshots if due.
+// The caller must hold the write lock
+func (r *ConfigurationRepository) commit(op string, config *domain.Config) error {
+	if err := r.store.append(op, config); err != nil {
+		return err
+	}
+
+	r.index.Replace(r.latest(config.Namespace, config.Name), config)
+
+	key := configurationKey(config.Namespace, config.Name)
+	r.configurations[key] = append(r.configurations[key], config)
+
+	if err := r.store.snapshotIfDue(r.configurations); err != nil {
+		// The change is already durable in the log, so a failed snapshot is retried on the next write
+		slog.Error("Error writing configuration snapshot", "error", err)
+	}
+
+	return nil
+}
+
+func (r *ConfigurationRepository) PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	latestVersion := 0
+	if versions := r.configurations[configurationKey(config.Namespace, config.Name)]; len(versions) > 0 {
+		latestVersion = versions[len(versions)-1].Version
+	}
+
+	if expectedVersion != 0 && expectedVersion != latestVersion {
+		return nil, domain.ErrVersionConflict // Someone else has written a version in the meantime
+	}
+
+	config.Version = latestVersion + 1 // Increment the version, a new config starts at 1
+	config.Deleted = false
+	config.CreatedAt = time.Now() // Set the creation timestamp
+
+	if err := r.commit(opPut, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (r *ConfigurationRepository) GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	config := r.latest(namespace, name)
+
+	if config == nil || config.Deleted {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return config, nil // Return the latest version of the config
+}
+
+// ListConfigurations filters the latest versions in process, without a query the page is found in the sorted index
+// and a label selector only looks at the configurations that have the labels it requires
+func (r *ConfigurationRepository) ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	configs, total := r.index.Page(namespace, query, page)
+
+	return configs, total, nil
+}
+
+func (r *ConfigurationRepository) ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions, ok := r.configurations[configurationKey(namespace, name)]
+
+	if !ok {
+		return nil, 0, domain.ErrDataNotFound
+	}
+
+	if filter != (domain.VersionFilter{}) {
+		var filtered []*domain.Config
+		for _, v := range versions {
+			if filter.Matches(v) {
+				filtered = append(filtered, v)
+			}
+		}
+		versions = filtered
+	}
+
+	// The versions are kept in version order, which is also their order by name and by creation time
+	configs, total := index.Page(versions, page, nil)
+
+	return configs, total, nil
+}
+
+func (r *ConfigurationRepository) GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, v := range r.configurations[configurationKey(namespace, name)] {
+		if v.Version == version {
+			return v, nil // Return the specific version
+		}
+	}
+
+	return nil, domain.ErrDataNotFound
+}
+
+func (r *ConfigurationRepository) RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int, change domain.Change) (*domain.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions, ok := r.configurations[configurationKey(namespace, name)]
+
+	if !ok || len(versions) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	for _, v := range versions {
+		if v.Version == version && !v.Deleted { // A tombstone has no value to roll back to
+			newConfigVersion := *v                                           // Create a copy of the found version
+			newConfigVersion.RollbackedVersion = version                     // Set the version to the rolled back version
+			newConfigVersion.Version = versions[len(versions)-1].Version + 1 // Increment the version for the new config
+			newConfigVersion.CreatedAt = time.Now()                          // Set the creation timestamp
+			newConfigVersion.CreatedBy = change.CreatedBy
+			newConfigVersion.ChangeMessage = change.Message
+			newConfigVersion.Environment = "" // Every overlay is rolled back, not the one of an environment
+			newConfigVersion.PromotedFrom = ""
+			newConfigVersion.PromotedVersion = 0
+
+			if err := r.commit(opRollback, &newConfigVersion); err != nil {
+				return nil, err
+			}
+
+			return &newConfigVersion, nil // Return the rolled back version
+		}
+	}
+
+	return nil, domain.ErrDataNotFound
+}
+
+func (r *ConfigurationRepository) DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int, change domain.Change) (*domain.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.latest(namespace, name)
+	if current == nil || current.Deleted {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if expectedVersion != 0 && expectedVersion != current.Version {
+		return nil, domain.ErrVersionConflict // Someone else has written a version in the meantime
+	}
+
+	tombstone := &domain.Config{
+		Namespace:     namespace,
+		Name:          name,
+		Type:          current.Type,
+		Version:       current.Version + 1,
+		Deleted:       true,
+		CreatedAt:     time.Now(),
+		CreatedBy:     change.CreatedBy,
+		ChangeMessage: change.Message,
+	}
+
+	if err := r.commit(opTombstone, tombstone); err != nil {
+		return nil, err
+	}
+
+	return tombstone, nil
+}
+
+func (r *ConfigurationRepository) RestoreConfiguration(ctx context.Context, namespace, name string, change domain.Change) (*domain.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tombstone := r.latest(namespace, name)
+	if tombstone == nil {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if !tombstone.Deleted {
+		return nil, domain.ErrConfigurationNotDeleted
+	}
+
+	// A configuration can't be deleted twice in a row, so the version before the tombstone has a value
+	versions := r.configurations[configurationKey(namespace, name)]
+	restoredConfig := *versions[len(versions)-2]
+	restoredConfig.RollbackedVersion = restoredConfig.Version
+	restoredConfig.Environment = ""
+	restoredConfig.PromotedFrom = ""
+	restoredConfig.PromotedVersion = 0
+	restoredConfig.Version = tombstone.Version + 1
+	restoredConfig.CreatedAt = time.Now()
+	restoredConfig.CreatedBy = change.CreatedBy
+	restoredConfig.ChangeMessage = change.Message
+
+	if err := r.commit(opRestore, &restoredConfig); err != nil {
+		return nil, err
+	}
+
+	return &restoredConfig, nil
+}
+
+// PurgeConfiguration erases every version of a configuration. The log still holds the erased versions
+// until the next snapshot, so one is taken right away
+func (r *ConfigurationRepository) PurgeConfiguration(ctx context.Context, namespace, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := configurationKey(namespace, name)
+	if _, ok := r.configurations[key]; !ok {
+		return domain.ErrDataNotFound
+	}
+
+	// The aliases go first, a purge that fails in between leaves a configuration without aliases rather than aliases
+	// without a configuration
+	if err := r.purgeAliases(namespace, name); err != nil {
+		return err
+	}
+
+	if err := r.store.append(opPurge, purgeEntry{namespace, name}); err != nil {
+		return err
+	}
+
+	r.index.Replace(r.latest(namespace, name), nil)
+	delete(r.configurations, key)
+
+	// Unlike other changes, a failed snapshot is reported, as the erased versions are still on disk
+	return r.store.snapshot(r.configurations)
+}
+
+// Close flushes pending log records and releases the log files
+func (r *ConfigurationRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return errors.Join(r.store.close(), r.aliasStore.close())
+}
@@ -0,0 +1,335 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/storagetest"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+)
+
+func newTestRepository(t *testing.T, dir string, snapshotEvery string) *ConfigurationRepository {
+	t.Helper()
+
+	repo, err := NewConfigurationRepository(&config.Storage{
+		FileDir:           dir,
+		FileSync:          "always",
+		FileSnapshotEvery: snapshotEvery,
+	})
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	return repo
+}
+
+func TestConfigurationRepository(t *testing.T) {
+	// Once replaying the log alone, once a snapshot and the log after it
+	for _, snapshotEvery := range []string{"", "2"} {
+		t.Run("SnapshotEvery"+snapshotEvery, func(t *testing.T) {
+			storagetest.TestConfigurationRepository(t, func(t *testing.T) (port.ConfigurationRepository, func() port.ConfigurationRepository) {
+				dir := t.TempDir()
+				repo := newTestRepository(t, dir, snapshotEvery)
+				t.Cleanup(func() { repo.Close() })
+
+				return repo, func() port.ConfigurationRepository {
+					repo.Close()
+					repo = newTestRepository(t, dir, snapshotEvery)
+					return repo
+				}
+			})
+		})
+	}
+}
+
+func TestReplayAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestRepository(t, dir, "")
+
+	t1 := time.Now()
+	_, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "person", Value: map[string]interface{}{"name": "John"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	_, err = repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "person", Value: map[string]interface{}{"name": "John II"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	rolledBack, err := repo.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, "test_config", 1, domain.Change{})
+	if err != nil {
+		t.Fatalf("Failed to rollback configuration: %v", err)
+	}
+	t2 := time.Now()
+
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	repo = newTestRepository(t, dir, "")
+	defer repo.Close()
+
+	versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("Expected 3 versions, got %d", len(versions))
+	}
+
+	validateConfig(t, versions[0], "test_config", map[string]interface{}{"name": "John"}, 1, t1, t2)
+	validateConfig(t, versions[1], "test_config", map[string]interface{}{"name": "John II"}, 2, t1, t2)
+	validateConfig(t, versions[2], "test_config", map[string]interface{}{"name": "John"}, 3, t1, t2)
+
+	if versions[2].RollbackedVersion != 1 {
+		t.Errorf("Expected RollbackedVersion 1, got %d", versions[2].RollbackedVersion)
+	}
+	if !versions[2].CreatedAt.Equal(rolledBack.CreatedAt) {
+		t.Errorf("Expected CreatedAt %v to survive a restart, got %v", rolledBack.CreatedAt, versions[2].CreatedAt)
+	}
+
+	// New versions continue from the replayed history
+	config, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"name": "John III"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if config.Version != 4 {
+		t.Errorf("Expected Version 4, got %d", config.Version)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestRepository(t, dir, "2")
+
+	for i := 0; i < 3; i++ {
+		_, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"index": float64(i)}}, 0)
+		if err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "configurations.snapshot")); err != nil {
+		t.Fatalf("Expected snapshot to be written, got %v", err)
+	}
+
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	repo = newTestRepository(t, dir, "2")
+	defer repo.Close()
+
+	versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("Expected 3 versions, got %d", len(versions))
+	}
+	for i, v := range versions {
+		if v.Version != i+1 || v.Value["index"] != float64(i) {
+			t.Errorf("Expected version %d with index %d, got %v", i+1, i, v)
+		}
+	}
+}
+
+func TestTornTailIsTruncated(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestRepository(t, dir, "")
+
+	_, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"name": "John"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	walPath := filepath.Join(dir, "configurations.wal")
+	info, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Failed to stat log: %v", err)
+	}
+	intactSize := info.Size()
+
+	// Simulate a crash in the middle of writing the next record
+	f, err := os.OpenFile(walPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatalf("Failed to open log: %v", err)
+	}
+	if _, err := f.Write([]byte{0x20, 0x00, 0x00, 0x00, 0xde, 0xad, '{', '"'}); err != nil {
+		t.Fatalf("Failed to write torn record: %v", err)
+	}
+	f.Close()
+
+	repo = newTestRepository(t, dir, "")
+
+	info, err = os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Failed to stat log: %v", err)
+	}
+	if info.Size() != intactSize {
+		t.Errorf("Expected log to be truncated to %d bytes, got %d", intactSize, info.Size())
+	}
+
+	config, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"name": "John II"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if config.Version != 2 {
+		t.Errorf("Expected Version 2, got %d", config.Version)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	repo = newTestRepository(t, dir, "")
+	defer repo.Close()
+
+	versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Errorf("Expected 2 versions, got %d", len(versions))
+	}
+}
+
+func TestParseSyncPolicy(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    syncPolicy
+		wantErr bool
+	}{
+		{input: "", want: syncPolicy{always: true}},
+		{input: "always", want: syncPolicy{always: true}},
+		{input: "never", want: syncPolicy{}},
+		{input: "500ms", want: syncPolicy{interval: 500 * time.Millisecond}},
+		{input: "sometimes", wantErr: true},
+		{input: "-1s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseSyncPolicy(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSyncPolicy(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseSyncPolicy(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func validateConfig(t *testing.T, config *domain.Config, expectedName string, expectedValue map[string]interface{}, expectedVersion int, expectedCreatedAtAfter time.Time, expectedCreatedAtBefore time.Time) {
+	if config.Name != expectedName {
+		t.Errorf("Expected Name %s, got %s", expectedName, config.Name)
+	}
+	if reflect.DeepEqual(config.Value, expectedValue) == false {
+		t.Errorf("Expected Value %s, got %s", expectedValue, config.Value)
+	}
+	if config.Version != expectedVersion {
+		t.Errorf("Expected Version %d, got %d", expectedVersion, config.Version)
+	}
+	if config.CreatedAt.IsZero() {
+		t.Error("Expected CreatedAt to be set, but it is zero")
+	}
+	if config.CreatedAt.Before(expectedCreatedAtAfter) || config.CreatedAt.After(expectedCreatedAtBefore) {
+		t.Errorf("Expected CreatedAt to be within the range of %v and %v, got %v", expectedCreatedAtAfter, expectedCreatedAtBefore, config.CreatedAt)
+	}
+}
+
+func TestPurgeErasesFromDisk(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestRepository(t, dir, "100")
+	ctx := context.Background()
+
+	for _, name := range []string{"secret_config", "other_config"} {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: name, Value: map[string]interface{}{"name": "John Doe " + name}}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	if err := repo.PurgeConfiguration(ctx, domain.DefaultNamespace, "secret_config"); err != nil {
+		t.Fatalf("Failed to purge configuration: %v", err)
+	}
+	if err := repo.PurgeConfiguration(ctx, domain.DefaultNamespace, "secret_config"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	for _, file := range []string{"configurations.snapshot", "configurations.wal"} {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", file, err)
+		}
+		if strings.Contains(string(data), "John Doe secret_config") {
+			t.Errorf("Expected the purged value to be erased from %s", file)
+		}
+	}
+
+	repo = newTestRepository(t, dir, "100")
+	defer repo.Close()
+
+	if _, _, err := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "secret_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10}); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+	if _, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "other_config"); err != nil {
+		t.Errorf("Expected the other configuration to be kept, got %v", err)
+	}
+}
+
+func TestDataBeforeNamespaces(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	// A snapshot and a log written before namespaces, where configurations are keyed and purged by name only
+	snapshot := `{"seq":2,"state":{` +
+		`"old_config":[{"name":"old_config","type":"test","value":{"key":"value"},"version":1}],` +
+		`"purged_config":[{"name":"purged_config","type":"test","value":{"key":"value"},"version":1}]}}`
+	if err := os.WriteFile(filepath.Join(dir, "configurations.snapshot"), []byte(snapshot), 0o644); err != nil {
+		t.Fatalf("Failed to write snapshot: %v", err)
+	}
+
+	opts, err := parseOptions(&config.Storage{FileDir: dir, FileSync: "always"})
+	if err != nil {
+		t.Fatalf("Failed to parse options: %v", err)
+	}
+	store, err := openStore(opts, "configurations", &map[string]any{}, func(string, json.RawMessage) error { return nil })
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	if err := store.append(opPurge, "purged_config"); err != nil {
+		t.Fatalf("Failed to append purge: %v", err)
+	}
+	if err := store.append(opPut, &domain.Config{Name: "old_config", Type: "test", Value: map[string]interface{}{"key": "new value"}, Version: 2}); err != nil {
+		t.Fatalf("Failed to append put: %v", err)
+	}
+	store.close()
+
+	repo := newTestRepository(t, dir, "")
+	defer repo.Close()
+
+	config, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "old_config")
+	if err != nil {
+		t.Fatalf("Expected the configuration in the default namespace, got %v", err)
+	}
+	if config.Namespace != domain.DefaultNamespace || config.Version != 2 {
+		t.Errorf("Expected version 2 in the default namespace, got %v", config)
+	}
+
+	if _, _, err := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "purged_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10}); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+}
@@ -0,0 +1,169 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// NamespaceRepository keeps the namespaces in memory and persists every change to a write-ahead log on local disk,
+// so they survive a restart
+type NamespaceRepository struct {
+	mu         sync.RWMutex
+	namespaces map[string]*domain.Namespace
+	store      *store
+}
+
+// NewNamespaceRepository opens the file-backed namespace repository, restoring the latest snapshot and replaying
+// the log written after it. The default namespace is created the first time the repository is opened
+func NewNamespaceRepository(cfg *config.Storage) (*NamespaceRepository, error) {
+	opts, err := parseOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &NamespaceRepository{
+		namespaces: make(map[string]*domain.Namespace),
+	}
+
+	store, err := openStore(opts, "namespaces", &r.namespaces, r.apply)
+	if err != nil {
+		return nil, err
+	}
+	r.store = store
+
+	if _, ok := r.namespaces[domain.DefaultNamespace]; !ok {
+		if _, err := r.put(&domain.Namespace{Name: domain.DefaultNamespace, CreatedAt: time.Now()}); err != nil {
+			store.close()
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// apply replays a logged change onto the in-memory state
+func (r *NamespaceRepository) apply(op string, data json.RawMessage) error {
+	switch op {
+	case opPut:
+		var namespace domain.Namespace
+		if err := json.Unmarshal(data, &namespace); err != nil {
+			return err
+		}
+		r.namespaces[namespace.Name] = &namespace
+		return nil
+	case opDelete:
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return err
+		}
+		delete(r.namespaces, name)
+		return nil
+	default:
+		return fmt.Errorf("unknown log operation %q", op)
+	}
+}
+
+// put logs a new namespace and applies it. The caller must hold the write lock
+func (r *NamespaceRepository) put(namespace *domain.Namespace) (*domain.Namespace, error) {
+	if err := r.store.append(opPut, namespace); err != nil {
+		return nil, err
+	}
+
+	r.namespaces[namespace.Name] = namespace
+	if err := r.store.snapshotIfDue(r.namespaces); err != nil {
+		// The change is already durable in the log, so a failed snapshot is retried on the next write
+		slog.Error("Error writing namespace snapshot", "error", err)
+	}
+
+	stored := *namespace // Return a copy so the caller can't modify the state
+	return &stored, nil
+}
+
+func (r *NamespaceRepository) CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.namespaces[namespace.Name]; ok {
+		return nil, domain.ErrConflictingData
+	}
+
+	newNamespace := *namespace          // Store a copy so the caller can't modify it
+	newNamespace.CreatedAt = time.Now() // Set the creation timestamp
+
+	return r.put(&newNamespace)
+}
+
+func (r *NamespaceRepository) GetNamespace(ctx context.Context, name string) (*domain.Namespace, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	namespace, ok := r.namespaces[name]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	found := *namespace
+	return &found, nil
+}
+
+func (r *NamespaceRepository) ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error) {
+	r.mu.RLock()
+	namespaces := make([]*domain.Namespace, 0, len(r.namespaces))
+	for _, namespace := range r.namespaces {
+		found := *namespace
+		namespaces = append(namespaces, &found)
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that pages are stable
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].Name < namespaces[j].Name
+	})
+
+	if skip >= uint64(len(namespaces)) {
+		return nil, nil // No namespaces to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(namespaces)) {
+		end = uint64(len(namespaces))
+	}
+
+	return namespaces[skip:end], nil
+}
+
+func (r *NamespaceRepository) DeleteNamespace(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.namespaces[name]; !ok {
+		return domain.ErrDataNotFound
+	}
+
+	if err := r.store.append(opDelete, name); err != nil {
+		return err
+	}
+
+	delete(r.namespaces, name)
+	if err := r.store.snapshotIfDue(r.namespaces); err != nil {
+		slog.Error("Error writing namespace snapshot", "error", err)
+	}
+
+	return nil
+}
+
+// Close flushes pending log records and releases the log file
+func (r *NamespaceRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.close()
+}
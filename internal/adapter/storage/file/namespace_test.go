@@ -0,0 +1,57 @@
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func newTestNamespaceRepository(t *testing.T, dir string) *NamespaceRepository {
+	t.Helper()
+
+	repo, err := NewNamespaceRepository(&config.Storage{
+		FileDir:  dir,
+		FileSync: "always",
+	})
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	return repo
+}
+
+func TestNamespaceReplayAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestNamespaceRepository(t, dir)
+	ctx := context.Background()
+
+	for _, name := range []string{"payments", "orders"} {
+		if _, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: name, Metadata: map[string]string{"team": name}}); err != nil {
+			t.Fatalf("Failed to create namespace: %v", err)
+		}
+	}
+	if err := repo.DeleteNamespace(ctx, "orders"); err != nil {
+		t.Fatalf("Failed to delete namespace: %v", err)
+	}
+
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	repo = newTestNamespaceRepository(t, dir)
+	defer repo.Close()
+
+	namespaces, err := repo.ListNamespaces(ctx, 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list namespaces: %v", err)
+	}
+	if len(namespaces) != 2 || namespaces[0].Name != domain.DefaultNamespace || namespaces[1].Name != "payments" || namespaces[1].Metadata["team"] != "payments" {
+		t.Errorf("Expected the default and payments namespaces, got %v", namespaces)
+	}
+
+	if _, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: "payments"}); err != domain.ErrConflictingData {
+		t.Errorf("Expected error %v, got %v", domain.ErrConflictingData, err)
+	}
+}
@@ -0,0 +1,199 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+const opDelete = "delete"
+
+// SchemaRepository keeps the version history of the schema of every config type in memory and persists every change
+// to a write-ahead log on local disk, so they survive a restart
+type SchemaRepository struct {
+	mu      sync.RWMutex
+	schemas map[string][]*domain.Schema
+	store   *store
+}
+
+// NewSchemaRepository opens the file-backed schema repository,
+// restoring the latest snapshot and replaying the log written after it
+func NewSchemaRepository(cfg *config.Storage) (*SchemaRepository, error) {
+	opts, err := parseOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &SchemaRepository{
+		schemas: make(map[string][]*domain.Schema),
+	}
+
+	store, err := openStore(opts, "schemas", &r.schemas, r.apply)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store = store
+	return r, nil
+}
+
+// apply replays a logged change onto the in-memory state
+func (r *SchemaRepository) apply(op string, data json.RawMessage) error {
+	switch op {
+	case opPut:
+		var schema domain.Schema
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return err
+		}
+		r.schemas[schema.Type] = append(r.schemas[schema.Type], &schema)
+		return nil
+	case opDelete:
+		var schemaType string
+		if err := json.Unmarshal(data, &schemaType); err != nil {
+			return err
+		}
+		delete(r.schemas, schemaType)
+		return nil
+	default:
+		return fmt.Errorf("unknown log operation %q", op)
+	}
+}
+
+// snapshotIfDue snapshots the in-memory state once enough changes have been logged.
+// The caller must hold the write lock
+func (r *SchemaRepository) snapshotIfDue() {
+	if err := r.store.snapshotIfDue(r.schemas); err != nil {
+		// The change is already durable in the log, so a failed snapshot is retried on the next write
+		slog.Error("Error writing schema snapshot", "error", err)
+	}
+}
+
+func (r *SchemaRepository) PutSchema(ctx context.Context, schema *domain.Schema, expectedVersion int) (*domain.Schema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions := r.schemas[schema.Type]
+
+	latestVersion := 0
+	if len(versions) > 0 {
+		latestVersion = versions[len(versions)-1].Version
+	}
+
+	if expectedVersion != 0 && expectedVersion != latestVersion {
+		return nil, domain.ErrVersionConflict // Someone else has registered a version in the meantime
+	}
+
+	newSchema := *schema                  // Store a copy so the caller can't modify the history
+	newSchema.Version = latestVersion + 1 // Increment the version, a new type starts at 1
+	newSchema.CreatedAt = time.Now()      // Set the creation timestamp
+
+	if err := r.store.append(opPut, &newSchema); err != nil {
+		return nil, err
+	}
+
+	r.schemas[schema.Type] = append(versions, &newSchema)
+	r.snapshotIfDue()
+
+	return &newSchema, nil
+}
+
+func (r *SchemaRepository) GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if versions := r.schemas[schemaType]; len(versions) > 0 {
+		return versions[len(versions)-1], nil // Return the latest version of the schema
+	}
+
+	return nil, domain.ErrDataNotFound
+}
+
+func (r *SchemaRepository) ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error) {
+	r.mu.RLock()
+	schemas := make([]*domain.Schema, 0, len(r.schemas))
+	for _, versions := range r.schemas {
+		schemas = append(schemas, versions[len(versions)-1]) // Get the latest version of each schema
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that pages are stable
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Type < schemas[j].Type })
+
+	if skip >= uint64(len(schemas)) {
+		return nil, nil // No schemas to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(schemas)) {
+		end = uint64(len(schemas))
+	}
+
+	return schemas[skip:end], nil
+}
+
+func (r *SchemaRepository) ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error) {
+	r.mu.RLock()
+	versions := r.schemas[schemaType]
+	r.mu.RUnlock()
+
+	if len(versions) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if skip >= uint64(len(versions)) {
+		return nil, nil // No versions to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(versions)) {
+		end = uint64(len(versions))
+	}
+
+	return versions[skip:end:end], nil
+}
+
+func (r *SchemaRepository) GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, v := range r.schemas[schemaType] {
+		if v.Version == version {
+			return v, nil // Return the specific version
+		}
+	}
+
+	return nil, domain.ErrDataNotFound
+}
+
+func (r *SchemaRepository) DeleteSchema(ctx context.Context, schemaType string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.schemas[schemaType]; !ok {
+		return domain.ErrDataNotFound
+	}
+
+	if err := r.store.append(opDelete, schemaType); err != nil {
+		return err
+	}
+
+	delete(r.schemas, schemaType)
+	r.snapshotIfDue()
+
+	return nil
+}
+
+// Close flushes pending log records and releases the log file
+func (r *SchemaRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store.close()
+}
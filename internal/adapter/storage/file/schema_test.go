@@ -0,0 +1,89 @@
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func newTestSchemaRepository(t *testing.T, dir string) *SchemaRepository {
+	t.Helper()
+
+	repo, err := NewSchemaRepository(&config.Storage{
+		FileDir:  dir,
+		FileSync: "always",
+	})
+	if err != nil {
+		t.Fatalf("Failed to open repository: %v", err)
+	}
+
+	return repo
+}
+
+func TestSchemaReplayAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	repo := newTestSchemaRepository(t, dir)
+
+	created, err := repo.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": "object"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+	_, err = repo.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": "string"}}, 1)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+	_, err = repo.PutSchema(context.Background(), &domain.Schema{Type: "address", Definition: map[string]interface{}{"type": "object"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+	if err := repo.DeleteSchema(context.Background(), "address"); err != nil {
+		t.Fatalf("Failed to delete schema: %v", err)
+	}
+
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Failed to close repository: %v", err)
+	}
+
+	repo = newTestSchemaRepository(t, dir)
+	defer repo.Close()
+
+	schemas, err := repo.ListSchemas(context.Background(), 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list schemas: %v", err)
+	}
+	if len(schemas) != 1 {
+		t.Fatalf("Expected 1 schema, got %d", len(schemas))
+	}
+
+	got := schemas[0]
+	if got.Type != "person" || got.Version != 2 || got.Definition["type"] != "string" {
+		t.Errorf("Expected the latest person schema, got %v", got)
+	}
+
+	versions, err := repo.ListSchemaVersions(context.Background(), "person", 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("Expected 2 versions, got %d", len(versions))
+	}
+	if !versions[0].CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("Expected CreatedAt %v to survive a restart, got %v", created.CreatedAt, versions[0].CreatedAt)
+	}
+
+	// New versions continue from the replayed history
+	schema, err := repo.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": "number"}}, 2)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+	if schema.Version != 3 {
+		t.Errorf("Expected Version 3, got %d", schema.Version)
+	}
+
+	_, err = repo.GetSchema(context.Background(), "address")
+	if err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+}
@@ -0,0 +1,328 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// recordHeaderSize is the size of the length and checksum prefix of each record
+const recordHeaderSize = 8
+
+// maxRecordSize guards against allocating huge buffers for a corrupted length prefix
+const maxRecordSize = 64 << 20
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errTornRecord is returned when a record is cut short by the end of the file
+var errTornRecord = errors.New("torn record")
+
+// errCorruptRecord is returned when a record has an invalid length or fails its checksum
+var errCorruptRecord = errors.New("corrupt record")
+
+// errLogFailed is returned by every append after a failed append couldn't be taken back out of the log
+var errLogFailed = errors.New("write-ahead log failed")
+
+// syncPolicy decides when appended records are flushed to stable storage
+type syncPolicy struct {
+	always   bool
+	interval time.Duration
+}
+
+// parseSyncPolicy parses "always", "never" or a duration such as "1s"
+func parseSyncPolicy(s string) (syncPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "always":
+		return syncPolicy{always: true}, nil
+	case "never", "none":
+		return syncPolicy{}, nil
+	}
+
+	interval, err := time.ParseDuration(s)
+	if err != nil || interval <= 0 {
+		return syncPolicy{}, fmt.Errorf("invalid sync policy %q", s)
+	}
+
+	return syncPolicy{interval: interval}, nil
+}
+
+// writeAheadLog is an append-only file of length-prefixed, checksummed records.
+// Each record is laid out as: uint32 payload length | uint32 crc32c(payload) | payload
+type writeAheadLog struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	policy syncPolicy
+	size   int64 // Offset just past the last record appended in full
+	failed error // Why the log stopped accepting appends, if it did
+	dirty  bool
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// openWriteAheadLog opens the log at path, replaying every intact record through fn.
+// A torn or corrupted tail is truncated so that new records are appended after the last good one, a corrupted record
+// anywhere else fails the open rather than drop the records after it.
+func openWriteAheadLog(path string, policy syncPolicy, fn func(payload []byte) error) (*writeAheadLog, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := replay(file, fn)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("replaying write-ahead log %s: %w", path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if info.Size() > offset {
+		slog.Warn("Truncating torn tail of write-ahead log", "path", path, "offset", offset, "size", info.Size())
+		if err := file.Truncate(offset); err != nil {
+			file.Close()
+			return nil, err
+		}
+		if err := file.Sync(); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	l := &writeAheadLog{
+		path:   path,
+		file:   file,
+		policy: policy,
+		size:   offset,
+		done:   make(chan struct{}),
+	}
+
+	if policy.interval > 0 {
+		l.wg.Add(1)
+		go l.syncLoop()
+	}
+
+	return l, nil
+}
+
+// replay reads records from the start of the file and returns the offset just past the last intact record
+func replay(file *os.File, fn func(payload []byte) error) (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	reader := bufio.NewReader(file)
+	var offset int64
+
+	for {
+		payload, extent, err := readRecord(reader)
+		if err == io.EOF || errors.Is(err, errTornRecord) {
+			return offset, nil
+		}
+		if errors.Is(err, errCorruptRecord) {
+			// A record that was being written when the process died is the last thing in the file
+			tail, tailErr := isTail(file, offset, extent, info.Size())
+			if tailErr != nil {
+				return 0, tailErr
+			}
+			if !tail {
+				return 0, fmt.Errorf("%w at offset %d", err, offset)
+			}
+			return offset, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if err := fn(payload); err != nil {
+			return 0, err
+		}
+
+		offset += extent
+	}
+}
+
+// isTail tells whether a corrupt record at offset ends the file. A record with a valid length spans extent bytes, one
+// without, as when the file system left zeros past the last write, has to be followed by nothing but zeros
+func isTail(file *os.File, offset, extent, size int64) (bool, error) {
+	if extent > 0 {
+		return offset+extent == size, nil
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(file, offset, size-offset))
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if b != 0 {
+			return false, nil
+		}
+	}
+}
+
+// readRecord reads a single record, returning io.EOF on a clean record boundary. The extent of a record is the number
+// of bytes it spans, 0 if its length is invalid
+func readRecord(reader io.Reader) ([]byte, int64, error) {
+	var header [recordHeaderSize]byte
+
+	n, err := io.ReadFull(reader, header[:])
+	if err == io.EOF {
+		return nil, 0, io.EOF
+	}
+	if err == io.ErrUnexpectedEOF {
+		return nil, 0, fmt.Errorf("%w: short header of %d bytes", errTornRecord, n)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	length := binary.LittleEndian.Uint32(header[0:4])
+	checksum := binary.LittleEndian.Uint32(header[4:8])
+
+	if length == 0 || length > maxRecordSize {
+		return nil, 0, fmt.Errorf("%w: invalid length %d", errCorruptRecord, length)
+	}
+	extent := int64(recordHeaderSize) + int64(length)
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, extent, fmt.Errorf("%w: short payload", errTornRecord)
+		}
+		return nil, extent, err
+	}
+
+	if crc32.Checksum(payload, crcTable) != checksum {
+		return nil, extent, fmt.Errorf("%w: checksum mismatch", errCorruptRecord)
+	}
+
+	return payload, extent, nil
+}
+
+// Append writes a record to the end of the log, syncing it if the policy requires
+func (l *writeAheadLog) Append(payload []byte) error {
+	record := make([]byte, recordHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	copy(record[recordHeaderSize:], payload)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.failed != nil {
+		return fmt.Errorf("%w: %w", errLogFailed, l.failed)
+	}
+
+	if _, err := l.file.Write(record); err != nil {
+		return l.rollback(err)
+	}
+
+	if l.policy.always {
+		if err := l.file.Sync(); err != nil {
+			return l.rollback(err)
+		}
+	}
+
+	l.size += int64(len(record))
+	l.dirty = !l.policy.always
+	return nil
+}
+
+// rollback takes the bytes of a failed append back out of the log, so that a record the caller was told failed is
+// never replayed and the next record starts where this one did. If the log can't be cut back, it refuses every further
+// append rather than write after bytes it doesn't know. The caller must hold the log lock
+func (l *writeAheadLog) rollback(cause error) error {
+	if err := l.file.Truncate(l.size); err != nil {
+		l.failed = err
+		return errors.Join(cause, err)
+	}
+	if _, err := l.file.Seek(l.size, io.SeekStart); err != nil {
+		l.failed = err
+		return errors.Join(cause, err)
+	}
+
+	return cause
+}
+
+// Reset discards every record in the log, typically after a snapshot has been written
+func (l *writeAheadLog) Reset() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := l.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	l.size = 0
+	l.dirty = false
+	return l.file.Sync()
+}
+
+// syncLoop periodically flushes the log when running with an interval sync policy
+func (l *writeAheadLog) syncLoop() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.policy.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.mu.Lock()
+			if l.dirty {
+				if err := l.file.Sync(); err != nil {
+					slog.Error("Error syncing write-ahead log", "path", l.path, "error", err)
+				}
+				l.dirty = false
+			}
+			l.mu.Unlock()
+		case <-l.done:
+			return
+		}
+	}
+}
+
+// Close stops the background sync, flushes pending records and closes the file
+func (l *writeAheadLog) Close() error {
+	close(l.done)
+	l.wg.Wait()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.file.Sync(); err != nil {
+		l.file.Close()
+		return err
+	}
+
+	return l.file.Close()
+}
@@ -0,0 +1,155 @@
+package file
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// openTestLog opens the log at path and returns it with the payloads it replayed
+func openTestLog(t *testing.T, path string) (*writeAheadLog, []string) {
+	t.Helper()
+
+	var replayed []string
+	log, err := openWriteAheadLog(path, syncPolicy{always: true}, func(payload []byte) error {
+		replayed = append(replayed, string(payload))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to open log: %v", err)
+	}
+
+	return log, replayed
+}
+
+func TestFailedAppendIsRolledBack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	log, _ := openTestLog(t, path)
+
+	if err := log.Append([]byte("first")); err != nil {
+		t.Fatalf("Failed to append: %v", err)
+	}
+
+	// An append that wrote part of its record before failing leaves nothing behind
+	if _, err := log.file.Write([]byte{0x06, 0x00, 0x00, 0x00, 0xde, 0xad}); err != nil {
+		t.Fatalf("Failed to write partial record: %v", err)
+	}
+	cause := errors.New("no space left on device")
+	if err := log.rollback(cause); err != cause {
+		t.Fatalf("Expected the cause of the failed append, got %v", err)
+	}
+
+	if err := log.Append([]byte("second")); err != nil {
+		t.Fatalf("Failed to append: %v", err)
+	}
+	if err := log.Close(); err != nil {
+		t.Fatalf("Failed to close log: %v", err)
+	}
+
+	log, replayed := openTestLog(t, path)
+	defer log.Close()
+
+	if !reflect.DeepEqual(replayed, []string{"first", "second"}) {
+		t.Errorf("Expected the acknowledged records, got %q", replayed)
+	}
+}
+
+func TestLogFailsWhenAppendCantBeRolledBack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	log, _ := openTestLog(t, path)
+
+	// Neither the write nor the truncate that would take it back can succeed on a closed file
+	log.file.Close()
+
+	if err := log.Append([]byte("first")); err == nil || errors.Is(err, errLogFailed) {
+		t.Fatalf("Expected the append to fail with its own error, got %v", err)
+	}
+	if err := log.Append([]byte("second")); !errors.Is(err, errLogFailed) {
+		t.Errorf("Expected every later append to fail with errLogFailed, got %v", err)
+	}
+}
+
+// writeTestLog writes a log of records and returns the offset each one starts at
+func writeTestLog(t *testing.T, path string, payloads ...string) []int64 {
+	t.Helper()
+
+	log, _ := openTestLog(t, path)
+	defer log.Close()
+
+	var offsets []int64
+	for _, payload := range payloads {
+		offsets = append(offsets, log.size)
+		if err := log.Append([]byte(payload)); err != nil {
+			t.Fatalf("Failed to append: %v", err)
+		}
+	}
+
+	return offsets
+}
+
+func TestCorruptTailIsTruncated(t *testing.T) {
+	tests := []struct {
+		name     string
+		corrupt  func(t *testing.T, f *os.File, last int64)
+		expected []string
+	}{
+		{"ChecksumMismatch", func(t *testing.T, f *os.File, last int64) {
+			if _, err := f.WriteAt([]byte{'X'}, last+recordHeaderSize); err != nil {
+				t.Fatalf("Failed to corrupt record: %v", err)
+			}
+		}, []string{"first"}},
+		{"ZeroFilled", func(t *testing.T, f *os.File, last int64) {
+			info, _ := f.Stat()
+			if _, err := f.WriteAt(make([]byte, 64), info.Size()); err != nil {
+				t.Fatalf("Failed to extend log: %v", err)
+			}
+		}, []string{"first", "second"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.wal")
+			offsets := writeTestLog(t, path, "first", "second")
+
+			f, err := os.OpenFile(path, os.O_RDWR, 0o644)
+			if err != nil {
+				t.Fatalf("Failed to open log: %v", err)
+			}
+			tt.corrupt(t, f, offsets[1])
+			f.Close()
+
+			log, replayed := openTestLog(t, path)
+			defer log.Close()
+
+			if !reflect.DeepEqual(replayed, tt.expected) {
+				t.Errorf("Expected %q to be replayed, got %q", tt.expected, replayed)
+			}
+		})
+	}
+}
+
+func TestCorruptRecordBeforeTheTailFailsReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.wal")
+	offsets := writeTestLog(t, path, "first", "second", "third")
+
+	f, err := os.OpenFile(path, os.O_RDWR, 0o644)
+	if err != nil {
+		t.Fatalf("Failed to open log: %v", err)
+	}
+	if _, err := f.WriteAt([]byte{'X'}, offsets[1]+recordHeaderSize); err != nil {
+		t.Fatalf("Failed to corrupt record: %v", err)
+	}
+	info, _ := f.Stat()
+	f.Close()
+
+	if _, err := openWriteAheadLog(path, syncPolicy{always: true}, func([]byte) error { return nil }); !errors.Is(err, errCorruptRecord) {
+		t.Fatalf("Expected errCorruptRecord, got %v", err)
+	}
+
+	// The records after the corrupt one are kept for whoever repairs the log
+	if after, err := os.Stat(path); err != nil || after.Size() != info.Size() {
+		t.Errorf("Expected the log to be left at %d bytes, got %v, %v", info.Size(), after, err)
+	}
+}
@@ -0,0 +1,242 @@
+// Package index keeps configurations sorted in every order they can be listed in, so that the in-process storage
+// drivers page through a list with a binary search instead of sorting it on each call. Configurations are also indexed
+// by their labels, so that a label selector only looks at the configurations that have the labels it requires
+package index
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// sortFields are the orders every list is kept in
+var sortFields = []domain.SortField{domain.SortByName, domain.SortByCreatedAt, domain.SortByVersion}
+
+// sorted holds the same configurations once for each sort field, in ascending order
+type sorted map[domain.SortField][]*domain.Config
+
+// insert adds a configuration to every order
+func (s sorted) insert(config *domain.Config) {
+	for _, field := range sortFields {
+		list := s[field]
+		i := search(list, config, field)
+		list = append(list, nil)
+		copy(list[i+1:], list[i:])
+		list[i] = config
+		s[field] = list
+	}
+}
+
+// remove takes a configuration out of every order
+func (s sorted) remove(config *domain.Config) {
+	for _, field := range sortFields {
+		list := s[field]
+		if i := search(list, config, field); i < len(list) && list[i] == config {
+			s[field] = append(list[:i], list[i+1:]...)
+		}
+	}
+}
+
+// search returns the position of the first configuration of a list that doesn't come before key
+func search(list []*domain.Config, key *domain.Config, field domain.SortField) int {
+	return sort.Search(len(list), func(i int) bool {
+		return domain.CompareConfigs(list[i], key, field) >= 0
+	})
+}
+
+// postings holds the configurations of every namespace that have a label, by the key and value of the label
+type postings map[string]map[string]map[*domain.Config]struct{}
+
+// add lists a configuration under each of its labels
+func (p postings) add(config *domain.Config) {
+	for key, value := range config.Labels {
+		if p[key] == nil {
+			p[key] = make(map[string]map[*domain.Config]struct{})
+		}
+		if p[key][value] == nil {
+			p[key][value] = make(map[*domain.Config]struct{})
+		}
+		p[key][value][config] = struct{}{}
+	}
+}
+
+// remove takes a configuration out from under each of its labels
+func (p postings) remove(config *domain.Config) {
+	for key, value := range config.Labels {
+		delete(p[key][value], config)
+		if len(p[key][value]) == 0 {
+			delete(p[key], value)
+		}
+		if len(p[key]) == 0 {
+			delete(p, key)
+		}
+	}
+}
+
+// candidates returns the configurations that have the labels a selector requires to exist, with a value of an in
+// requirement or with any value, among which are all that the selector matches. It returns false if the selector
+// requires no label to exist, then any configuration may match
+func (p postings) candidates(selector domain.LabelSelector) (map[*domain.Config]struct{}, bool) {
+	var candidates map[*domain.Config]struct{}
+	required := false
+
+	for _, requirement := range selector {
+		if requirement.Operator != domain.LabelIn && requirement.Operator != domain.LabelExists {
+			continue
+		}
+
+		having := make(map[*domain.Config]struct{})
+		for value, configs := range p[requirement.Key] {
+			if requirement.Operator == domain.LabelIn && !slices.Contains(requirement.Values, value) {
+				continue
+			}
+			for config := range configs {
+				if !required || hasCandidate(candidates, config) {
+					having[config] = struct{}{}
+				}
+			}
+		}
+
+		candidates, required = having, true
+	}
+
+	return candidates, required
+}
+
+// hasCandidate reports whether a configuration is among the candidates
+func hasCandidate(candidates map[*domain.Config]struct{}, config *domain.Config) bool {
+	_, ok := candidates[config]
+	return ok
+}
+
+// Configurations indexes the latest version of every live configuration, in its namespace and among those of every
+// namespace, and by its labels. It isn't safe for concurrent use, the storage driver guards it
+type Configurations struct {
+	namespaces map[string]sorted // The configurations of every namespace are kept under ""
+	labels     postings
+}
+
+// NewConfigurations creates an empty index
+func NewConfigurations() *Configurations {
+	return &Configurations{
+		namespaces: make(map[string]sorted),
+		labels:     make(postings),
+	}
+}
+
+// Replace indexes the new latest version of a configuration in place of the version that was latest before it.
+// Either may be nil, and a tombstone isn't indexed, so a deleted configuration is left out
+func (ix *Configurations) Replace(previous, latest *domain.Config) {
+	if previous != nil && !previous.Deleted {
+		ix.namespaces[""].remove(previous)
+		ix.namespaces[previous.Namespace].remove(previous)
+		if len(ix.namespaces[previous.Namespace][domain.SortByName]) == 0 {
+			delete(ix.namespaces, previous.Namespace)
+		}
+		ix.labels.remove(previous)
+	}
+
+	if latest != nil && !latest.Deleted {
+		for _, namespace := range []string{"", latest.Namespace} {
+			if ix.namespaces[namespace] == nil {
+				ix.namespaces[namespace] = make(sorted, len(sortFields))
+			}
+			ix.namespaces[namespace].insert(latest)
+		}
+		ix.labels.add(latest)
+	}
+}
+
+// Page returns a page of the configurations of a namespace, or of every namespace if it is empty, that pass a query,
+// and how many pass it in all. A query whose label selector requires labels to exist is only matched against the
+// configurations that have them, which are sorted for the page, any other query is matched against every configuration
+func (ix *Configurations) Page(namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64) {
+	field := page.Sort
+	if field == "" {
+		field = domain.SortByName
+	}
+
+	list := ix.namespaces[namespace][field]
+	if query.IsEmpty() {
+		return matchingPage(list, page, nil, domain.CompareLatest)
+	}
+
+	if candidates, ok := ix.labels.candidates(query.Labels); ok && len(candidates) < len(list) {
+		list = make([]*domain.Config, 0, len(candidates))
+		for config := range candidates {
+			if namespace == "" || config.Namespace == namespace {
+				list = append(list, config)
+			}
+		}
+		slices.SortFunc(list, func(a, b *domain.Config) int {
+			return domain.CompareConfigs(a, b, field)
+		})
+	}
+
+	return matchingPage(list, page, query.Matches, domain.CompareLatest)
+}
+
+// Page returns a page of the configurations of a list that match, and how many match in all. The list is sorted in
+// ascending order by the sort field of the page request, and a nil match matches every configuration. Without a
+// match the page is found with a binary search, with one every configuration is matched. The page is a copy, so the
+// caller may keep it while the list changes
+func Page(list []*domain.Config, page domain.PageRequest, match func(*domain.Config) bool) ([]*domain.Config, uint64) {
+	return matchingPage(list, page, match, domain.CompareConfigs)
+}
+
+// matchingPage is Page with the comparison the cursor of the page is placed with
+func matchingPage(list []*domain.Config, page domain.PageRequest, match func(*domain.Config) bool, compare compareFunc) ([]*domain.Config, uint64) {
+	if match != nil {
+		var matching []*domain.Config
+		for _, config := range list {
+			if match(config) {
+				matching = append(matching, config)
+			}
+		}
+		list = matching
+	}
+
+	return pageOf(list, page, compare), uint64(len(list))
+}
+
+// compareFunc orders two configurations by a sort field, see domain.CompareConfigs and domain.CompareLatest
+type compareFunc func(a, b *domain.Config, sort domain.SortField) int
+
+// pageOf returns a page of a list sorted in ascending order by the sort field of the page request, the cursor of the
+// page is placed in the list with compare
+func pageOf(list []*domain.Config, page domain.PageRequest, compare compareFunc) []*domain.Config {
+	var configs []*domain.Config
+
+	if page.Order == domain.SortDescending {
+		// The page starts at the last configuration before the cursor and goes backwards
+		start := len(list) - 1
+		if page.Cursor != nil {
+			key := page.Cursor.Key()
+			start = sort.Search(len(list), func(i int) bool {
+				return compare(list[i], key, page.Sort) >= 0
+			}) - 1
+		}
+
+		for i := start - int(min(page.Skip, uint64(len(list)))); i >= 0 && uint64(len(configs)) < page.Limit; i-- {
+			configs = append(configs, list[i])
+		}
+
+		return configs
+	}
+
+	start := 0
+	if page.Cursor != nil {
+		// The first configuration after the cursor, the cursor may be a configuration that has since changed
+		key := page.Cursor.Key()
+		start = sort.Search(len(list), func(i int) bool {
+			return compare(list[i], key, page.Sort) > 0
+		})
+	}
+
+	for i := start + int(min(page.Skip, uint64(len(list)))); i < len(list) && uint64(len(configs)) < page.Limit; i++ {
+		configs = append(configs, list[i])
+	}
+
+	return configs
+}
@@ -0,0 +1,154 @@
+package index
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func names(configs []*domain.Config) []string {
+	var names []string
+	for _, config := range configs {
+		names = append(names, config.Name)
+	}
+
+	return names
+}
+
+func TestReplace(t *testing.T) {
+	ix := NewConfigurations()
+
+	a1 := &domain.Config{Namespace: "default", Name: "a_config", Version: 1}
+	b1 := &domain.Config{Namespace: "payments", Name: "b_config", Version: 1}
+	a2 := &domain.Config{Namespace: "default", Name: "a_config", Version: 2}
+
+	ix.Replace(nil, a1)
+	ix.Replace(nil, b1)
+	ix.Replace(a1, a2)
+
+	page := domain.PageRequest{Sort: domain.SortByVersion, Order: domain.SortDescending, Limit: 10}
+	if got, total := ix.Page("", domain.ConfigQuery{}, page); len(got) != 2 || got[0] != a2 || got[1] != b1 || total != 2 {
+		t.Errorf("Expected a_config 2 and b_config 1 of 2, got %v of %d", got, total)
+	}
+	if _, total := ix.Page("payments", domain.ConfigQuery{}, domain.PageRequest{Limit: 0}); total != 1 {
+		t.Errorf("Expected 1 configuration in the payments namespace, got %d", total)
+	}
+
+	// A tombstone takes the configuration out of the index
+	ix.Replace(b1, &domain.Config{Namespace: "payments", Name: "b_config", Version: 2, Deleted: true})
+	if got, _ := ix.Page("payments", domain.ConfigQuery{}, page); len(got) != 0 {
+		t.Errorf("Expected no configurations in the payments namespace, got %v", got)
+	}
+	if _, ok := ix.namespaces["payments"]; ok {
+		t.Error("Expected the empty payments namespace to be dropped")
+	}
+
+	ix.Replace(a2, nil)
+	if got, _ := ix.Page("", domain.ConfigQuery{}, page); len(got) != 0 {
+		t.Errorf("Expected no configurations, got %v", got)
+	}
+}
+
+func TestPage(t *testing.T) {
+	var list []*domain.Config
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		list = append(list, &domain.Config{Namespace: "default", Name: name, Version: 1})
+	}
+
+	cursor := func(name string, order domain.SortOrder) *domain.Cursor {
+		return &domain.Cursor{Sort: domain.SortByName, Order: order, Namespace: "default", Name: name, Version: 1}
+	}
+
+	tests := []struct {
+		name     string
+		page     domain.PageRequest
+		expected []string
+	}{
+		{"First", domain.PageRequest{Limit: 2}, []string{"a", "b"}},
+		{"Skip", domain.PageRequest{Skip: 3, Limit: 5}, []string{"d", "e"}},
+		{"Cursor", domain.PageRequest{Cursor: cursor("b", domain.SortAscending), Limit: 2}, []string{"c", "d"}},
+		{"CursorAndSkip", domain.PageRequest{Cursor: cursor("b", domain.SortAscending), Skip: 2, Limit: 2}, []string{"e"}},
+		{"CursorOfRemoved", domain.PageRequest{Cursor: &domain.Cursor{Namespace: "default", Name: "bb"}, Limit: 1}, []string{"c"}},
+		{"Descending", domain.PageRequest{Order: domain.SortDescending, Limit: 2}, []string{"e", "d"}},
+		{"DescendingCursor", domain.PageRequest{Order: domain.SortDescending, Cursor: cursor("d", domain.SortDescending), Limit: 2}, []string{"c", "b"}},
+		{"DescendingCursorAndSkip", domain.PageRequest{Order: domain.SortDescending, Cursor: cursor("d", domain.SortDescending), Skip: 2, Limit: 2}, []string{"a"}},
+		{"PastTheEnd", domain.PageRequest{Order: domain.SortDescending, Skip: 10, Limit: 2}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, total := Page(list, tt.page, nil); !reflect.DeepEqual(names(got), tt.expected) || total != 5 {
+				t.Errorf("Expected %v of 5, got %v of %d", tt.expected, names(got), total)
+			}
+		})
+	}
+}
+
+func TestPageMatching(t *testing.T) {
+	var list []*domain.Config
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		list = append(list, &domain.Config{Namespace: "default", Name: name, Version: 1})
+	}
+
+	vowel := func(config *domain.Config) bool { return config.Name == "a" || config.Name == "e" }
+
+	got, total := Page(list, domain.PageRequest{Cursor: &domain.Cursor{Namespace: "default", Name: "a", Version: 1}, Limit: 5}, vowel)
+	if !reflect.DeepEqual(names(got), []string{"e"}) || total != 2 {
+		t.Errorf("Expected e of 2, got %v of %d", names(got), total)
+	}
+}
+
+func TestPageLabels(t *testing.T) {
+	ix := NewConfigurations()
+
+	a := &domain.Config{Namespace: "default", Name: "a", Version: 1, Labels: map[string]string{"team": "payments", "tier": "critical"}}
+	b := &domain.Config{Namespace: "payments", Name: "b", Version: 1, Labels: map[string]string{"team": "payments"}}
+	c1 := &domain.Config{Namespace: "default", Name: "c", Version: 1, Labels: map[string]string{"team": "orders"}}
+	c2 := &domain.Config{Namespace: "default", Name: "c", Version: 2, Labels: map[string]string{"team": "payments", "tier": "low"}}
+	d := &domain.Config{Namespace: "default", Name: "d", Version: 1}
+
+	for _, config := range []*domain.Config{a, b, c1, d} {
+		ix.Replace(nil, config)
+	}
+	ix.Replace(c1, c2) // Relabeled
+
+	selector := func(s string) domain.ConfigQuery {
+		parsed, err := domain.ParseLabelSelector(s)
+		if err != nil {
+			t.Fatalf("Expected a valid selector %q, got %v", s, err)
+		}
+		return domain.ConfigQuery{Labels: parsed}
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		query     domain.ConfigQuery
+		page      domain.PageRequest
+		expected  []string
+	}{
+		{"Equal", "", selector("team=payments"), domain.PageRequest{Limit: 5}, []string{"a", "c", "b"}},
+		{"Namespace", "default", selector("team=payments"), domain.PageRequest{Limit: 5}, []string{"a", "c"}},
+		{"Relabeled", "", selector("team=orders"), domain.PageRequest{Limit: 5}, nil},
+		{"In", "", selector("tier in (critical,low)"), domain.PageRequest{Order: domain.SortDescending, Limit: 5}, []string{"c", "a"}},
+		{"ExistsAndNotIn", "", selector("team,tier notin (low)"), domain.PageRequest{Limit: 5}, []string{"a", "b"}},
+		{"DoesNotExist", "", selector("!tier"), domain.PageRequest{Limit: 5}, []string{"d", "b"}},
+		{"Cursor", "", selector("team=payments"), domain.PageRequest{Cursor: &domain.Cursor{Namespace: "default", Name: "a", Version: 1}, Limit: 1}, []string{"c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, total := ix.Page(tt.namespace, tt.query, tt.page); !reflect.DeepEqual(names(got), tt.expected) || (tt.page.Cursor == nil && total != uint64(len(tt.expected))) {
+				t.Errorf("Expected %v, got %v of %d", tt.expected, names(got), total)
+			}
+		})
+	}
+
+	ix.Replace(c2, nil)
+	ix.Replace(b, nil)
+	ix.Replace(a, nil)
+	if len(ix.labels) != 0 {
+		t.Errorf("Expected no labels to be indexed, got %v", ix.labels)
+	}
+}
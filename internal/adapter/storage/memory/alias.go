@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// aliases holds the revisions of every alias of a configuration by alias
+type aliases map[string][]*domain.Alias
+
+func (r *ConfigurationRepository) MoveAlias(ctx context.Context, alias *domain.Alias, expectedRevision int) (*domain.Alias, error) {
+	r.aliasMu.Lock()
+	defer r.aliasMu.Unlock()
+
+	key := configurationKey{alias.Namespace, alias.Name}
+	revisions := r.aliases[key][alias.Alias]
+
+	latestRevision := 0
+	if len(revisions) > 0 {
+		latestRevision = revisions[len(revisions)-1].Revision
+	}
+
+	if expectedRevision != 0 && expectedRevision != latestRevision {
+		return nil, domain.ErrAliasConflict // Someone else has moved the alias in the meantime
+	}
+
+	moved := *alias // Store a copy so the caller can't modify the history
+	moved.Revision = latestRevision + 1
+	moved.MovedAt = time.Now()
+
+	if r.aliases[key] == nil {
+		r.aliases[key] = make(aliases)
+	}
+	r.aliases[key][alias.Alias] = append(revisions, &moved)
+
+	return &moved, nil
+}
+
+func (r *ConfigurationRepository) GetAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, error) {
+	r.aliasMu.RLock()
+	defer r.aliasMu.RUnlock()
+
+	revisions := r.aliases[configurationKey{namespace, name}][alias]
+	if len(revisions) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return revisions[len(revisions)-1], nil
+}
+
+func (r *ConfigurationRepository) ListAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error) {
+	r.aliasMu.RLock()
+	var set []*domain.Alias
+	for _, revisions := range r.aliases[configurationKey{namespace, name}] {
+		if latest := revisions[len(revisions)-1]; latest.IsSet() {
+			set = append(set, latest)
+		}
+	}
+	r.aliasMu.RUnlock()
+
+	// Map iteration order is random, sort so that the list is stable
+	sort.Slice(set, func(i, j int) bool {
+		return set[i].Alias < set[j].Alias
+	})
+
+	return set, nil
+}
+
+func (r *ConfigurationRepository) ListAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error) {
+	r.aliasMu.RLock()
+	revisions := r.aliases[configurationKey{namespace, name}][alias]
+	r.aliasMu.RUnlock()
+
+	if skip >= uint64(len(revisions)) {
+		return nil, nil // No revisions to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(revisions)) {
+		end = uint64(len(revisions))
+	}
+
+	return revisions[skip:end], nil
+}
+
+// purgeAliases erases every revision of the aliases of a configuration
+func (r *ConfigurationRepository) purgeAliases(key configurationKey) {
+	r.aliasMu.Lock()
+	defer r.aliasMu.Unlock()
+
+	delete(r.aliases, key)
+}
@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func aliasVersions(aliases []*domain.Alias) []int {
+	var versions []int
+	for _, alias := range aliases {
+		versions = append(versions, alias.Version)
+	}
+
+	return versions
+}
+
+func TestMoveAlias(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	move := func(alias string, version, expectedRevision int) (*domain.Alias, error) {
+		return repo.MoveAlias(ctx, &domain.Alias{Namespace: domain.DefaultNamespace, Name: "app_config", Alias: alias, Version: version, MovedBy: "alice"}, expectedRevision)
+	}
+
+	if _, err := repo.GetAlias(ctx, domain.DefaultNamespace, "app_config", "stable"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected ErrDataNotFound for an alias never moved, got %v", err)
+	}
+
+	stable, err := move("stable", 3, 0)
+	if err != nil || stable.Revision != 1 || stable.MovedAt.IsZero() || stable.MovedBy != "alice" {
+		t.Fatalf("Expected revision 1 of stable, got %v, %v", stable, err)
+	}
+	if _, err := move("stable", 4, 1); err != nil {
+		t.Fatalf("Failed to move stable: %v", err)
+	}
+	if _, err := move("stable", 5, 1); err != domain.ErrAliasConflict {
+		t.Errorf("Expected ErrAliasConflict for a stale revision, got %v", err)
+	}
+	if _, err := move("canary", 5, 0); err != nil {
+		t.Fatalf("Failed to move canary: %v", err)
+	}
+	if _, err := move("beta", 2, 0); err != nil {
+		t.Fatalf("Failed to move beta: %v", err)
+	}
+
+	// Clearing beta keeps its revisions but leaves it out of the list
+	cleared, err := move("beta", 0, 1)
+	if err != nil || cleared.IsSet() || cleared.Revision != 2 {
+		t.Fatalf("Expected beta to be cleared at revision 2, got %v, %v", cleared, err)
+	}
+
+	aliases, err := repo.ListAliases(ctx, domain.DefaultNamespace, "app_config")
+	if err != nil || len(aliases) != 2 || aliases[0].Alias != "canary" || aliases[1].Alias != "stable" || aliases[1].Version != 4 {
+		t.Errorf("Expected canary and stable at 4, got %v, %v", aliases, err)
+	}
+
+	revisions, err := repo.ListAliasRevisions(ctx, domain.DefaultNamespace, "app_config", "stable", 0, 10)
+	if err != nil || !reflect.DeepEqual(aliasVersions(revisions), []int{3, 4}) {
+		t.Errorf("Expected the revisions of stable at 3 and 4, got %v, %v", aliasVersions(revisions), err)
+	}
+	if revisions, _ := repo.ListAliasRevisions(ctx, domain.DefaultNamespace, "app_config", "stable", 1, 10); !reflect.DeepEqual(aliasVersions(revisions), []int{4}) {
+		t.Errorf("Expected the second revision of stable, got %v", aliasVersions(revisions))
+	}
+
+	// A purge erases the aliases with the configuration
+	if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "app_config", Value: map[string]interface{}{"name": "John"}}, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if err := repo.PurgeConfiguration(ctx, domain.DefaultNamespace, "app_config"); err != nil {
+		t.Fatalf("Failed to purge configuration: %v", err)
+	}
+	if _, err := repo.GetAlias(ctx, domain.DefaultNamespace, "app_config", "stable"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected the purged alias to be gone, got %v", err)
+	}
+	if stable, err := move("stable", 1, 0); err != nil || stable.Revision != 1 {
+		t.Errorf("Expected a purged alias to start over at revision 1, got %v, %v", stable, err)
+	}
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// ApiKeyRepository stores API keys in memory
+type ApiKeyRepository struct {
+	mu   sync.RWMutex
+	keys map[string]*domain.ApiKey
+}
+
+func NewApiKeyRepository() *ApiKeyRepository {
+	return &ApiKeyRepository{
+		keys: make(map[string]*domain.ApiKey),
+	}
+}
+
+func (r *ApiKeyRepository) CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.keys[key.ID]; ok {
+		return nil, domain.ErrConflictingData
+	}
+
+	newKey := *key                // Store a copy so the caller can't modify it
+	newKey.CreatedAt = time.Now() // Set the creation timestamp
+	r.keys[key.ID] = &newKey
+
+	created := newKey
+	return &created, nil
+}
+
+func (r *ApiKeyRepository) GetApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	found := *key // Keys are changed in place, return a copy
+	return &found, nil
+}
+
+func (r *ApiKeyRepository) ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error) {
+	r.mu.RLock()
+	keys := make([]*domain.ApiKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		found := *key
+		keys = append(keys, &found)
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that pages are stable
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+		}
+		return keys[i].ID < keys[j].ID
+	})
+
+	if skip >= uint64(len(keys)) {
+		return nil, nil // No keys to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(keys)) {
+		end = uint64(len(keys))
+	}
+
+	return keys[skip:end], nil
+}
+
+func (r *ApiKeyRepository) RotateApiKey(ctx context.Context, id, hash string) (*domain.ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if key.Revoked() {
+		return nil, domain.ErrApiKeyRevoked
+	}
+
+	key.Hash = hash
+	key.RotatedAt = time.Now()
+
+	rotated := *key
+	return &rotated, nil
+}
+
+func (r *ApiKeyRepository) RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if key.Revoked() {
+		return nil, domain.ErrApiKeyRevoked
+	}
+
+	key.RevokedAt = time.Now()
+
+	revoked := *key
+	return &revoked, nil
+}
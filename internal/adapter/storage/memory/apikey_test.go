@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestApiKeyRepository(t *testing.T) {
+	repo := NewApiKeyRepository()
+
+	_, err := repo.GetApiKey(context.Background(), "a")
+	if err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	created, err := repo.CreateApiKey(context.Background(), &domain.ApiKey{ID: "a", Name: "ci", Hash: "hash-1", Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	if created.CreatedAt.IsZero() {
+		t.Errorf("Expected CreatedAt set, got %v", created)
+	}
+
+	_, err = repo.CreateApiKey(context.Background(), &domain.ApiKey{ID: "a", Name: "other"})
+	if err != domain.ErrConflictingData {
+		t.Errorf("Expected error %v, got %v", domain.ErrConflictingData, err)
+	}
+
+	_, err = repo.CreateApiKey(context.Background(), &domain.ApiKey{ID: "b", Name: "sidecar", Hash: "hash-2"})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+
+	rotated, err := repo.RotateApiKey(context.Background(), "a", "hash-3")
+	if err != nil {
+		t.Fatalf("Failed to rotate API key: %v", err)
+	}
+	if rotated.Hash != "hash-3" || rotated.RotatedAt.IsZero() || rotated.Name != "ci" {
+		t.Errorf("Expected the new hash with RotatedAt set, got %v", rotated)
+	}
+
+	revoked, err := repo.RevokeApiKey(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("Failed to revoke API key: %v", err)
+	}
+	if !revoked.Revoked() {
+		t.Errorf("Expected the key to be revoked, got %v", revoked)
+	}
+
+	// A revoked key stays revoked
+	if _, err := repo.RotateApiKey(context.Background(), "a", "hash-4"); err != domain.ErrApiKeyRevoked {
+		t.Errorf("Expected error %v, got %v", domain.ErrApiKeyRevoked, err)
+	}
+	if _, err := repo.RevokeApiKey(context.Background(), "a"); err != domain.ErrApiKeyRevoked {
+		t.Errorf("Expected error %v, got %v", domain.ErrApiKeyRevoked, err)
+	}
+	if _, err := repo.RevokeApiKey(context.Background(), "c"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	found, err := repo.GetApiKey(context.Background(), "a")
+	if err != nil {
+		t.Fatalf("Failed to get API key: %v", err)
+	}
+	if found.Hash != "hash-3" || !found.Revoked() {
+		t.Errorf("Expected the rotated and revoked key, got %v", found)
+	}
+
+	keys, err := repo.ListApiKeys(context.Background(), 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list API keys: %v", err)
+	}
+	if len(keys) != 2 || keys[0].ID != "a" || keys[1].ID != "b" {
+		t.Errorf("Expected keys a and b oldest first, got %v", keys)
+	}
+
+	keys, err = repo.ListApiKeys(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("Failed to list API keys: %v", err)
+	}
+	if len(keys) != 1 || keys[0].ID != "b" {
+		t.Errorf("Expected key b, got %v", keys)
+	}
+}
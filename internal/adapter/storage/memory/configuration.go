@@ -2,119 +2,232 @@ package memory
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/index"
 	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
 )
 
+// configurationEntry holds the version history of a single configuration.
+// Versions are append-only, so a reader only needs the lock to copy the slice header
+type configurationEntry struct {
+	mu       sync.RWMutex
+	versions []*domain.Config
+	purged   bool // Set once the entry is removed from the map, a writer that still holds it must look it up again
+}
+
+// snapshot returns the versions appended so far
+func (e *configurationEntry) snapshot() []*domain.Config {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.versions
+}
+
+// configurationKey identifies a configuration, names are unique within a namespace
+type configurationKey struct {
+	namespace string
+	name      string
+}
+
+// ConfigurationRepository stores configurations in memory. Writes to different
+// configurations proceed in parallel, writes to the same configuration are serialized
 type ConfigurationRepository struct {
-	configurations map[string][]*domain.Config
+	mu             sync.RWMutex // guards the configurations map, not the entries in it
+	configurations map[configurationKey]*configurationEntry
+	indexMu        sync.RWMutex // guards the index
+	index          *index.Configurations
+	aliasMu        sync.RWMutex // guards the aliases, they are moved apart from the versions
+	aliases        map[configurationKey]aliases
 }
 
 func NewConfigurationRepository() *ConfigurationRepository {
 	return &ConfigurationRepository{
-		configurations: make(map[string][]*domain.Config),
+		configurations: make(map[configurationKey]*configurationEntry),
+		index:          index.NewConfigurations(),
+		aliases:        make(map[configurationKey]aliases),
 	}
 }
-func (r *ConfigurationRepository) PutConfiguration(ctx context.Context, config *domain.Config) (*domain.Config, error) {
-	// Looking for a config whose Name value matches the parameter.
-	versions, ok := r.configurations[config.Name]
 
-	if ok {
-		// If found, update the config and return it.
-		config.Version = versions[len(versions)-1].Version + 1 // Increment the version for the updated config
-		config.CreatedAt = time.Now()                          // Set the creation timestamp
+// entry looks up the history of a configuration, creating an empty one if create is set
+func (r *ConfigurationRepository) entry(key configurationKey, create bool) *configurationEntry {
+	r.mu.RLock()
+	e, ok := r.configurations[key]
+	r.mu.RUnlock()
 
-		r.configurations[config.Name] = append(r.configurations[config.Name], config)
-		return config, nil
+	if ok || !create {
+		return e
 	}
 
-	// If not found, create a new config.
-	config.Version = 1            // Set the version to 1 for a new config
-	config.CreatedAt = time.Now() // Set the creation timestamp
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	r.configurations[config.Name] = []*domain.Config{config}
+	// Another writer may have created it between the two locks
+	if e, ok = r.configurations[key]; !ok {
+		e = &configurationEntry{}
+		r.configurations[key] = e
+	}
 
-	return config, nil
+	return e
 }
 
-func (r *ConfigurationRepository) GetConfiguration(ctx context.Context, name string) (*domain.Config, error) {
-	// Looking for a config whose Name value matches the parameter.
-	versions, ok := r.configurations[name]
+// lockedEntry looks up the history of a configuration like entry and locks it for writing
+func (r *ConfigurationRepository) lockedEntry(key configurationKey, create bool) *configurationEntry {
+	for {
+		e := r.entry(key, create)
+		if e == nil {
+			return nil
+		}
 
-	if ok && len(versions) > 0 {
-		return versions[len(versions)-1], nil // Return the latest version of the config
+		e.mu.Lock()
+		if !e.purged {
+			return e
+		}
+		e.mu.Unlock()
 	}
+}
 
-	return nil, domain.ErrDataNotFound
+// latest returns the latest version in a history, or nil if there is none
+func latest(versions []*domain.Config) *domain.Config {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	return versions[len(versions)-1]
 }
 
-func (r *ConfigurationRepository) ListConfigurations(ctx context.Context, skip, limit uint64) ([]*domain.Config, error) {
-	var configs []*domain.Config
+// append adds the new latest version to a history locked for writing, and lists it in place of the previous one
+func (r *ConfigurationRepository) append(e *configurationEntry, config *domain.Config) {
+	r.indexMu.Lock()
+	r.index.Replace(latest(e.versions), config)
+	r.indexMu.Unlock()
+
+	e.versions = append(e.versions, config)
+}
 
-	for _, versions := range r.configurations {
-		configs = append(configs, versions[len(versions)-1]) // Get the latest version of each config
+// versions returns the version history of a configuration, or nil if it doesn't exist
+func (r *ConfigurationRepository) versions(namespace, name string) []*domain.Config {
+	e := r.entry(configurationKey{namespace, name}, false)
+	if e == nil {
+		return nil
 	}
 
-	if skip >= uint64(len(configs)) {
-		return nil, nil // No configs to return
+	return e.snapshot()
+}
+
+func (r *ConfigurationRepository) PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	// A conditional write never creates a configuration, so a failed one doesn't leave an empty entry behind
+	e := r.lockedEntry(configurationKey{config.Namespace, config.Name}, expectedVersion == 0)
+	if e == nil {
+		return nil, domain.ErrVersionConflict
 	}
+	defer e.mu.Unlock()
 
-	end := skip + limit
-	if end > uint64(len(configs)) {
-		end = uint64(len(configs))
+	latestVersion := 0
+	if len(e.versions) > 0 {
+		latestVersion = e.versions[len(e.versions)-1].Version
 	}
 
-	return configs[skip:end], nil
+	if expectedVersion != 0 && expectedVersion != latestVersion {
+		return nil, domain.ErrVersionConflict // Someone else has written a version in the meantime
+	}
+
+	newConfig := *config                  // Store a copy so the caller can't modify the history
+	newConfig.Version = latestVersion + 1 // Increment the version, a new config starts at 1
+	newConfig.Deleted = false
+
+	newConfig.CreatedAt = time.Now() // Set the creation timestamp
+
+	r.append(e, &newConfig)
+
+	return &newConfig, nil
 }
-func (r *ConfigurationRepository) ListConfigurationVersions(ctx context.Context, name string, skip, limit uint64) ([]*domain.Config, error) {
-	versions, ok := r.configurations[name]
 
-	if !ok {
+func (r *ConfigurationRepository) GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	config := latest(r.versions(namespace, name))
+
+	if config == nil || config.Deleted {
 		return nil, domain.ErrDataNotFound
 	}
 
-	if skip >= uint64(len(versions)) {
-		return nil, nil // No versions to return
+	return config, nil // Return the latest version of the config
+}
+
+// ListConfigurations filters the latest versions in process, without a query the page is found in the sorted index
+// and a label selector only looks at the configurations that have the labels it requires
+func (r *ConfigurationRepository) ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	r.indexMu.RLock()
+	defer r.indexMu.RUnlock()
+
+	configs, total := r.index.Page(namespace, query, page)
+
+	return configs, total, nil
+}
+
+// filterVersions returns the versions that pass a filter, oldest first
+func filterVersions(versions []*domain.Config, filter domain.VersionFilter) []*domain.Config {
+	if filter == (domain.VersionFilter{}) {
+		return versions
+	}
+
+	var filtered []*domain.Config
+	for _, v := range versions {
+		if filter.Matches(v) {
+			filtered = append(filtered, v)
+		}
 	}
 
-	end := skip + limit
-	if end > uint64(len(versions)) {
-		end = uint64(len(versions))
+	return filtered
+}
+
+// ListConfigurationVersions pages through a history that is in version order, and so in the order it was written
+func (r *ConfigurationRepository) ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	versions := r.versions(namespace, name)
+
+	if len(versions) == 0 {
+		return nil, 0, domain.ErrDataNotFound
 	}
 
-	return versions[skip:end], nil
+	configs, total := index.Page(filterVersions(versions, filter), page, nil)
+
+	return configs, total, nil
 }
-func (r *ConfigurationRepository) GetConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error) {
-	// Looking for a config whose Name value matches the parameter.
-	versions, ok := r.configurations[name]
 
-	if ok {
-		for _, v := range versions {
-			if v.Version == version {
-				return v, nil // Return the specific version
-			}
+func (r *ConfigurationRepository) GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	// Looking for a config whose Name value matches the parameter.
+	for _, v := range r.versions(namespace, name) {
+		if v.Version == version {
+			return v, nil // Return the specific version
 		}
 	}
 
 	return nil, domain.ErrDataNotFound
 }
-func (r *ConfigurationRepository) RollbackConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error) {
+
+func (r *ConfigurationRepository) RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int, change domain.Change) (*domain.Config, error) {
 	// Looking for a config whose Name value matches the parameter.
-	versions, ok := r.configurations[name]
+	e := r.lockedEntry(configurationKey{namespace, name}, false)
 
-	if !ok || len(versions) == 0 {
+	if e == nil {
 		return nil, domain.ErrDataNotFound
 	}
 
-	for _, v := range versions {
-		if v.Version == version {
-			newConfigVersion := *v                                           // Create a copy of the found version
-			newConfigVersion.RollbackedVersion = version                     // Set the version to the rolled back version
-			newConfigVersion.Version = versions[len(versions)-1].Version + 1 // Increment the version for the new config
+	defer e.mu.Unlock()
+
+	for _, v := range e.versions {
+		if v.Version == version && !v.Deleted { // A tombstone has no value to roll back to
+			newConfigVersion := *v                                               // Create a copy of the found version
+			newConfigVersion.RollbackedVersion = version                         // Set the version to the rolled back version
+			newConfigVersion.Version = e.versions[len(e.versions)-1].Version + 1 // Increment the version for the new config
 
 			newConfigVersion.CreatedAt = time.Now() // Set the creation timestamp
-			r.configurations[name] = append(r.configurations[name], &newConfigVersion)
+			newConfigVersion.CreatedBy = change.CreatedBy
+			newConfigVersion.ChangeMessage = change.Message
+			newConfigVersion.Environment = "" // Every overlay is rolled back, not the one of an environment
+			newConfigVersion.PromotedFrom = ""
+			newConfigVersion.PromotedVersion = 0
+			r.append(e, &newConfigVersion)
 
 			return &newConfigVersion, nil // Return the rolled back version
 		}
@@ -122,3 +235,102 @@ func (r *ConfigurationRepository) RollbackConfigurationVersion(ctx context.Conte
 
 	return nil, domain.ErrDataNotFound
 }
+
+func (r *ConfigurationRepository) DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int, change domain.Change) (*domain.Config, error) {
+	e := r.lockedEntry(configurationKey{namespace, name}, false)
+
+	if e == nil {
+		return nil, domain.ErrDataNotFound
+	}
+
+	defer e.mu.Unlock()
+
+	current := latest(e.versions)
+	if current == nil || current.Deleted {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if expectedVersion != 0 && expectedVersion != current.Version {
+		return nil, domain.ErrVersionConflict // Someone else has written a version in the meantime
+	}
+
+	tombstone := &domain.Config{
+		Namespace:     namespace,
+		Name:          name,
+		Type:          current.Type,
+		Version:       current.Version + 1,
+		Deleted:       true,
+		CreatedAt:     time.Now(),
+		CreatedBy:     change.CreatedBy,
+		ChangeMessage: change.Message,
+	}
+	r.append(e, tombstone)
+
+	return tombstone, nil
+}
+
+func (r *ConfigurationRepository) RestoreConfiguration(ctx context.Context, namespace, name string, change domain.Change) (*domain.Config, error) {
+	e := r.lockedEntry(configurationKey{namespace, name}, false)
+
+	if e == nil {
+		return nil, domain.ErrDataNotFound
+	}
+
+	defer e.mu.Unlock()
+
+	tombstone := latest(e.versions)
+	if tombstone == nil {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if !tombstone.Deleted {
+		return nil, domain.ErrConfigurationNotDeleted
+	}
+
+	// A configuration can't be deleted twice in a row, so the version before the tombstone has a value
+	restoredConfig := *e.versions[len(e.versions)-2]
+	restoredConfig.RollbackedVersion = restoredConfig.Version
+	restoredConfig.Environment = ""
+	restoredConfig.PromotedFrom = ""
+	restoredConfig.PromotedVersion = 0
+	restoredConfig.Version = tombstone.Version + 1
+	restoredConfig.CreatedAt = time.Now()
+	restoredConfig.CreatedBy = change.CreatedBy
+	restoredConfig.ChangeMessage = change.Message
+	r.append(e, &restoredConfig)
+
+	return &restoredConfig, nil
+}
+
+func (r *ConfigurationRepository) PurgeConfiguration(ctx context.Context, namespace, name string) error {
+	key := configurationKey{namespace, name}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.configurations[key]
+	if !ok {
+		return domain.ErrDataNotFound
+	}
+
+	// Lock the entry so a writer that already looked it up either finishes first or sees it purged
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.versions) == 0 {
+		return domain.ErrDataNotFound // Created by a writer that hasn't appended its first version yet
+	}
+
+	delete(r.configurations, key)
+
+	r.indexMu.Lock()
+	r.index.Replace(latest(e.versions), nil)
+	r.indexMu.Unlock()
+
+	r.purgeAliases(key)
+
+	e.versions = nil
+	e.purged = true
+
+	return nil
+}
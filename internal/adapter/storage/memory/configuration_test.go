@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/storagetest"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+)
+
+func TestConfigurationRepository(t *testing.T) {
+	storagetest.TestConfigurationRepository(t, func(t *testing.T) (port.ConfigurationRepository, func() port.ConfigurationRepository) {
+		// Nothing is kept across a restart
+		return NewConfigurationRepository(), nil
+	})
+}
@@ -2,7 +2,9 @@ package memory
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,7 +19,7 @@ func TestEmptyConfigurations(t *testing.T) {
 	}
 
 	// List configurations
-	configs, err := repo.ListConfigurations(context.Background(), 0, 10)
+	configs, _, err := repo.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10})
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
@@ -26,12 +28,13 @@ func TestEmptyConfigurations(t *testing.T) {
 	}
 
 	config := &domain.Config{
-		Name:  "test_config",
-		Value: map[string]interface{}{"name": "John"},
+		Namespace: domain.DefaultNamespace,
+		Name:      "test_config",
+		Value:     map[string]interface{}{"name": "John"},
 	}
 
 	// Get configuration
-	got, err := repo.GetConfiguration(context.Background(), config.Name)
+	got, err := repo.GetConfiguration(context.Background(), domain.DefaultNamespace, config.Name)
 
 	if err == nil || got != nil {
 		t.Errorf("Failed to get configuration: %v", err)
@@ -42,7 +45,7 @@ func TestEmptyConfigurations(t *testing.T) {
 	}
 
 	// Get historical versions
-	versions, err := repo.ListConfigurationVersions(context.Background(), config.Name, 0, 10)
+	versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, config.Name, domain.VersionFilter{}, domain.PageRequest{Limit: 10})
 	if err == nil || versions != nil {
 		t.Errorf("Failed to get configuration: %v", err)
 	}
@@ -52,7 +55,7 @@ func TestEmptyConfigurations(t *testing.T) {
 	}
 
 	// Get specific version
-	_, err = repo.GetConfigurationVersion(context.Background(), config.Name, 1)
+	_, err = repo.GetConfigurationVersion(context.Background(), domain.DefaultNamespace, config.Name, 1)
 	if err == nil {
 		t.Errorf("Expected error when getting non-existing version, got nil")
 	}
@@ -61,7 +64,7 @@ func TestEmptyConfigurations(t *testing.T) {
 	}
 
 	// Rollback configuration version
-	_, err = repo.RollbackConfigurationVersion(context.Background(), config.Name, 1)
+	_, err = repo.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, config.Name, 1, domain.Change{})
 	if err == nil {
 		t.Errorf("Expected error when rolling back non-existing version, got nil")
 	}
@@ -73,14 +76,15 @@ func TestEmptyConfigurations(t *testing.T) {
 func TestPutConfiguration(t *testing.T) {
 	repo := NewConfigurationRepository()
 	config := &domain.Config{
-		Name:  "test_config",
-		Value: map[string]interface{}{"name": "John"},
+		Namespace: domain.DefaultNamespace,
+		Name:      "test_config",
+		Value:     map[string]interface{}{"name": "John"},
 	}
 
 	t1 := time.Now()
 
 	// Put configuration
-	createdConfig, err := repo.PutConfiguration(context.Background(), config)
+	createdConfig, err := repo.PutConfiguration(context.Background(), config, 0)
 	if err != nil {
 		t.Fatalf("Failed to put configuration: %v", err)
 	}
@@ -92,12 +96,12 @@ func TestPutConfiguration(t *testing.T) {
 		t.Errorf("Expected configurations to have 1 entry, got %d", len(repo.configurations))
 	}
 
-	if repo.configurations[config.Name] == nil || len(repo.configurations[config.Name]) != 1 {
-		t.Errorf("Expected configurations for %s to have 1 entry, got %d", config.Name, len(repo.configurations[config.Name]))
+	if repo.configurations[configurationKey{config.Namespace, config.Name}] == nil || len(repo.configurations[configurationKey{config.Namespace, config.Name}].versions) != 1 {
+		t.Errorf("Expected configurations for %s to have 1 entry, got %d", config.Name, len(repo.configurations[configurationKey{config.Namespace, config.Name}].versions))
 	}
 
 	// List configurations
-	configs, err := repo.ListConfigurations(context.Background(), 0, 10)
+	configs, _, err := repo.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10})
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
@@ -105,7 +109,7 @@ func TestPutConfiguration(t *testing.T) {
 		t.Errorf("Expected 1 configurations, got %d", len(configs))
 	}
 
-	configs, err = repo.ListConfigurations(context.Background(), 1, 10)
+	configs, _, err = repo.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Skip: 1, Limit: 10})
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
@@ -115,7 +119,7 @@ func TestPutConfiguration(t *testing.T) {
 	}
 
 	// Get configuration
-	got, err := repo.GetConfiguration(context.Background(), config.Name)
+	got, err := repo.GetConfiguration(context.Background(), domain.DefaultNamespace, config.Name)
 
 	if err != nil {
 		t.Errorf("Failed to get configuration: %v", err)
@@ -124,7 +128,7 @@ func TestPutConfiguration(t *testing.T) {
 	validateConfig(t, got, config.Name, config.Value, 1, t1, t2)
 
 	// Get historical versions
-	versions, err := repo.ListConfigurationVersions(context.Background(), config.Name, 0, 10)
+	versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, config.Name, domain.VersionFilter{}, domain.PageRequest{Limit: 10})
 	if err != nil {
 		t.Errorf("Failed to get configuration: %v", err)
 	}
@@ -135,7 +139,7 @@ func TestPutConfiguration(t *testing.T) {
 
 	validateConfig(t, versions[0], config.Name, config.Value, 1, t1, t2)
 
-	versions, err = repo.ListConfigurationVersions(context.Background(), config.Name, 1, 10)
+	versions, _, err = repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, config.Name, domain.VersionFilter{}, domain.PageRequest{Skip: 1, Limit: 10})
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
@@ -145,14 +149,14 @@ func TestPutConfiguration(t *testing.T) {
 	}
 
 	// Get specific version
-	ver, err := repo.GetConfigurationVersion(context.Background(), config.Name, 1)
+	ver, err := repo.GetConfigurationVersion(context.Background(), domain.DefaultNamespace, config.Name, 1)
 	if err != nil {
 		t.Errorf("Failed to get configuration version: %v", err)
 	}
 
 	validateConfig(t, ver, config.Name, config.Value, 1, t1, t2)
 
-	_, err = repo.GetConfigurationVersion(context.Background(), config.Name, 2)
+	_, err = repo.GetConfigurationVersion(context.Background(), domain.DefaultNamespace, config.Name, 2)
 	if err == nil {
 		t.Errorf("Expected error when getting non-existing version, got nil")
 	}
@@ -162,7 +166,7 @@ func TestPutConfiguration(t *testing.T) {
 
 	// Rollback configuration version
 	t1 = time.Now()
-	rolledBackConfig, err := repo.RollbackConfigurationVersion(context.Background(), config.Name, 1)
+	rolledBackConfig, err := repo.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, config.Name, 1, domain.Change{})
 	if err != nil {
 		t.Errorf("Failed to rollback configuration version: %v", err)
 	}
@@ -173,11 +177,11 @@ func TestPutConfiguration(t *testing.T) {
 	if len(repo.configurations) != 1 {
 		t.Errorf("Expected configurations to have 1 entry, got %d", len(repo.configurations))
 	}
-	if repo.configurations[config.Name] == nil || len(repo.configurations[config.Name]) != 2 {
-		t.Errorf("Expected configurations for %s to have 2 entries, got %d", config.Name, len(repo.configurations[config.Name]))
+	if repo.configurations[configurationKey{config.Namespace, config.Name}] == nil || len(repo.configurations[configurationKey{config.Namespace, config.Name}].versions) != 2 {
+		t.Errorf("Expected configurations for %s to have 2 entries, got %d", config.Name, len(repo.configurations[configurationKey{config.Namespace, config.Name}].versions))
 	}
 
-	ver, err = repo.RollbackConfigurationVersion(context.Background(), config.Name, 3)
+	ver, err = repo.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, config.Name, 3, domain.Change{})
 	if ver != nil || err == nil {
 		t.Errorf("Expected error when rolling back non-existing version, got nil")
 	}
@@ -187,15 +191,61 @@ func TestPutConfiguration(t *testing.T) {
 
 }
 
+func TestPutConfigurationExpectedVersion(t *testing.T) {
+	repo := NewConfigurationRepository()
+	config := &domain.Config{
+		Namespace: domain.DefaultNamespace,
+		Name:      "test_config",
+		Value:     map[string]interface{}{"name": "John"},
+	}
+
+	// A config that doesn't exist yet has no version to match, and the failed write leaves nothing behind
+	_, err := repo.PutConfiguration(context.Background(), config, 1)
+	if err != domain.ErrVersionConflict {
+		t.Errorf("Expected error %v, got %v", domain.ErrVersionConflict, err)
+	}
+	if len(repo.configurations) != 0 {
+		t.Errorf("Expected no entry for the failed write, got %d", len(repo.configurations))
+	}
+
+	_, err = repo.PutConfiguration(context.Background(), config, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+
+	updatedConfig, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: config.Name, Value: map[string]interface{}{"name": "John II"}}, 1)
+	if err != nil {
+		t.Fatalf("Failed to put configuration with the latest expected version: %v", err)
+	}
+	if updatedConfig.Version != 2 {
+		t.Errorf("Expected Version 2, got %d", updatedConfig.Version)
+	}
+
+	// The second writer still expects version 1
+	_, err = repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: config.Name, Value: map[string]interface{}{"name": "John III"}}, 1)
+	if err != domain.ErrVersionConflict {
+		t.Errorf("Expected error %v, got %v", domain.ErrVersionConflict, err)
+	}
+
+	got, err := repo.GetConfiguration(context.Background(), domain.DefaultNamespace, config.Name)
+	if err != nil {
+		t.Fatalf("Failed to get configuration: %v", err)
+	}
+	if got.Version != 2 || got.Value["name"] != "John II" {
+		t.Errorf("Expected the conflicting write to be rejected, got %v", got)
+	}
+}
+
 func TestReplaceConfiguration(t *testing.T) {
 	repo := NewConfigurationRepository()
 	config := &domain.Config{
-		Name:  "test_config",
-		Value: map[string]interface{}{"name": "John"},
+		Namespace: domain.DefaultNamespace,
+		Name:      "test_config",
+		Value:     map[string]interface{}{"name": "John"},
 	}
 
 	// Put initial configuration
-	_, err := repo.PutConfiguration(context.Background(), config)
+	_, err := repo.PutConfiguration(context.Background(), config, 0)
 	if err != nil {
 		t.Fatalf("Failed to put initial configuration: %v", err)
 	}
@@ -204,7 +254,7 @@ func TestReplaceConfiguration(t *testing.T) {
 	// Update configuration
 	config.Value = map[string]interface{}{"name": "John II"}
 
-	updatedConfig, err := repo.PutConfiguration(context.Background(), config)
+	updatedConfig, err := repo.PutConfiguration(context.Background(), config, 0)
 	if err != nil {
 		t.Fatalf("Failed to update configuration: %v", err)
 	}
@@ -216,8 +266,131 @@ func TestReplaceConfiguration(t *testing.T) {
 		t.Errorf("Expected configurations to have 1 entry, got %d", len(repo.configurations))
 	}
 
-	if repo.configurations[config.Name] == nil || len(repo.configurations[config.Name]) != 2 {
-		t.Errorf("Expected configurations for %s to have 2 entries, got %d", config.Name, len(repo.configurations[config.Name]))
+	if repo.configurations[configurationKey{config.Namespace, config.Name}] == nil || len(repo.configurations[configurationKey{config.Namespace, config.Name}].versions) != 2 {
+		t.Errorf("Expected configurations for %s to have 2 entries, got %d", config.Name, len(repo.configurations[configurationKey{config.Namespace, config.Name}].versions))
+	}
+}
+
+func TestConcurrentWritesAreMonotonic(t *testing.T) {
+	repo := NewConfigurationRepository()
+
+	const (
+		names   = 4
+		writers = 16
+		writes  = 50
+	)
+
+	// Seed every config so that rollbacks always have a version to copy
+	for n := 0; n < names; n++ {
+		_, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: fmt.Sprintf("config_%d", n), Value: map[string]interface{}{"writer": -1}}, 0)
+		if err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		returned = make(map[string]map[int]bool)
+	)
+
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < writes; i++ {
+				name := fmt.Sprintf("config_%d", (w+i)%names)
+
+				var (
+					config *domain.Config
+					err    error
+				)
+				if i%3 == 0 {
+					config, err = repo.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, name, 1, domain.Change{})
+				} else {
+					config, err = repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: name, Value: map[string]interface{}{"writer": w}}, 0)
+				}
+				if err != nil {
+					t.Errorf("Failed to write configuration: %v", err)
+					return
+				}
+
+				mu.Lock()
+				if returned[name] == nil {
+					returned[name] = make(map[int]bool)
+				}
+				if returned[name][config.Version] {
+					t.Errorf("Version %d of %s was returned twice", config.Version, name)
+				}
+				returned[name][config.Version] = true
+				mu.Unlock()
+
+				// Concurrent reads must never observe a partially written history
+				if _, _, err := repo.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: names}); err != nil {
+					t.Errorf("Failed to list configurations: %v", err)
+				}
+				if _, err := repo.GetConfiguration(context.Background(), domain.DefaultNamespace, name); err != nil {
+					t.Errorf("Failed to get configuration: %v", err)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	total := 0
+	for n := 0; n < names; n++ {
+		name := fmt.Sprintf("config_%d", n)
+		versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, name, domain.VersionFilter{}, domain.PageRequest{Limit: writers*writes + 1})
+		if err != nil {
+			t.Fatalf("Failed to list versions: %v", err)
+		}
+
+		for i, v := range versions {
+			if v.Version != i+1 {
+				t.Fatalf("Expected version %d at index %d of %s, got %d", i+1, i, name, v.Version)
+			}
+			if i > 0 && v.CreatedAt.Before(versions[i-1].CreatedAt) {
+				t.Errorf("Expected version %d of %s to be created after version %d", v.Version, name, versions[i-1].Version)
+			}
+		}
+
+		total += len(versions) - 1 // Exclude the seed version
+	}
+
+	if total != writers*writes {
+		t.Errorf("Expected %d written versions, got %d", writers*writes, total)
+	}
+}
+
+func TestConcurrentFirstPut(t *testing.T) {
+	repo := NewConfigurationRepository()
+
+	const writers = 32
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"name": "John"}}, 0)
+			if err != nil {
+				t.Errorf("Failed to put configuration: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	versions, _, err := repo.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: writers})
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+	if len(versions) != writers {
+		t.Fatalf("Expected %d versions, got %d", writers, len(versions))
+	}
+	for i, v := range versions {
+		if v.Version != i+1 {
+			t.Errorf("Expected version %d at index %d, got %d", i+1, i, v.Version)
+		}
 	}
 }
 
@@ -238,3 +411,500 @@ func validateConfig(t *testing.T, config *domain.Config, expectedName string, ex
 		t.Errorf("Expected CreatedAt to be within the range of %v and %v, got %v", expectedCreatedAtAfter, expectedCreatedAtBefore, config.CreatedAt)
 	}
 }
+
+func TestDeleteAndRestoreConfiguration(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "test_config", 0, domain.Change{}); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	for _, name := range []string{"John", "John II"} {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "person", Value: map[string]interface{}{"name": name}}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "test_config", 1, domain.Change{}); err != domain.ErrVersionConflict {
+		t.Errorf("Expected error %v, got %v", domain.ErrVersionConflict, err)
+	}
+
+	if _, err := repo.RestoreConfiguration(ctx, domain.DefaultNamespace, "test_config", domain.Change{}); err != domain.ErrConfigurationNotDeleted {
+		t.Errorf("Expected error %v, got %v", domain.ErrConfigurationNotDeleted, err)
+	}
+
+	tombstone, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "test_config", 2, domain.Change{})
+	if err != nil {
+		t.Fatalf("Failed to delete configuration: %v", err)
+	}
+	if tombstone.Version != 3 || !tombstone.Deleted || tombstone.Type != "person" || tombstone.Value != nil {
+		t.Errorf("Expected a tombstone with version 3, got %v", tombstone)
+	}
+
+	// The deleted configuration is gone from the latest versions but its history is kept
+	if _, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "test_config"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+	if configs, _, _ := repo.ListConfigurations(ctx, domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10}); len(configs) != 0 {
+		t.Errorf("Expected no configurations, got %v", configs)
+	}
+	if versions, _, _ := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10}); len(versions) != 3 {
+		t.Errorf("Expected 3 versions, got %v", versions)
+	}
+
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "test_config", 0, domain.Change{}); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+	if _, err := repo.RollbackConfigurationVersion(ctx, domain.DefaultNamespace, "test_config", 3, domain.Change{}); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	restored, err := repo.RestoreConfiguration(ctx, domain.DefaultNamespace, "test_config", domain.Change{})
+	if err != nil {
+		t.Fatalf("Failed to restore configuration: %v", err)
+	}
+	if restored.Version != 4 || restored.Deleted || restored.RollbackedVersion != 2 || restored.Value["name"] != "John II" {
+		t.Errorf("Expected version 4 restored from version 2, got %v", restored)
+	}
+
+	got, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "test_config")
+	if err != nil || got.Version != 4 {
+		t.Errorf("Expected the restored version 4, got %v, error: %v", got, err)
+	}
+}
+
+func TestPurgeConfiguration(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	if err := repo.PurgeConfiguration(ctx, domain.DefaultNamespace, "test_config"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	for _, name := range []string{"John", "John II"} {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"name": name}}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "test_config", 0, domain.Change{}); err != nil {
+		t.Fatalf("Failed to delete configuration: %v", err)
+	}
+
+	if err := repo.PurgeConfiguration(ctx, domain.DefaultNamespace, "test_config"); err != nil {
+		t.Fatalf("Failed to purge configuration: %v", err)
+	}
+
+	if _, _, err := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10}); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	// The name can be used again, starting over at version 1
+	config, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Value: map[string]interface{}{"name": "Jane"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if config.Version != 1 {
+		t.Errorf("Expected Version 1, got %d", config.Version)
+	}
+}
+
+func TestChangesAreRecorded(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	value := map[string]interface{}{"key": "value"}
+	if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "test", Value: value, CreatedBy: "alice", ChangeMessage: "Initial version"}, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if _, err := repo.RollbackConfigurationVersion(ctx, domain.DefaultNamespace, "test_config", 1, domain.Change{CreatedBy: "bob", Message: "Roll back"}); err != nil {
+		t.Fatalf("Failed to roll back configuration: %v", err)
+	}
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "test_config", 0, domain.Change{CreatedBy: "alice", Message: "No longer used"}); err != nil {
+		t.Fatalf("Failed to delete configuration: %v", err)
+	}
+	if _, err := repo.RestoreConfiguration(ctx, domain.DefaultNamespace, "test_config", domain.Change{CreatedBy: "bob"}); err != nil {
+		t.Fatalf("Failed to restore configuration: %v", err)
+	}
+
+	versions, _, err := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{}, domain.PageRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+
+	want := []domain.Change{{CreatedBy: "alice", Message: "Initial version"}, {CreatedBy: "bob", Message: "Roll back"}, {CreatedBy: "alice", Message: "No longer used"}, {CreatedBy: "bob"}}
+	for i, v := range versions {
+		if got := (domain.Change{CreatedBy: v.CreatedBy, Message: v.ChangeMessage}); got != want[i] {
+			t.Errorf("Expected version %d to record %+v, got %+v", v.Version, want[i], got)
+		}
+	}
+
+	// Only the versions written by bob, paged after the filter
+	versions, _, err = repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{CreatedBy: "bob"}, domain.PageRequest{Skip: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to list versions: %v", err)
+	}
+	if len(versions) != 1 || versions[0].Version != 4 {
+		t.Errorf("Expected only version 4, got %v", versions)
+	}
+
+	if _, _, err := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{CreatedBy: "carol"}, domain.PageRequest{Limit: 10}); err != nil {
+		t.Errorf("Expected no error for a filter that matches nothing, got %v", err)
+	}
+}
+
+func TestNamespacesAreSeparate(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	for _, namespace := range []string{domain.DefaultNamespace, "payments", "payments"} {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: namespace, Name: "test_config", Type: "test", Value: map[string]interface{}{"namespace": namespace}}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	got, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "test_config")
+	if err != nil || got.Version != 1 || got.Value["namespace"] != domain.DefaultNamespace {
+		t.Errorf("Expected version 1 in the default namespace, got config: %v, error: %v", got, err)
+	}
+
+	got, err = repo.GetConfiguration(ctx, "payments", "test_config")
+	if err != nil || got.Version != 2 || got.Namespace != "payments" {
+		t.Errorf("Expected version 2 in the payments namespace, got config: %v, error: %v", got, err)
+	}
+
+	if configs, _, _ := repo.ListConfigurations(ctx, "payments", domain.ConfigQuery{}, domain.PageRequest{Limit: 10}); len(configs) != 1 {
+		t.Errorf("Expected 1 configuration in the payments namespace, got %d", len(configs))
+	}
+	if configs, _, _ := repo.ListConfigurations(ctx, "", domain.ConfigQuery{}, domain.PageRequest{Limit: 10}); len(configs) != 2 {
+		t.Errorf("Expected 2 configurations in every namespace, got %d", len(configs))
+	}
+
+	if err := repo.PurgeConfiguration(ctx, "payments", "test_config"); err != nil {
+		t.Fatalf("Failed to purge configuration: %v", err)
+	}
+	if _, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "test_config"); err != nil {
+		t.Errorf("Expected the configuration of the default namespace to be kept, got %v", err)
+	}
+}
+
+func TestOverlaysAreKept(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	overlays := map[string]map[string]interface{}{"staging": {"key": "staging"}, "prod": {"key": "prod"}}
+	if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "test", Value: map[string]interface{}{"key": "value"}, Overlays: overlays}, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "test", Value: map[string]interface{}{"key": "value"}, Overlays: overlays, Environment: "prod", PromotedFrom: "staging", PromotedVersion: 1}, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+
+	promoted, err := repo.GetConfigurationVersion(ctx, domain.DefaultNamespace, "test_config", 2)
+	if err != nil {
+		t.Fatalf("Failed to get configuration version: %v", err)
+	}
+	if promoted.Environment != "prod" || promoted.PromotedFrom != "staging" || promoted.PromotedVersion != 1 {
+		t.Errorf("Expected version 2 to be promoted from version 1 of staging to prod, got %v", promoted)
+	}
+
+	// A rollback restores every overlay, it isn't the write of an environment
+	rolledBack, err := repo.RollbackConfigurationVersion(ctx, domain.DefaultNamespace, "test_config", 2, domain.Change{})
+	if err != nil {
+		t.Fatalf("Failed to roll back configuration: %v", err)
+	}
+	if !reflect.DeepEqual(rolledBack.Overlays, overlays) {
+		t.Errorf("Expected overlays %v, got %v", overlays, rolledBack.Overlays)
+	}
+	if rolledBack.Environment != "" || rolledBack.PromotedFrom != "" || rolledBack.PromotedVersion != 0 {
+		t.Errorf("Expected the rollback not to be a promotion, got %v", rolledBack)
+	}
+}
+
+// pageNames walks a sorted list of configurations one at a time with cursors, and returns their names
+func pageNames(t *testing.T, repo *ConfigurationRepository, namespace string, sort domain.SortField, order domain.SortOrder) []string {
+	t.Helper()
+
+	var names []string
+	page := domain.PageRequest{Sort: sort, Order: order, Limit: 1}
+	for {
+		configs, _, err := repo.ListConfigurations(context.Background(), namespace, domain.ConfigQuery{}, page)
+		if err != nil {
+			t.Fatalf("Failed to list configurations: %v", err)
+		}
+		if len(configs) == 0 {
+			return names
+		}
+
+		names = append(names, configs[0].Name)
+		page.Cursor = domain.NewCursor(configs[0], page)
+	}
+}
+
+func TestListConfigurationsSorted(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	// The latest versions are c_config 1, a_config 3 and b_config 2, written in that order
+	for _, name := range []string{"a_config", "b_config", "c_config", "a_config", "a_config", "b_config"} {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: name, Type: "test", Value: map[string]interface{}{"key": "value"}}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+	if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: "payments", Name: "d_config", Type: "test", Value: map[string]interface{}{"key": "value"}}, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	tests := []struct {
+		sort     domain.SortField
+		order    domain.SortOrder
+		expected []string
+	}{
+		{domain.SortByName, domain.SortAscending, []string{"a_config", "b_config", "c_config"}},
+		{domain.SortByName, domain.SortDescending, []string{"c_config", "b_config", "a_config"}},
+		{domain.SortByCreatedAt, domain.SortAscending, []string{"c_config", "a_config", "b_config"}},
+		{domain.SortByCreatedAt, domain.SortDescending, []string{"b_config", "a_config", "c_config"}},
+		{domain.SortByVersion, domain.SortAscending, []string{"c_config", "b_config", "a_config"}},
+		{domain.SortByVersion, domain.SortDescending, []string{"a_config", "b_config", "c_config"}},
+	}
+
+	for _, tt := range tests {
+		if names := pageNames(t, repo, domain.DefaultNamespace, tt.sort, tt.order); !reflect.DeepEqual(names, tt.expected) {
+			t.Errorf("Expected %v sorted by %s %s, got %v", tt.expected, tt.sort, tt.order, names)
+		}
+	}
+
+	if names := pageNames(t, repo, "", domain.SortByName, domain.SortDescending); !reflect.DeepEqual(names, []string{"d_config", "c_config", "b_config", "a_config"}) {
+		t.Errorf("Expected the payments namespace to sort after the default namespace, got %v", names)
+	}
+
+	// A page continues after its cursor even when the configuration of the cursor has changed since
+	page := domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 1}
+	first, _, _ := repo.ListConfigurations(ctx, domain.DefaultNamespace, domain.ConfigQuery{}, page)
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "a_config", 0, domain.Change{}); err != nil {
+		t.Fatalf("Failed to delete configuration: %v", err)
+	}
+
+	page.Cursor = domain.NewCursor(first[0], page)
+	page.Skip = 1
+	configs, _, err := repo.ListConfigurations(ctx, domain.DefaultNamespace, domain.ConfigQuery{}, page)
+	if err != nil || len(configs) != 1 || configs[0].Name != "c_config" {
+		t.Errorf("Expected c_config after a_config and one skipped, got configs: %v, error: %v", configs, err)
+	}
+}
+
+func TestListConfigurationVersionsSorted(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test_config", Type: "test", Value: map[string]interface{}{"key": i}}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	page := domain.PageRequest{Sort: domain.SortByCreatedAt, Order: domain.SortDescending, Limit: 2}
+	versions, _, err := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{}, page)
+	if err != nil || len(versions) != 2 || versions[0].Version != 3 || versions[1].Version != 2 {
+		t.Fatalf("Expected versions 3 and 2, got versions: %v, error: %v", versions, err)
+	}
+
+	page.Cursor = domain.NewCursor(versions[1], page)
+	versions, _, err = repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "test_config", domain.VersionFilter{}, page)
+	if err != nil || len(versions) != 1 || versions[0].Version != 1 {
+		t.Errorf("Expected version 1 after the cursor, got versions: %v, error: %v", versions, err)
+	}
+}
+
+func TestListTotals(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	for _, name := range []string{"a_config", "b_config", "b_config", "c_config"} {
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: name, Type: "test", Value: map[string]interface{}{"key": "value"}, CreatedBy: name}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+	if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: "payments", Name: "a_config", Type: "test", Value: map[string]interface{}{"key": "value"}}, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "c_config", 0, domain.Change{CreatedBy: "alice"}); err != nil {
+		t.Fatalf("Failed to delete configuration: %v", err)
+	}
+
+	// The total counts every page, deleted configurations are left out of it like they are of the list
+	configs, total, err := repo.ListConfigurations(ctx, domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 1})
+	if err != nil || len(configs) != 1 || total != 2 {
+		t.Errorf("Expected 1 of 2 configurations, got configs: %v, total: %d, error: %v", configs, total, err)
+	}
+	if _, total, _ := repo.ListConfigurations(ctx, "", domain.ConfigQuery{}, domain.PageRequest{Limit: 1}); total != 3 {
+		t.Errorf("Expected 3 configurations in every namespace, got %d", total)
+	}
+
+	// The total of versions counts those that pass the filter
+	if _, total, _ := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "b_config", domain.VersionFilter{}, domain.PageRequest{Limit: 1}); total != 2 {
+		t.Errorf("Expected 2 versions, got %d", total)
+	}
+	if _, total, _ := repo.ListConfigurationVersions(ctx, domain.DefaultNamespace, "c_config", domain.VersionFilter{CreatedBy: "alice"}, domain.PageRequest{Limit: 5}); total != 1 {
+		t.Errorf("Expected 1 version written by alice, got %d", total)
+	}
+}
+
+func TestListConfigurationsQuery(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	values := map[string]map[string]interface{}{
+		"payments_eu":  {"region": "eu", "limits": []interface{}{map[string]interface{}{"max": 10.0}}, "enabled": true},
+		"payments_us":  {"region": "us", "limits": []interface{}{map[string]interface{}{"max": 50.0}}, "enabled": false},
+		"orders_eu":    {"region": "eu", "enabled": nil},
+		"orders_other": {"region": 1.0},
+	}
+	for _, name := range []string{"payments_eu", "payments_us", "orders_eu", "orders_other"} {
+		configType := "payment"
+		if name[0] == 'o' {
+			configType = "order"
+		}
+		if _, err := repo.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: name, Type: configType, Value: values[name]}, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	latest, _, _ := repo.ListConfigurations(ctx, domain.DefaultNamespace, domain.ConfigQuery{NameGlob: "orders_other"}, domain.PageRequest{Limit: 1})
+	if len(latest) != 1 {
+		t.Fatalf("Expected orders_other, got %v", latest)
+	}
+	writtenLast := latest[0].CreatedAt
+
+	where := func(expressions ...string) []domain.ValuePredicate {
+		var predicates []domain.ValuePredicate
+		for _, expression := range expressions {
+			predicate, err := domain.ParseValuePredicate(expression)
+			if err != nil {
+				t.Fatalf("Failed to parse predicate %q: %v", expression, err)
+			}
+			predicates = append(predicates, predicate)
+		}
+		return predicates
+	}
+
+	tests := []struct {
+		name     string
+		query    domain.ConfigQuery
+		expected []string
+	}{
+		{"Type", domain.ConfigQuery{Type: "order"}, []string{"orders_eu", "orders_other"}},
+		{"NamePrefix", domain.ConfigQuery{NamePrefix: "payments_"}, []string{"payments_eu", "payments_us"}},
+		{"NameGlob", domain.ConfigQuery{NameGlob: "*_eu"}, []string{"orders_eu", "payments_eu"}},
+		{"CreatedBefore", domain.ConfigQuery{CreatedBefore: writtenLast}, []string{"orders_eu", "payments_eu", "payments_us"}},
+		{"CreatedAfter", domain.ConfigQuery{CreatedAfter: writtenLast.Add(-time.Nanosecond)}, []string{"orders_other"}},
+		{"ValueEqual", domain.ConfigQuery{Values: where(`value.region == "eu"`)}, []string{"orders_eu", "payments_eu"}},
+		{"ValueNotEqual", domain.ConfigQuery{Values: where(`value.region != "eu"`)}, []string{"orders_other", "payments_us"}},
+		{"ValueNumberIsNotString", domain.ConfigQuery{Values: where(`value.region == "1"`)}, nil},
+		{"ValueInArray", domain.ConfigQuery{Values: where(`value.limits[0].max >= 20`)}, []string{"payments_us"}},
+		{"ValueBool", domain.ConfigQuery{Values: where(`value.enabled == true`)}, []string{"payments_eu"}},
+		{"ValueNull", domain.ConfigQuery{Values: where(`value.enabled == null`)}, []string{"orders_eu"}},
+		{"ValueMissing", domain.ConfigQuery{Values: where(`value.enabled != false`)}, []string{"orders_eu", "orders_other", "payments_eu"}},
+		{"Combined", domain.ConfigQuery{Type: "payment", Values: where(`value.region == "eu"`, `value.limits[0].max < 20`)}, []string{"payments_eu"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, total, err := repo.ListConfigurations(ctx, domain.DefaultNamespace, tt.query, domain.PageRequest{Limit: 10})
+			if err != nil {
+				t.Fatalf("Failed to list configurations: %v", err)
+			}
+
+			var names []string
+			for _, config := range configs {
+				names = append(names, config.Name)
+			}
+			if !reflect.DeepEqual(names, tt.expected) || total != uint64(len(tt.expected)) {
+				t.Errorf("Expected %v, got %v of %d", tt.expected, names, total)
+			}
+		})
+	}
+
+	// A query and a cursor page through the configurations that pass the query
+	page := domain.PageRequest{Limit: 1}
+	query := domain.ConfigQuery{Values: where(`value.region == "eu"`)}
+	first, total, _ := repo.ListConfigurations(ctx, domain.DefaultNamespace, query, page)
+	page.Cursor = domain.NewCursor(first[0], domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending})
+	second, _, _ := repo.ListConfigurations(ctx, domain.DefaultNamespace, query, page)
+	if len(second) != 1 || second[0].Name != "payments_eu" || total != 2 {
+		t.Errorf("Expected payments_eu on the second page of 2, got %v of %d", second, total)
+	}
+}
+
+func TestListConfigurationsLabels(t *testing.T) {
+	repo := NewConfigurationRepository()
+	ctx := context.Background()
+
+	labels := map[string]map[string]string{
+		"payments_eu": {"team": "payments", "tier": "critical", "example.com/region": "eu"},
+		"payments_us": {"team": "payments", "tier": "low"},
+		"orders_eu":   {"team": "orders", "example.com/region": "eu"},
+		"unlabeled":   nil,
+	}
+	for _, name := range []string{"payments_eu", "payments_us", "orders_eu", "unlabeled"} {
+		config := &domain.Config{Namespace: domain.DefaultNamespace, Name: name, Type: "person", Value: map[string]interface{}{"name": name}, Labels: labels[name], Annotations: map[string]string{"owner": "alice"}}
+		if _, err := repo.PutConfiguration(ctx, config, 0); err != nil {
+			t.Fatalf("Failed to put configuration: %v", err)
+		}
+	}
+
+	// Relabeling orders_eu leaves its earlier labels out, and rolling back brings them back
+	relabeled := &domain.Config{Namespace: domain.DefaultNamespace, Name: "orders_eu", Type: "person", Value: map[string]interface{}{"name": "orders_eu"}, Labels: map[string]string{"team": "shipping"}}
+	if _, err := repo.PutConfiguration(ctx, relabeled, 0); err != nil {
+		t.Fatalf("Failed to put configuration: %v", err)
+	}
+	if _, err := repo.RollbackConfigurationVersion(ctx, domain.DefaultNamespace, "orders_eu", 1, domain.Change{}); err != nil {
+		t.Fatalf("Failed to roll back configuration: %v", err)
+	}
+	if _, err := repo.DeleteConfiguration(ctx, domain.DefaultNamespace, "payments_us", 0, domain.Change{}); err != nil {
+		t.Fatalf("Failed to delete configuration: %v", err)
+	}
+	config, err := repo.GetConfiguration(ctx, domain.DefaultNamespace, "orders_eu")
+	if err != nil || !reflect.DeepEqual(config.Labels, labels["orders_eu"]) || config.Annotations["owner"] != "alice" {
+		t.Errorf("Expected the rolled back labels and annotations, got %v", config)
+	}
+
+	selector := func(s string) domain.LabelSelector {
+		parsed, err := domain.ParseLabelSelector(s)
+		if err != nil {
+			t.Fatalf("Failed to parse selector %q: %v", s, err)
+		}
+		return parsed
+	}
+
+	tests := []struct {
+		name     string
+		query    domain.ConfigQuery
+		expected []string
+	}{
+		{"Equal", domain.ConfigQuery{Labels: selector("team=payments")}, []string{"payments_eu"}},
+		{"Relabeled", domain.ConfigQuery{Labels: selector("team=shipping")}, nil},
+		{"NotEqual", domain.ConfigQuery{Labels: selector("team!=payments")}, []string{"orders_eu", "unlabeled"}},
+		{"In", domain.ConfigQuery{Labels: selector("team in (orders,payments)")}, []string{"orders_eu", "payments_eu"}},
+		{"NotIn", domain.ConfigQuery{Labels: selector("tier notin (critical)")}, []string{"orders_eu", "unlabeled"}},
+		{"Exists", domain.ConfigQuery{Labels: selector("example.com/region")}, []string{"orders_eu", "payments_eu"}},
+		{"DoesNotExist", domain.ConfigQuery{Labels: selector("!team")}, []string{"unlabeled"}},
+		{"Combined", domain.ConfigQuery{NamePrefix: "payments_", Labels: selector("team=payments,example.com/region=eu")}, []string{"payments_eu"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, total, err := repo.ListConfigurations(ctx, domain.DefaultNamespace, tt.query, domain.PageRequest{Limit: 10})
+			if err != nil {
+				t.Fatalf("Failed to list configurations: %v", err)
+			}
+
+			var names []string
+			for _, config := range configs {
+				names = append(names, config.Name)
+			}
+			if !reflect.DeepEqual(names, tt.expected) || total != uint64(len(tt.expected)) {
+				t.Errorf("Expected %v, got %v of %d", tt.expected, names, total)
+			}
+		})
+	}
+}
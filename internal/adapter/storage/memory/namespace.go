@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// NamespaceRepository stores namespaces in memory
+type NamespaceRepository struct {
+	mu         sync.RWMutex
+	namespaces map[string]*domain.Namespace
+}
+
+// NewNamespaceRepository creates a repository that holds the default namespace
+func NewNamespaceRepository() *NamespaceRepository {
+	return &NamespaceRepository{
+		namespaces: map[string]*domain.Namespace{
+			domain.DefaultNamespace: {Name: domain.DefaultNamespace, CreatedAt: time.Now()},
+		},
+	}
+}
+
+func (r *NamespaceRepository) CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.namespaces[namespace.Name]; ok {
+		return nil, domain.ErrConflictingData
+	}
+
+	newNamespace := *namespace          // Store a copy so the caller can't modify it
+	newNamespace.CreatedAt = time.Now() // Set the creation timestamp
+	r.namespaces[namespace.Name] = &newNamespace
+
+	return &newNamespace, nil
+}
+
+func (r *NamespaceRepository) GetNamespace(ctx context.Context, name string) (*domain.Namespace, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	namespace, ok := r.namespaces[name]
+	if !ok {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return namespace, nil
+}
+
+func (r *NamespaceRepository) ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error) {
+	r.mu.RLock()
+	namespaces := make([]*domain.Namespace, 0, len(r.namespaces))
+	for _, namespace := range r.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that pages are stable
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].Name < namespaces[j].Name
+	})
+
+	if skip >= uint64(len(namespaces)) {
+		return nil, nil // No namespaces to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(namespaces)) {
+		end = uint64(len(namespaces))
+	}
+
+	return namespaces[skip:end], nil
+}
+
+func (r *NamespaceRepository) DeleteNamespace(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.namespaces[name]; !ok {
+		return domain.ErrDataNotFound
+	}
+
+	delete(r.namespaces, name)
+
+	return nil
+}
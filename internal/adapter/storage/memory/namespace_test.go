@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestNamespaceRepository(t *testing.T) {
+	repo := NewNamespaceRepository()
+	ctx := context.Background()
+
+	if _, err := repo.GetNamespace(ctx, domain.DefaultNamespace); err != nil {
+		t.Fatalf("Expected the default namespace, got %v", err)
+	}
+
+	created, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: "payments", Description: "Payment services", Metadata: map[string]string{"team": "payments"}})
+	if err != nil {
+		t.Fatalf("Failed to create namespace: %v", err)
+	}
+	if created.CreatedAt.IsZero() || created.Metadata["team"] != "payments" {
+		t.Errorf("Expected CreatedAt and metadata set, got %v", created)
+	}
+
+	if _, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: "payments"}); err != domain.ErrConflictingData {
+		t.Errorf("Expected error %v, got %v", domain.ErrConflictingData, err)
+	}
+
+	if _, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: "audit"}); err != nil {
+		t.Fatalf("Failed to create namespace: %v", err)
+	}
+
+	namespaces, err := repo.ListNamespaces(ctx, 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list namespaces: %v", err)
+	}
+	if len(namespaces) != 3 || namespaces[0].Name != "audit" || namespaces[1].Name != domain.DefaultNamespace || namespaces[2].Name != "payments" {
+		t.Errorf("Expected audit, default and payments, got %v", namespaces)
+	}
+
+	if namespaces, _ := repo.ListNamespaces(ctx, 2, 10); len(namespaces) != 1 {
+		t.Errorf("Expected 1 namespace after skipping 2, got %d", len(namespaces))
+	}
+
+	if err := repo.DeleteNamespace(ctx, "payments"); err != nil {
+		t.Fatalf("Failed to delete namespace: %v", err)
+	}
+	if _, err := repo.GetNamespace(ctx, "payments"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+	if err := repo.DeleteNamespace(ctx, "payments"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+}
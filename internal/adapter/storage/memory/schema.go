@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// SchemaRepository stores the version history of the schema of every config type in memory
+type SchemaRepository struct {
+	mu      sync.RWMutex
+	schemas map[string][]*domain.Schema
+}
+
+func NewSchemaRepository() *SchemaRepository {
+	return &SchemaRepository{
+		schemas: make(map[string][]*domain.Schema),
+	}
+}
+
+func (r *SchemaRepository) PutSchema(ctx context.Context, schema *domain.Schema, expectedVersion int) (*domain.Schema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions := r.schemas[schema.Type]
+
+	latestVersion := 0
+	if len(versions) > 0 {
+		latestVersion = versions[len(versions)-1].Version
+	}
+
+	if expectedVersion != 0 && expectedVersion != latestVersion {
+		return nil, domain.ErrVersionConflict // Someone else has registered a version in the meantime
+	}
+
+	newSchema := *schema                  // Store a copy so the caller can't modify the history
+	newSchema.Version = latestVersion + 1 // Increment the version, a new type starts at 1
+	newSchema.CreatedAt = time.Now()      // Set the creation timestamp
+
+	r.schemas[schema.Type] = append(versions, &newSchema)
+
+	return &newSchema, nil
+}
+
+func (r *SchemaRepository) GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if versions := r.schemas[schemaType]; len(versions) > 0 {
+		return versions[len(versions)-1], nil // Return the latest version of the schema
+	}
+
+	return nil, domain.ErrDataNotFound
+}
+
+func (r *SchemaRepository) ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error) {
+	r.mu.RLock()
+	schemas := make([]*domain.Schema, 0, len(r.schemas))
+	for _, versions := range r.schemas {
+		schemas = append(schemas, versions[len(versions)-1]) // Get the latest version of each schema
+	}
+	r.mu.RUnlock()
+
+	// Map iteration order is random, sort so that pages are stable
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Type < schemas[j].Type })
+
+	if skip >= uint64(len(schemas)) {
+		return nil, nil // No schemas to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(schemas)) {
+		end = uint64(len(schemas))
+	}
+
+	return schemas[skip:end], nil
+}
+
+func (r *SchemaRepository) ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error) {
+	r.mu.RLock()
+	versions := r.schemas[schemaType]
+	r.mu.RUnlock()
+
+	if len(versions) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	if skip >= uint64(len(versions)) {
+		return nil, nil // No versions to return
+	}
+
+	end := skip + limit
+	if end > uint64(len(versions)) {
+		end = uint64(len(versions))
+	}
+
+	return versions[skip:end:end], nil
+}
+
+func (r *SchemaRepository) GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, v := range r.schemas[schemaType] {
+		if v.Version == version {
+			return v, nil // Return the specific version
+		}
+	}
+
+	return nil, domain.ErrDataNotFound
+}
+
+func (r *SchemaRepository) DeleteSchema(ctx context.Context, schemaType string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.schemas[schemaType]; !ok {
+		return domain.ErrDataNotFound
+	}
+
+	delete(r.schemas, schemaType)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+const aliasColumns = `namespace, name, alias, revision, version, moved_at, moved_by, change_message`
+
+// scanAlias reads a row selected with aliasColumns
+func scanAlias(row scanner) (*domain.Alias, error) {
+	var (
+		alias   domain.Alias
+		movedAt int64
+	)
+
+	if err := row.Scan(&alias.Namespace, &alias.Name, &alias.Alias, &alias.Revision, &alias.Version, &movedAt, &alias.MovedBy, &alias.ChangeMessage); err != nil {
+		return nil, err
+	}
+
+	alias.MovedAt = time.Unix(0, movedAt)
+
+	return &alias, nil
+}
+
+// scanAliases reads every row selected with aliasColumns
+func scanAliases(rows *sql.Rows) ([]*domain.Alias, error) {
+	defer rows.Close()
+
+	var aliases []*domain.Alias
+	for rows.Next() {
+		alias, err := scanAlias(rows)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+
+	return aliases, rows.Err()
+}
+
+func (r *ConfigurationRepository) MoveAlias(ctx context.Context, alias *domain.Alias, expectedRevision int) (*domain.Alias, error) {
+	movedAt := time.Now()
+
+	// The next revision and the expected revision check are computed inside the insert so that they are atomic,
+	// the primary key is the safety net
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO configuration_aliases (namespace, name, alias, revision, version, moved_at, moved_by, change_message)
+		SELECT ?, ?, ?, COALESCE(MAX(revision), 0) + 1, ?, ?, ?, ?
+		FROM configuration_aliases
+		WHERE namespace = ? AND name = ? AND alias = ?
+		HAVING ? = 0 OR COALESCE(MAX(revision), 0) = ?
+		RETURNING `+aliasColumns,
+		alias.Namespace, alias.Name, alias.Alias, alias.Version, movedAt.UnixNano(), alias.MovedBy, alias.ChangeMessage,
+		alias.Namespace, alias.Name, alias.Alias, expectedRevision, expectedRevision)
+
+	moved, err := scanAlias(row)
+	if errors.Is(err, sql.ErrNoRows) || isUniqueViolation(err) {
+		return nil, domain.ErrAliasConflict // Someone else has moved the alias in the meantime
+	}
+
+	return moved, err
+}
+
+func (r *ConfigurationRepository) GetAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+aliasColumns+`
+		FROM configuration_aliases
+		WHERE namespace = ? AND name = ? AND alias = ?
+		ORDER BY revision DESC
+		LIMIT 1`,
+		namespace, name, alias)
+
+	found, err := scanAlias(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return found, err
+}
+
+func (r *ConfigurationRepository) ListAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+aliasColumns+`
+		FROM configuration_aliases a
+		WHERE namespace = ? AND name = ? AND version != 0
+			AND revision = (SELECT MAX(revision) FROM configuration_aliases WHERE namespace = a.namespace AND name = a.name AND alias = a.alias)
+		ORDER BY alias`,
+		namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAliases(rows)
+}
+
+func (r *ConfigurationRepository) ListAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+aliasColumns+`
+		FROM configuration_aliases
+		WHERE namespace = ? AND name = ? AND alias = ?
+		ORDER BY revision
+		LIMIT ? OFFSET ?`,
+		namespace, name, alias, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAliases(rows)
+}
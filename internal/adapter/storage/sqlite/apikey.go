@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// ApiKeyRepository stores every API key as a row of the api_keys table
+type ApiKeyRepository struct {
+	db *sql.DB
+}
+
+// NewApiKeyRepository creates a new API key repository on top of a migrated database
+func NewApiKeyRepository(db *sql.DB) *ApiKeyRepository {
+	return &ApiKeyRepository{
+		db,
+	}
+}
+
+const apiKeyColumns = `id, name, hash, scopes, expires_at, created_at, rotated_at, revoked_at`
+
+// unixNano stores a timestamp as nanoseconds, with 0 for an unset one
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// fromUnixNano reads a timestamp stored by unixNano
+func fromUnixNano(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// scanApiKey reads a row selected with apiKeyColumns
+func scanApiKey(row scanner) (*domain.ApiKey, error) {
+	var (
+		key                                        domain.ApiKey
+		scopes                                     string
+		expiresAt, createdAt, rotatedAt, revokedAt int64
+	)
+
+	if err := row.Scan(&key.ID, &key.Name, &key.Hash, &scopes, &expiresAt, &createdAt, &rotatedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(scopes), &key.Scopes); err != nil {
+		return nil, err
+	}
+
+	key.ExpiresAt = fromUnixNano(expiresAt)
+	key.CreatedAt = fromUnixNano(createdAt)
+	key.RotatedAt = fromUnixNano(rotatedAt)
+	key.RevokedAt = fromUnixNano(revokedAt)
+
+	return &key, nil
+}
+
+func (r *ApiKeyRepository) CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, error) {
+	scopes, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now() // Set the creation timestamp
+
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO api_keys (id, name, hash, scopes, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		RETURNING `+apiKeyColumns,
+		key.ID, key.Name, key.Hash, string(scopes), unixNano(key.ExpiresAt), createdAt.UnixNano())
+
+	newKey, err := scanApiKey(row)
+	if isUniqueViolation(err) {
+		return nil, domain.ErrConflictingData
+	}
+
+	return newKey, err
+}
+
+func (r *ApiKeyRepository) GetApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = ?`, id)
+
+	key, err := scanApiKey(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return key, err
+}
+
+func (r *ApiKeyRepository) ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		ORDER BY created_at, id
+		LIMIT ? OFFSET ?`,
+		limit, skip)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*domain.ApiKey
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// update changes a key that isn't revoked, telling a missing key apart from a revoked one when nothing was changed
+func (r *ApiKeyRepository) update(ctx context.Context, id string, set string, args ...any) (*domain.ApiKey, error) {
+	row := r.db.QueryRowContext(ctx, `
+		UPDATE api_keys
+		SET `+set+`
+		WHERE id = ? AND revoked_at = 0
+		RETURNING `+apiKeyColumns,
+		append(args, id)...)
+
+	key, err := scanApiKey(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		if _, err := r.GetApiKey(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrApiKeyRevoked
+	}
+
+	return key, err
+}
+
+func (r *ApiKeyRepository) RotateApiKey(ctx context.Context, id, hash string) (*domain.ApiKey, error) {
+	return r.update(ctx, id, `hash = ?, rotated_at = ?`, hash, time.Now().UnixNano())
+}
+
+func (r *ApiKeyRepository) RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	return r.update(ctx, id, `revoked_at = ?`, time.Now().UnixNano())
+}
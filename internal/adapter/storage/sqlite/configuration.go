@@ -0,0 +1,569 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// ConfigurationRepository stores every configuration version as a row of the configurations table
+type ConfigurationRepository struct {
+	db *sql.DB
+}
+
+// NewConfigurationRepository creates a new configuration repository on top of a migrated database
+func NewConfigurationRepository(db *sql.DB) *ConfigurationRepository {
+	return &ConfigurationRepository{
+		db,
+	}
+}
+
+const configurationColumns = `namespace, name, type, value, version, schema_version, rollbacked_version, deleted, created_at, created_by, change_message,
+	overlays, environment, promoted_from, promoted_version, labels, annotations`
+
+// scanner is implemented by both *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanConfiguration reads a row selected with configurationColumns
+func scanConfiguration(row scanner) (*domain.Config, error) {
+	var (
+		config      domain.Config
+		value       string
+		overlays    string
+		labels      string
+		annotations string
+		createdAt   int64
+	)
+
+	if err := row.Scan(&config.Namespace, &config.Name, &config.Type, &value, &config.Version, &config.SchemaVersion, &config.RollbackedVersion, &config.Deleted, &createdAt, &config.CreatedBy, &config.ChangeMessage,
+		&overlays, &config.Environment, &config.PromotedFrom, &config.PromotedVersion, &labels, &annotations); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(value), &config.Value); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(overlays), &config.Overlays); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(labels), &config.Labels); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(annotations), &config.Annotations); err != nil {
+		return nil, err
+	}
+
+	config.CreatedAt = time.Unix(0, createdAt)
+
+	return &config, nil
+}
+
+// scanConfigurations reads every row selected with configurationColumns
+func scanConfigurations(rows *sql.Rows) ([]*domain.Config, error) {
+	defer rows.Close()
+
+	var configs []*domain.Config
+	for rows.Next() {
+		config, err := scanConfiguration(rows)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, config)
+	}
+
+	return configs, rows.Err()
+}
+
+// isUniqueViolation reports whether err was caused by the (namespace, name, version) unique index
+func isUniqueViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
+
+func (r *ConfigurationRepository) PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	value, err := json.Marshal(config.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	overlays, err := json.Marshal(config.Overlays)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := json.Marshal(config.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := json.Marshal(config.Annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now() // Set the creation timestamp
+
+	// The next version and the expected version check are computed inside the insert so that they are atomic,
+	// the unique index is the safety net. A writer that had to wait for the database is stamped no earlier than
+	// the version before it
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO configurations (namespace, name, version, type, value, schema_version, rollbacked_version, created_at, created_by, change_message,
+			overlays, environment, promoted_from, promoted_version, labels, annotations)
+		SELECT ?, ?, COALESCE(MAX(version), 0) + 1, ?, ?, ?, 0, MAX(?, COALESCE(MAX(created_at), 0)), ?, ?, ?, ?, ?, ?, ?, ?
+		FROM configurations
+		WHERE namespace = ? AND name = ?
+		HAVING ? = 0 OR COALESCE(MAX(version), 0) = ?
+		RETURNING version, created_at`,
+		config.Namespace, config.Name, config.Type, string(value), config.SchemaVersion, now.UnixNano(), config.CreatedBy, config.ChangeMessage,
+		string(overlays), config.Environment, config.PromotedFrom, config.PromotedVersion, string(labels), string(annotations),
+		config.Namespace, config.Name, expectedVersion, expectedVersion)
+
+	var (
+		version   int
+		createdAt int64
+	)
+	if err := row.Scan(&version, &createdAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrVersionConflict // Someone else has written a version in the meantime
+		}
+		if isUniqueViolation(err) {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	config.Version = version
+	config.RollbackedVersion = 0
+	config.Deleted = false
+	config.CreatedAt = time.Unix(0, createdAt)
+
+	return config, nil
+}
+
+func (r *ConfigurationRepository) GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+configurationColumns+`
+		FROM configurations
+		WHERE namespace = ? AND name = ?
+		ORDER BY version DESC
+		LIMIT 1`,
+		namespace, name)
+
+	config, err := scanConfiguration(row)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && config.Deleted) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return config, err
+}
+
+// sortColumns are the columns of each sort order, compared in the same order as domain.CompareConfigs
+var sortColumns = map[domain.SortField][]string{
+	domain.SortByName:      {"namespace", "name", "version"},
+	domain.SortByCreatedAt: {"created_at", "namespace", "name", "version"},
+	domain.SortByVersion:   {"version", "namespace", "name"},
+}
+
+// latestSortColumns are the columns of each sort order of the latest versions, compared in the same order as
+// domain.CompareLatest, so that a cursor on a configuration holds when it gets a new version between two pages
+var latestSortColumns = map[domain.SortField][]string{
+	domain.SortByName:      {"namespace", "name"},
+	domain.SortByCreatedAt: {"created_at", "namespace", "name"},
+	domain.SortByVersion:   {"version", "namespace", "name"},
+}
+
+// pageClause returns the condition that starts a page after its cursor, joined to the preceding WHERE clause with
+// AND, followed by the ORDER BY and LIMIT of the page, together with their arguments. The columns of the sort order
+// are taken from sortColumns or latestSortColumns
+func pageClause(page domain.PageRequest, orders map[domain.SortField][]string) (string, []any) {
+	columns, ok := orders[page.Sort]
+	if !ok {
+		columns = orders[domain.SortByName]
+	}
+
+	direction, comparison := "ASC", ">"
+	if page.Order == domain.SortDescending {
+		direction, comparison = "DESC", "<"
+	}
+
+	var (
+		clause strings.Builder
+		args   []any
+	)
+
+	if page.Cursor != nil {
+		// A row value comparison continues right after the cursor, using the index of the sort order
+		values := map[string]any{
+			"namespace":  page.Cursor.Namespace,
+			"name":       page.Cursor.Name,
+			"version":    page.Cursor.Version,
+			"created_at": page.Cursor.CreatedAt,
+		}
+		for _, column := range columns {
+			args = append(args, values[column])
+		}
+		clause.WriteString(" AND (" + strings.Join(columns, ", ") + ") " + comparison + " (?" + strings.Repeat(", ?", len(columns)-1) + ")")
+	}
+
+	clause.WriteString(" ORDER BY " + strings.Join(columns, " "+direction+", ") + " " + direction + " LIMIT ? OFFSET ?")
+	args = append(args, page.Limit, page.Skip)
+
+	return clause.String(), args
+}
+
+// globPattern turns a pattern of path.Match into one of the GLOB operator, which has no escapes but matches a
+// special character in brackets
+func globPattern(pattern string) string {
+	var glob strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '\\' && i+1 < len(pattern) {
+			i++
+			glob.WriteString("[" + pattern[i:i+1] + "]")
+			continue
+		}
+		glob.WriteByte(pattern[i])
+	}
+
+	return glob.String()
+}
+
+// predicateCondition returns the condition of a value predicate, comparing the JSON type of the field as well so that
+// e.g. the string "1" isn't equal to the number 1
+func predicateCondition(predicate domain.ValuePredicate) (string, []any) {
+	path := predicate.JSONPath()
+
+	var equal string
+	var args []any
+	switch operand := predicate.Operand.(type) {
+	case string:
+		equal, args = `json_type(value, ?) = 'text' AND json_extract(value, ?) `, []any{path, path}
+	case float64:
+		equal, args = `json_type(value, ?) IN ('integer', 'real') AND json_extract(value, ?) `, []any{path, path}
+	case bool:
+		return predicateEquality(predicate.Operator, `json_type(value, ?) = ?`), []any{path, strconv.FormatBool(operand)}
+	default:
+		return predicateEquality(predicate.Operator, `json_type(value, ?) = 'null'`), []any{path}
+	}
+
+	if predicate.Operator == domain.ValueNotEqual {
+		return predicateEquality(predicate.Operator, equal+`= ?`), append(args, predicate.Operand)
+	}
+
+	return equal + string(predicate.Operator) + ` ?`, append(args, predicate.Operand)
+}
+
+// predicateEquality returns an equality condition, or its negation for !=, which a missing field passes
+func predicateEquality(operator domain.ValueOperator, equal string) string {
+	if operator == domain.ValueNotEqual {
+		return `NOT COALESCE(` + equal + `, 0)`
+	}
+
+	return equal
+}
+
+// labelCondition returns the condition of a requirement of a label selector, the labels are a JSON object
+func labelCondition(requirement domain.LabelRequirement) (string, []any) {
+	path := `$.` + strconv.Quote(requirement.Key)
+
+	switch requirement.Operator {
+	case domain.LabelExists:
+		return `json_type(labels, ?) IS NOT NULL`, []any{path}
+	case domain.LabelDoesNotExist:
+		return `json_type(labels, ?) IS NULL`, []any{path}
+	}
+
+	args := []any{path}
+	for _, value := range requirement.Values {
+		args = append(args, value)
+	}
+	in := `json_extract(labels, ?) IN (?` + strings.Repeat(`, ?`, len(requirement.Values)-1) + `)`
+
+	if requirement.Operator == domain.LabelNotIn {
+		return `NOT COALESCE(` + in + `, 0)`, args
+	}
+
+	return in, args
+}
+
+// queryCondition returns the conditions of a query, joined to the preceding WHERE clause with AND, together with their
+// arguments
+func queryCondition(query domain.ConfigQuery) (string, []any) {
+	var (
+		condition strings.Builder
+		args      []any
+	)
+
+	if query.Type != "" {
+		condition.WriteString(` AND type = ?`)
+		args = append(args, query.Type)
+	}
+	if query.NamePrefix != "" {
+		condition.WriteString(` AND substr(name, 1, length(?)) = ?`)
+		args = append(args, query.NamePrefix, query.NamePrefix)
+	}
+	if query.NameGlob != "" {
+		condition.WriteString(` AND name GLOB ?`)
+		args = append(args, globPattern(query.NameGlob))
+	}
+	if !query.CreatedAfter.IsZero() {
+		condition.WriteString(` AND created_at > ?`)
+		args = append(args, query.CreatedAfter.UnixNano())
+	}
+	if !query.CreatedBefore.IsZero() {
+		condition.WriteString(` AND created_at < ?`)
+		args = append(args, query.CreatedBefore.UnixNano())
+	}
+	for _, predicate := range query.Values {
+		clause, clauseArgs := predicateCondition(predicate)
+		condition.WriteString(` AND (` + clause + `)`)
+		args = append(args, clauseArgs...)
+	}
+	for _, requirement := range query.Labels {
+		clause, clauseArgs := labelCondition(requirement)
+		condition.WriteString(` AND (` + clause + `)`)
+		args = append(args, clauseArgs...)
+	}
+
+	return condition.String(), args
+}
+
+// ListConfigurations reads the latest live versions through the index of the sort order, see 0009_page_configurations.sql.
+// The query is pushed down as conditions on the same rows, and the total is counted with every condition
+func (r *ConfigurationRepository) ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	where := ` WHERE latest = 1 AND deleted = 0`
+
+	var args []any
+	if namespace != "" {
+		where += ` AND namespace = ?`
+		args = append(args, namespace)
+	}
+
+	condition, queryArgs := queryCondition(query)
+	where += condition
+	args = append(args, queryArgs...)
+
+	var total uint64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM configurations`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	clause, pageArgs := pageClause(page, latestSortColumns)
+
+	rows, err := r.db.QueryContext(ctx, `SELECT `+configurationColumns+` FROM configurations`+where+clause, append(args, pageArgs...)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	configs, err := scanConfigurations(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return configs, total, nil
+}
+
+func (r *ConfigurationRepository) ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	// Counts every version, to tell whether the configuration exists, and the versions that pass the filter
+	var versions, total uint64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*), COALESCE(SUM(? = '' OR created_by = ?), 0)
+		FROM configurations
+		WHERE namespace = ? AND name = ?`,
+		filter.CreatedBy, filter.CreatedBy, namespace, name).Scan(&versions, &total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if versions == 0 {
+		return nil, 0, domain.ErrDataNotFound
+	}
+
+	clause, pageArgs := pageClause(page, sortColumns)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+configurationColumns+`
+		FROM configurations
+		WHERE namespace = ? AND name = ? AND (? = '' OR created_by = ?)`+clause,
+		append([]any{namespace, name, filter.CreatedBy, filter.CreatedBy}, pageArgs...)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	configs, err := scanConfigurations(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return configs, total, nil
+}
+
+func (r *ConfigurationRepository) GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+configurationColumns+`
+		FROM configurations
+		WHERE namespace = ? AND name = ? AND version = ?`,
+		namespace, name, version)
+
+	config, err := scanConfiguration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return config, err
+}
+
+func (r *ConfigurationRepository) RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int, change domain.Change) (*domain.Config, error) {
+	createdAt := time.Now() // Set the creation timestamp
+
+	// Copy the requested version as a new latest version in a single atomic statement
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO configurations (namespace, name, version, type, value, schema_version, rollbacked_version, created_at, created_by, change_message, overlays,
+			labels, annotations)
+		SELECT c.namespace, c.name, (SELECT MAX(version) FROM configurations WHERE namespace = c.namespace AND name = c.name) + 1,
+			c.type, c.value, c.schema_version, c.version,
+			MAX(?, (SELECT MAX(created_at) FROM configurations WHERE namespace = c.namespace AND name = c.name)), ?, ?, c.overlays, c.labels, c.annotations
+		FROM configurations c
+		WHERE c.namespace = ? AND c.name = ? AND c.version = ? AND c.deleted = 0
+		RETURNING `+configurationColumns,
+		createdAt.UnixNano(), change.CreatedBy, change.Message, namespace, name, version)
+
+	config, err := scanConfiguration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, domain.ErrConflictingData
+	}
+
+	return config, err
+}
+
+// latestVersion returns the latest version of a configuration, which may be a tombstone, or nil if it doesn't exist
+func (r *ConfigurationRepository) latestVersion(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+configurationColumns+`
+		FROM configurations
+		WHERE namespace = ? AND name = ?
+		ORDER BY version DESC
+		LIMIT 1`,
+		namespace, name)
+
+	config, err := scanConfiguration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return config, err
+}
+
+func (r *ConfigurationRepository) DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int, change domain.Change) (*domain.Config, error) {
+	createdAt := time.Now() // Set the creation timestamp
+
+	// Append the tombstone only if the latest version is live and is the expected one, in a single atomic statement
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO configurations (namespace, name, version, type, value, schema_version, rollbacked_version, deleted, created_at, created_by, change_message)
+		SELECT c.namespace, c.name, c.version + 1, c.type, 'null', 0, 0, 1, MAX(?, c.created_at), ?, ?
+		FROM configurations c
+		WHERE c.namespace = ? AND c.name = ? AND c.version = (SELECT MAX(version) FROM configurations WHERE namespace = c.namespace AND name = c.name)
+			AND c.deleted = 0 AND (? = 0 OR c.version = ?)
+		RETURNING `+configurationColumns,
+		createdAt.UnixNano(), change.CreatedBy, change.Message, namespace, name, expectedVersion, expectedVersion)
+
+	config, err := scanConfiguration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Nothing was written, find out why
+		current, err := r.latestVersion(ctx, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil || current.Deleted {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, domain.ErrVersionConflict // Someone else has written a version in the meantime
+	}
+	if isUniqueViolation(err) {
+		return nil, domain.ErrVersionConflict
+	}
+
+	return config, err
+}
+
+func (r *ConfigurationRepository) RestoreConfiguration(ctx context.Context, namespace, name string, change domain.Change) (*domain.Config, error) {
+	createdAt := time.Now() // Set the creation timestamp
+
+	// Copy the version before the tombstone as the new latest version, if the latest version is a tombstone
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO configurations (namespace, name, version, type, value, schema_version, rollbacked_version, deleted, created_at, created_by, change_message, overlays,
+			labels, annotations)
+		SELECT p.namespace, p.name, c.version + 1, p.type, p.value, p.schema_version, p.version, 0, MAX(?, c.created_at), ?, ?, p.overlays, p.labels,
+			p.annotations
+		FROM configurations c
+		JOIN configurations p ON p.namespace = c.namespace AND p.name = c.name AND p.version = c.version - 1
+		WHERE c.namespace = ? AND c.name = ? AND c.version = (SELECT MAX(version) FROM configurations WHERE namespace = c.namespace AND name = c.name)
+			AND c.deleted = 1
+		RETURNING `+configurationColumns,
+		createdAt.UnixNano(), change.CreatedBy, change.Message, namespace, name)
+
+	config, err := scanConfiguration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Nothing was written, find out why
+		current, err := r.latestVersion(ctx, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, domain.ErrConfigurationNotDeleted
+	}
+	if isUniqueViolation(err) {
+		return nil, domain.ErrConflictingData
+	}
+
+	return config, err
+}
+
+// PurgeConfiguration deletes every version of a configuration and its aliases, overwriting the freed pages and
+// truncating the write-ahead log so the erased values don't linger on disk
+func (r *ConfigurationRepository) PurgeConfiguration(ctx context.Context, namespace, name string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM configurations WHERE namespace = ? AND name = ?`, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM configuration_aliases WHERE namespace = ? AND name = ?`, namespace, name); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `PRAGMA wal_checkpoint(TRUNCATE)`)
+	return err
+}
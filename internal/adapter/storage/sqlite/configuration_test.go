@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/storage/storagetest"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+)
+
+func newTestRepository(t *testing.T) *ConfigurationRepository {
+	t.Helper()
+
+	db, err := Open(&config.Storage{SQLitePath: filepath.Join(t.TempDir(), "cms.db")})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return NewConfigurationRepository(db)
+}
+
+func TestConfigurationRepository(t *testing.T) {
+	storagetest.TestConfigurationRepository(t, func(t *testing.T) (port.ConfigurationRepository, func() port.ConfigurationRepository) {
+		cfg := &config.Storage{SQLitePath: filepath.Join(t.TempDir(), "cms.db")}
+		open := func() *sql.DB {
+			db, err := Open(cfg)
+			if err != nil {
+				t.Fatalf("Failed to open database: %v", err)
+			}
+			return db
+		}
+
+		db := open()
+		t.Cleanup(func() { db.Close() })
+
+		return NewConfigurationRepository(db), func() port.ConfigurationRepository {
+			db.Close()
+			db = open()
+			return NewConfigurationRepository(db)
+		}
+	})
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	repo := newTestRepository(t)
+
+	if err := Migrate(context.Background(), repo.db); err != nil {
+		t.Fatalf("Expected migrations to be re-runnable, got %v", err)
+	}
+
+	list, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	var applied int
+	if err := repo.db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&applied); err != nil {
+		t.Fatalf("Failed to count migrations: %v", err)
+	}
+	if applied != len(list) {
+		t.Errorf("Expected %d applied migrations, got %d", len(list), applied)
+	}
+}
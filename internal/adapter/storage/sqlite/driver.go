@@ -0,0 +1,4 @@
+package sqlite
+
+// The pure-Go SQLite driver registers itself as "sqlite" and needs no cgo toolchain
+import _ "modernc.org/sqlite"
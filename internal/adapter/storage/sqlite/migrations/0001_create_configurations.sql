@@ -0,0 +1,14 @@
+-- Every version of a configuration is stored as its own row
+CREATE TABLE configurations (
+    id                 INTEGER PRIMARY KEY AUTOINCREMENT,
+    name               TEXT    NOT NULL,
+    version            INTEGER NOT NULL,
+    type               TEXT    NOT NULL,
+    value              TEXT    NOT NULL,
+    rollbacked_version INTEGER NOT NULL DEFAULT 0,
+    created_at         INTEGER NOT NULL
+);
+
+-- Prevents two writers from minting the same version number, and serves
+-- version listings as well as the latest-version lookups of config listings
+CREATE UNIQUE INDEX ux_configurations_name_version ON configurations (name, version);
@@ -0,0 +1,7 @@
+-- The schema registry holds the current definition of every config type
+CREATE TABLE schemas (
+    type       TEXT    PRIMARY KEY,
+    definition TEXT    NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
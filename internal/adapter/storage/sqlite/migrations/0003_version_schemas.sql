@@ -0,0 +1,22 @@
+-- Every version of a schema is stored as its own row, the current definitions become version 1
+CREATE TABLE schema_versions (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    type          TEXT    NOT NULL,
+    version       INTEGER NOT NULL,
+    definition    TEXT    NOT NULL,
+    compatibility TEXT    NOT NULL,
+    created_at    INTEGER NOT NULL
+);
+
+CREATE UNIQUE INDEX ux_schema_versions_type_version ON schema_versions (type, version);
+
+INSERT INTO schema_versions (type, version, definition, compatibility, created_at)
+SELECT type, 1, definition, 'backward', updated_at
+FROM schemas;
+
+DROP TABLE schemas;
+
+-- Existing configurations were validated against the only version of their schema
+ALTER TABLE configurations ADD COLUMN schema_version INTEGER NOT NULL DEFAULT 0;
+
+UPDATE configurations SET schema_version = 1 WHERE type IN (SELECT type FROM schema_versions);
@@ -0,0 +1,2 @@
+-- A deleted configuration keeps its history, deleting it appends a tombstone version
+ALTER TABLE configurations ADD COLUMN deleted INTEGER NOT NULL DEFAULT 0;
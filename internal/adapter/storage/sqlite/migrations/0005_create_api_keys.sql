@@ -0,0 +1,11 @@
+-- Only the hash of the secret of an API key is stored, revoked keys are kept for auditing
+CREATE TABLE api_keys (
+    id         TEXT    PRIMARY KEY,
+    name       TEXT    NOT NULL,
+    hash       TEXT    NOT NULL,
+    scopes     TEXT    NOT NULL,
+    expires_at INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL,
+    rotated_at INTEGER NOT NULL DEFAULT 0,
+    revoked_at INTEGER NOT NULL DEFAULT 0
+);
@@ -0,0 +1,3 @@
+-- Every version records the caller that wrote it and why, versions written before are left anonymous
+ALTER TABLE configurations ADD COLUMN created_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE configurations ADD COLUMN change_message TEXT NOT NULL DEFAULT '';
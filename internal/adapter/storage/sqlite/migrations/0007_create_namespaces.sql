@@ -0,0 +1,16 @@
+-- Configurations are grouped into namespaces, names are unique within a namespace.
+-- Existing configurations move to the default namespace
+CREATE TABLE namespaces (
+    name        TEXT    PRIMARY KEY,
+    description TEXT    NOT NULL DEFAULT '',
+    metadata    TEXT    NOT NULL DEFAULT '{}',
+    created_at  INTEGER NOT NULL
+);
+
+INSERT INTO namespaces (name, created_at) VALUES ('default', strftime('%s', 'now') * 1000000000);
+
+ALTER TABLE configurations ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default';
+
+DROP INDEX ux_configurations_name_version;
+
+CREATE UNIQUE INDEX ux_configurations_namespace_name_version ON configurations (namespace, name, version);
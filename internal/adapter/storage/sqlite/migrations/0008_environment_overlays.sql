@@ -0,0 +1,6 @@
+-- Every version holds the overlays of its environments, and records the environment it wrote and the one it was
+-- promoted from. Versions written before have no overlays
+ALTER TABLE configurations ADD COLUMN overlays TEXT NOT NULL DEFAULT 'null';
+ALTER TABLE configurations ADD COLUMN environment TEXT NOT NULL DEFAULT '';
+ALTER TABLE configurations ADD COLUMN promoted_from TEXT NOT NULL DEFAULT '';
+ALTER TABLE configurations ADD COLUMN promoted_version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,23 @@
+-- Every row records whether it is the latest version of its configuration, so that listings read the latest
+-- versions from an index in sort order instead of grouping the whole history
+ALTER TABLE configurations ADD COLUMN latest INTEGER NOT NULL DEFAULT 1;
+
+UPDATE configurations SET latest = 0
+WHERE EXISTS (
+    SELECT 1 FROM configurations newer
+    WHERE newer.namespace = configurations.namespace AND newer.name = configurations.name AND newer.version > configurations.version
+);
+
+-- A new version is inserted as the latest and takes over from the one before it
+CREATE TRIGGER tr_configurations_latest AFTER INSERT ON configurations
+BEGIN
+    UPDATE configurations SET latest = 0
+    WHERE namespace = NEW.namespace AND name = NEW.name AND version < NEW.version AND latest = 1;
+END;
+
+-- Serve the listings of live configurations in each sort order, within a namespace and across all of them
+CREATE INDEX ix_configurations_latest_name ON configurations (namespace, name) WHERE latest = 1 AND deleted = 0;
+CREATE INDEX ix_configurations_latest_namespace_created_at ON configurations (namespace, created_at, name) WHERE latest = 1 AND deleted = 0;
+CREATE INDEX ix_configurations_latest_namespace_version ON configurations (namespace, version, name) WHERE latest = 1 AND deleted = 0;
+CREATE INDEX ix_configurations_latest_created_at ON configurations (created_at, namespace, name) WHERE latest = 1 AND deleted = 0;
+CREATE INDEX ix_configurations_latest_version ON configurations (version, namespace, name) WHERE latest = 1 AND deleted = 0;
@@ -0,0 +1,4 @@
+-- Every version holds the labels and annotations of its configuration as JSON objects. Versions written before have
+-- neither
+ALTER TABLE configurations ADD COLUMN labels TEXT NOT NULL DEFAULT 'null';
+ALTER TABLE configurations ADD COLUMN annotations TEXT NOT NULL DEFAULT 'null';
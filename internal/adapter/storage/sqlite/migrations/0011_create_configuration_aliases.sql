@@ -0,0 +1,13 @@
+-- Every move of an alias of a configuration is stored as its own row, the latest revision is where the alias points.
+-- A cleared alias points at version 0
+CREATE TABLE configuration_aliases (
+    namespace      TEXT    NOT NULL,
+    name           TEXT    NOT NULL,
+    alias          TEXT    NOT NULL,
+    revision       INTEGER NOT NULL,
+    version        INTEGER NOT NULL,
+    moved_at       INTEGER NOT NULL,
+    moved_by       TEXT    NOT NULL DEFAULT '',
+    change_message TEXT    NOT NULL DEFAULT '',
+    PRIMARY KEY (namespace, name, alias, revision)
+);
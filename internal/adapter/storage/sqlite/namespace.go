@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// NamespaceRepository stores every namespace as a row of the namespaces table
+type NamespaceRepository struct {
+	db *sql.DB
+}
+
+// NewNamespaceRepository creates a new namespace repository on top of a migrated database,
+// the migrations create the default namespace
+func NewNamespaceRepository(db *sql.DB) *NamespaceRepository {
+	return &NamespaceRepository{
+		db,
+	}
+}
+
+const namespaceColumns = `name, description, metadata, created_at`
+
+// scanNamespace reads a row selected with namespaceColumns
+func scanNamespace(row scanner) (*domain.Namespace, error) {
+	var (
+		namespace domain.Namespace
+		metadata  string
+		createdAt int64
+	)
+
+	if err := row.Scan(&namespace.Name, &namespace.Description, &metadata, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(metadata), &namespace.Metadata); err != nil {
+		return nil, err
+	}
+
+	namespace.CreatedAt = time.Unix(0, createdAt)
+
+	return &namespace, nil
+}
+
+func (r *NamespaceRepository) CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error) {
+	metadata, err := json.Marshal(namespace.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now() // Set the creation timestamp
+
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO namespaces (name, description, metadata, created_at)
+		VALUES (?, ?, ?, ?)
+		RETURNING `+namespaceColumns,
+		namespace.Name, namespace.Description, string(metadata), createdAt.UnixNano())
+
+	newNamespace, err := scanNamespace(row)
+	if isUniqueViolation(err) {
+		return nil, domain.ErrConflictingData
+	}
+
+	return newNamespace, err
+}
+
+func (r *NamespaceRepository) GetNamespace(ctx context.Context, name string) (*domain.Namespace, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+namespaceColumns+` FROM namespaces WHERE name = ?`, name)
+
+	namespace, err := scanNamespace(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return namespace, err
+}
+
+func (r *NamespaceRepository) ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+namespaceColumns+`
+		FROM namespaces
+		ORDER BY name
+		LIMIT ? OFFSET ?`,
+		limit, skip)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var namespaces []*domain.Namespace
+	for rows.Next() {
+		namespace, err := scanNamespace(rows)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, namespace)
+	}
+
+	return namespaces, rows.Err()
+}
+
+func (r *NamespaceRepository) DeleteNamespace(ctx context.Context, name string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM namespaces WHERE name = ?`, name)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
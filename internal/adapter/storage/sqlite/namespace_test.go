@@ -0,0 +1,44 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestNamespaceRepository(t *testing.T) {
+	repo := NewNamespaceRepository(newTestRepository(t).db)
+	ctx := context.Background()
+
+	if _, err := repo.GetNamespace(ctx, domain.DefaultNamespace); err != nil {
+		t.Fatalf("Expected the default namespace, got %v", err)
+	}
+
+	created, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: "payments", Description: "Payment services", Metadata: map[string]string{"team": "payments"}})
+	if err != nil {
+		t.Fatalf("Failed to create namespace: %v", err)
+	}
+	if created.CreatedAt.IsZero() || created.Description != "Payment services" || created.Metadata["team"] != "payments" {
+		t.Errorf("Expected the created namespace, got %v", created)
+	}
+
+	if _, err := repo.CreateNamespace(ctx, &domain.Namespace{Name: "payments"}); err != domain.ErrConflictingData {
+		t.Errorf("Expected error %v, got %v", domain.ErrConflictingData, err)
+	}
+
+	namespaces, err := repo.ListNamespaces(ctx, 0, 10)
+	if err != nil {
+		t.Fatalf("Failed to list namespaces: %v", err)
+	}
+	if len(namespaces) != 2 || namespaces[0].Name != domain.DefaultNamespace || namespaces[1].Name != "payments" {
+		t.Errorf("Expected the default and payments namespaces, got %v", namespaces)
+	}
+
+	if err := repo.DeleteNamespace(ctx, "payments"); err != nil {
+		t.Fatalf("Failed to delete namespace: %v", err)
+	}
+	if err := repo.DeleteNamespace(ctx, "payments"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+}
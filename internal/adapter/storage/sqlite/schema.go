@@ -0,0 +1,187 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// SchemaRepository stores every schema version as a row of the schema_versions table
+type SchemaRepository struct {
+	db *sql.DB
+}
+
+// NewSchemaRepository creates a new schema repository on top of a migrated database
+func NewSchemaRepository(db *sql.DB) *SchemaRepository {
+	return &SchemaRepository{
+		db,
+	}
+}
+
+const schemaColumns = `type, version, definition, compatibility, created_at`
+
+// scanSchema reads a row selected with schemaColumns
+func scanSchema(row scanner) (*domain.Schema, error) {
+	var (
+		schema     domain.Schema
+		definition string
+		createdAt  int64
+	)
+
+	if err := row.Scan(&schema.Type, &schema.Version, &definition, &schema.Compatibility, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(definition), &schema.Definition); err != nil {
+		return nil, err
+	}
+
+	schema.CreatedAt = time.Unix(0, createdAt)
+
+	return &schema, nil
+}
+
+// scanSchemas reads every row selected with schemaColumns
+func scanSchemas(rows *sql.Rows) ([]*domain.Schema, error) {
+	defer rows.Close()
+
+	var schemas []*domain.Schema
+	for rows.Next() {
+		schema, err := scanSchema(rows)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+
+	return schemas, rows.Err()
+}
+
+func (r *SchemaRepository) PutSchema(ctx context.Context, schema *domain.Schema, expectedVersion int) (*domain.Schema, error) {
+	definition, err := json.Marshal(schema.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now() // Set the creation timestamp
+
+	// The next version and the expected version check are computed inside the insert so that they are atomic,
+	// the unique index is the safety net
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO schema_versions (type, version, definition, compatibility, created_at)
+		SELECT ?, COALESCE(MAX(version), 0) + 1, ?, ?, ?
+		FROM schema_versions
+		WHERE type = ?
+		HAVING ? = 0 OR COALESCE(MAX(version), 0) = ?
+		RETURNING `+schemaColumns,
+		schema.Type, string(definition), schema.Compatibility, createdAt.UnixNano(), schema.Type, expectedVersion, expectedVersion)
+
+	newSchema, err := scanSchema(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrVersionConflict // Someone else has registered a version in the meantime
+	}
+	if isUniqueViolation(err) {
+		return nil, domain.ErrConflictingData
+	}
+
+	return newSchema, err
+}
+
+func (r *SchemaRepository) GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+schemaColumns+`
+		FROM schema_versions
+		WHERE type = ?
+		ORDER BY version DESC
+		LIMIT 1`,
+		schemaType)
+
+	schema, err := scanSchema(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return schema, err
+}
+
+func (r *SchemaRepository) ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT s.type, s.version, s.definition, s.compatibility, s.created_at
+		FROM schema_versions s
+		JOIN (
+			SELECT type, MAX(version) AS version
+			FROM schema_versions
+			GROUP BY type
+			ORDER BY type
+			LIMIT ? OFFSET ?
+		) latest ON latest.type = s.type AND latest.version = s.version
+		ORDER BY s.type`,
+		limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanSchemas(rows)
+}
+
+func (r *SchemaRepository) ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM schema_versions WHERE type = ?)`, schemaType).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, domain.ErrDataNotFound
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+schemaColumns+`
+		FROM schema_versions
+		WHERE type = ?
+		ORDER BY version
+		LIMIT ? OFFSET ?`,
+		schemaType, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanSchemas(rows)
+}
+
+func (r *SchemaRepository) GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+schemaColumns+`
+		FROM schema_versions
+		WHERE type = ? AND version = ?`,
+		schemaType, version)
+
+	schema, err := scanSchema(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return schema, err
+}
+
+func (r *SchemaRepository) DeleteSchema(ctx context.Context, schemaType string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM schema_versions WHERE type = ?`, schemaType)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
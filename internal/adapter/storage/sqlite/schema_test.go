@@ -0,0 +1,108 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestSchemaRepository(t *testing.T) {
+	repo := NewSchemaRepository(newTestRepository(t).db)
+
+	_, err := repo.GetSchema(context.Background(), "person")
+	if err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	_, err = repo.ListSchemaVersions(context.Background(), "person", 0, 10)
+	if err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	created, err := repo.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": "object"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+	if created.Version != 1 || created.CreatedAt.IsZero() {
+		t.Errorf("Expected version 1 with CreatedAt set, got %v", created)
+	}
+
+	_, err = repo.PutSchema(context.Background(), &domain.Schema{Type: "address", Definition: map[string]interface{}{"type": "object"}}, 0)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+
+	// Register a new version of an existing type
+	updated, err := repo.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": "string"}}, 1)
+	if err != nil {
+		t.Fatalf("Failed to put schema: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("Expected version 2, got %d", updated.Version)
+	}
+
+	// The second writer still expects version 1
+	_, err = repo.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": "number"}}, 1)
+	if err != domain.ErrVersionConflict {
+		t.Errorf("Expected error %v, got %v", domain.ErrVersionConflict, err)
+	}
+
+	got, err := repo.GetSchema(context.Background(), "person")
+	if err != nil {
+		t.Fatalf("Failed to get schema: %v", err)
+	}
+	if got.Version != 2 || got.Definition["type"] != "string" {
+		t.Errorf("Expected the latest version, got %v", got)
+	}
+
+	got, err = repo.GetSchemaVersion(context.Background(), "person", 1)
+	if err != nil {
+		t.Fatalf("Failed to get schema version: %v", err)
+	}
+	if got.Definition["type"] != "object" {
+		t.Errorf("Expected the first definition, got %v", got.Definition)
+	}
+
+	_, err = repo.GetSchemaVersion(context.Background(), "person", 3)
+	if err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	versions, err := repo.ListSchemaVersions(context.Background(), "person", 0, 10)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 1 || versions[1].Version != 2 {
+		t.Errorf("Expected versions 1 and 2, got %v", versions)
+	}
+
+	schemas, err := repo.ListSchemas(context.Background(), 0, 10)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(schemas) != 2 || schemas[0].Type != "address" || schemas[1].Type != "person" || schemas[1].Version != 2 {
+		t.Errorf("Expected the latest address and person schemas sorted by type, got %v", schemas)
+	}
+
+	schemas, err = repo.ListSchemas(context.Background(), 1, 10)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(schemas) != 1 {
+		t.Errorf("Expected 1 schema, got %d", len(schemas))
+	}
+
+	if err := repo.DeleteSchema(context.Background(), "person"); err != nil {
+		t.Fatalf("Failed to delete schema: %v", err)
+	}
+
+	if err := repo.DeleteSchema(context.Background(), "person"); err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+
+	_, err = repo.GetSchemaVersion(context.Background(), "person", 1)
+	if err != domain.ErrDataNotFound {
+		t.Errorf("Expected error %v, got %v", domain.ErrDataNotFound, err)
+	}
+}
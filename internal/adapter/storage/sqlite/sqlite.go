@@ -0,0 +1,171 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/adapter/config"
+)
+
+// driverName is the database/sql driver registered by modernc.org/sqlite
+const driverName = "sqlite"
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Open opens the SQLite database configured in the storage config and applies any pending migrations
+func Open(cfg *config.Storage) (*sql.DB, error) {
+	path := cfg.SQLitePath
+	if path == "" {
+		path = "data/cms.db"
+	}
+
+	if !strings.HasPrefix(path, "file:") && path != ":memory:" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := sql.Open(driverName, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite serializes writers anyway. A single connection keeps pragmas in effect and avoids SQLITE_BUSY
+	db.SetMaxOpenConns(1)
+
+	for _, pragma := range []string{
+		"PRAGMA journal_mode = WAL",
+		"PRAGMA synchronous = NORMAL",
+		"PRAGMA foreign_keys = ON",
+		"PRAGMA busy_timeout = 5000",
+		"PRAGMA secure_delete = ON", // Purged configurations are overwritten, not just unlinked
+	} {
+		if _, err := db.Exec(pragma); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("%s: %w", pragma, err)
+		}
+	}
+
+	if err := Migrate(context.Background(), db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// migration is a numbered schema change loaded from the migrations directory
+type migration struct {
+	version int
+	name    string
+	query   string
+}
+
+// loadMigrations reads the embedded migrations ordered by their numeric prefix, e.g. 0001_create_configurations.sql
+func loadMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	var list []migration
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s has no version prefix", entry.Name())
+		}
+
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has an invalid version prefix: %w", entry.Name(), err)
+		}
+
+		query, err := fs.ReadFile(migrations, "migrations/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, migration{version: version, name: entry.Name(), query: string(query)})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].version < list[j].version })
+
+	return list, nil
+}
+
+// Migrate applies every migration that has not been recorded in the schema_migrations table yet.
+// Each migration runs in its own transaction together with its bookkeeping row
+func Migrate(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT    NOT NULL,
+		applied_at INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return err
+	}
+
+	list, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	applied := make(map[int]bool)
+	rows, err := db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return err
+		}
+		applied[version] = true
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	for _, m := range list {
+		if applied[m.version] {
+			continue
+		}
+
+		if err := applyMigration(ctx, db, m); err != nil {
+			return fmt.Errorf("applying migration %s: %w", m.name, err)
+		}
+	}
+
+	return nil
+}
+
+// applyMigration runs a single migration and records it
+func applyMigration(ctx context.Context, db *sql.DB, m migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, m.query); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`, m.version, m.name, time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
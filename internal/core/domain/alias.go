@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// Alias names a version of a configuration, such as stable or canary, so that clients can read a version other than
+// the latest. Moving an alias doesn't write a version of the configuration, every move is kept as a revision of the
+// alias instead, recording who moved it and why
+type Alias struct {
+	Namespace     string    `json:"namespace"`
+	Name          string    `json:"name"` // Name of the configuration
+	Alias         string    `json:"alias"`
+	Version       int       `json:"version"`  // Version the alias points at, 0 once the alias is cleared
+	Revision      int       `json:"revision"` // Number of the move, the first move is 1
+	MovedAt       time.Time `json:"moved_at"`
+	MovedBy       string    `json:"moved_by,omitempty"`       // Caller that moved the alias, see Principal.Name
+	ChangeMessage string    `json:"change_message,omitempty"` // Optional, why the alias was moved
+}
+
+// IsSet reports whether the alias points at a version, a cleared alias doesn't
+func (a *Alias) IsSet() bool {
+	return a.Version != 0
+}
+
+// ValidAliasName reports whether a name can be given to an alias, aliases are named like namespaces
+func ValidAliasName(name string) bool {
+	return namespaceNamePattern.MatchString(name)
+}
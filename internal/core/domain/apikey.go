@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// ApiKeyScope is a kind of operation an API key may perform
+type ApiKeyScope string
+
+const (
+	// ApiKeyScopeRead allows reading and watching configurations and schemas
+	ApiKeyScopeRead ApiKeyScope = "read"
+	// ApiKeyScopeWrite allows every other change of configurations and schemas
+	ApiKeyScopeWrite ApiKeyScope = "write"
+	// ApiKeyScopeAdmin allows the irreversible operations, such as purging configurations and managing API keys
+	ApiKeyScopeAdmin ApiKeyScope = "admin"
+)
+
+// ApiKeyScopes are the scopes an API key can be granted
+var ApiKeyScopes = []ApiKeyScope{ApiKeyScopeRead, ApiKeyScopeWrite, ApiKeyScopeAdmin}
+
+// ApiKey represents a long-lived credential of a machine client. Only the hash of its secret is stored
+type ApiKey struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	Hash      string        `json:"hash"` // Hex encoded SHA-256 of the secret
+	Scopes    []ApiKeyScope `json:"scopes"`
+	ExpiresAt time.Time     `json:"expires_at,omitempty"` // Zero if the key doesn't expire
+	CreatedAt time.Time     `json:"created_at"`
+	RotatedAt time.Time     `json:"rotated_at,omitempty"` // Set when the secret was last replaced
+	RevokedAt time.Time     `json:"revoked_at,omitempty"` // Set once the key is revoked, it can't be used again
+}
+
+// Revoked reports whether the key has been revoked
+func (k *ApiKey) Revoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// Expired reports whether the key has expired at the given time
+func (k *ApiKey) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// HasScope reports whether the key was granted a scope
+func (k *ApiKey) HasScope(scope ApiKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
@@ -1,13 +1,63 @@
 package domain
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Config represents data about a record Config.
 type Config struct {
+	Namespace         string                 `json:"namespace"` // Namespace the name is unique in
 	Name              string                 `json:"name"`
 	Type              string                 `json:"type"`
 	Value             map[string]interface{} `json:"value"`
 	Version           int                    `json:"version"`
+	SchemaVersion     int                    `json:"schema_version,omitempty"`     // Version of the type's schema the value was validated against
 	RollbackedVersion int                    `json:"rollbacked_version,omitempty"` // Optional field for copied version
+	Deleted           bool                   `json:"deleted,omitempty"`            // Set on the tombstone version written when the configuration is deleted
 	CreatedAt         time.Time              `json:"created_at,omitempty"`         // Optional field for creation timestamp
+	CreatedBy         string                 `json:"created_by,omitempty"`         // Caller that wrote the version, see Principal.Name
+	ChangeMessage     string                 `json:"change_message,omitempty"`     // Optional, why the version was written
+
+	// Overlays are the values of the environments, each a JSON Merge Patch (RFC 7396) over Value that holds what
+	// differs in that environment. An environment without an overlay gets Value as it is
+	Overlays        map[string]map[string]interface{} `json:"overlays,omitempty"`
+	Environment     string                            `json:"environment,omitempty"`      // Environment whose overlay the version wrote, empty when it wrote the base value
+	PromotedFrom    string                            `json:"promoted_from,omitempty"`    // Environment the overlay was promoted from
+	PromotedVersion int                               `json:"promoted_version,omitempty"` // Version the overlay was promoted from
+
+	// Labels tag the configuration for selecting it, such as team=payments, and annotations hold free-form notes about
+	// it. Neither is part of the value, so neither is validated against the schema
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Change describes who writes a version of a configuration, and why
+type Change struct {
+	CreatedBy string
+	Message   string
+}
+
+// VersionFilter limits the versions of a configuration that are listed. An empty field doesn't limit them
+type VersionFilter struct {
+	CreatedBy string
+}
+
+// Matches reports whether a version of a configuration passes the filter
+func (f VersionFilter) Matches(config *Config) bool {
+	return f.CreatedBy == "" || config.CreatedBy == f.CreatedBy
+}
+
+// changeMessageKey is the context key of the reason a request gives for a change
+type changeMessageKey struct{}
+
+// ContextWithChangeMessage returns a copy of ctx that carries the reason for the change a request makes
+func ContextWithChangeMessage(ctx context.Context, message string) context.Context {
+	return context.WithValue(ctx, changeMessageKey{}, message)
+}
+
+// ChangeMessageFromContext returns the reason for the change a request makes, or an empty string if it gives none
+func ChangeMessageFromContext(ctx context.Context) string {
+	message, _ := ctx.Value(changeMessageKey{}).(string)
+	return message
 }
@@ -0,0 +1,30 @@
+package domain
+
+// DiffFormat is the representation of the changes between two versions of a configuration
+type DiffFormat string
+
+const (
+	// DiffFormatPatch is a list of RFC 6902 JSON Patch operations that turn one value into the other
+	DiffFormatPatch DiffFormat = "patch"
+	// DiffFormatUnified is a unified diff of the two values, for humans to read
+	DiffFormatUnified DiffFormat = "unified"
+)
+
+// DiffOperation is an RFC 6902 JSON Patch operation
+type DiffOperation struct {
+	Op    string      // add, remove or replace
+	Path  string      // JSON pointer into the configuration value
+	Value interface{} // New value of an add or replace
+}
+
+// ConfigDiff represents the changes between two versions of a configuration
+type ConfigDiff struct {
+	Namespace   string
+	Name        string
+	FromVersion int
+	ToVersion   int
+	FromType    string
+	ToType      string
+	Operations  []DiffOperation // Set for DiffFormatPatch
+	Unified     string          // Set for DiffFormatUnified
+}
@@ -0,0 +1,43 @@
+package domain
+
+// ValidEnvironmentName reports whether a name can be given to an environment, environments are named like namespaces
+func ValidEnvironmentName(name string) bool {
+	return namespaceNamePattern.MatchString(name)
+}
+
+// ForEnvironment returns a copy of a configuration version whose value is the effective value in an environment,
+// the base value with the overlay of the environment merged over it
+func (c *Config) ForEnvironment(environment string) *Config {
+	effective := *c
+	effective.Value = MergeOverlay(c.Value, c.Overlays[environment])
+	effective.Overlays = nil
+
+	return &effective
+}
+
+// MergeOverlay applies an overlay to a value as a JSON Merge Patch (RFC 7396): objects are merged recursively, a null
+// removes a field and anything else replaces it. Neither argument is modified
+func MergeOverlay(value, overlay map[string]interface{}) map[string]interface{} {
+	if overlay == nil {
+		return value
+	}
+
+	merged := make(map[string]interface{}, len(value)+len(overlay))
+	for k, v := range value {
+		merged[k] = v
+	}
+
+	for k, v := range overlay {
+		switch v := v.(type) {
+		case nil:
+			delete(merged, k)
+		case map[string]interface{}:
+			base, _ := merged[k].(map[string]interface{})
+			merged[k] = MergeOverlay(base, v)
+		default:
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
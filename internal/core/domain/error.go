@@ -39,4 +39,60 @@ var (
 	ErrForbidden = errors.New("user is forbidden to access the resource")
 	// ErrInvalidSchema is an error for when the schema validation fails
 	ErrInvalidSchema = errors.New("invalid schema")
+	// ErrInvalidSchemaDefinition is an error for when a registered schema is not a valid JSON schema
+	ErrInvalidSchemaDefinition = errors.New("schema definition is not a valid JSON schema")
+	// ErrIncompatibleSchema is an error for when a new schema version breaks the compatibility mode or existing configurations
+	ErrIncompatibleSchema = errors.New("schema is incompatible with the previous version or existing configurations")
+	// ErrUnsupportedDiffFormat is an error for when a diff is requested in an unknown format
+	ErrUnsupportedDiffFormat = errors.New("diff format is not supported")
+	// ErrUnsupportedPatchType is an error for when a patch document is neither a JSON Merge Patch nor a JSON Patch
+	ErrUnsupportedPatchType = errors.New("patch type is not supported")
+	// ErrInvalidPatch is an error for when a patch document is malformed or can't be applied to the configuration value
+	ErrInvalidPatch = errors.New("invalid patch")
+	// ErrPatchTestFailed is an error for when a test operation of a JSON Patch doesn't hold
+	ErrPatchTestFailed = errors.New("patch test operation failed")
+	// ErrSchemaInUse is an error for when a schema is deleted while configurations of its type exist
+	ErrSchemaInUse = errors.New("schema is used by existing configurations")
+	// ErrConfigurationNotDeleted is an error for when a configuration that isn't deleted is restored
+	ErrConfigurationNotDeleted = errors.New("configuration is not deleted")
+	// ErrRevisionUnavailable is an error for when a watcher resumes from a revision the event history no longer holds
+	ErrRevisionUnavailable = errors.New("revision is no longer available, read the configurations again and watch from now")
+	// ErrNotModified is an error for when no newer version of a configuration is written before a wait times out
+	ErrNotModified = errors.New("configuration has not been modified")
+	// ErrInvalidApiKey is an error for when an API key is created without a name or a known scope, or with an expiry in the past
+	ErrInvalidApiKey = errors.New("API key must have a name, known scopes and an expiry in the future")
+	// ErrApiKeyRevoked is an error for when a revoked API key is rotated or revoked again
+	ErrApiKeyRevoked = errors.New("API key has been revoked")
+	// ErrInvalidNamespace is an error for when a namespace is created with a name that isn't lowercase letters, digits, '-' and '_'
+	ErrInvalidNamespace = errors.New("namespace name must be 1 to 63 lowercase letters, digits, '-' or '_', starting and ending with a letter or digit")
+	// ErrNamespaceNotFound is an error for when a configuration is written to a namespace that doesn't exist
+	ErrNamespaceNotFound = errors.New("namespace not found")
+	// ErrNamespaceNotEmpty is an error for when a namespace that still holds configurations is deleted
+	ErrNamespaceNotEmpty = errors.New("namespace still holds configurations")
+	// ErrDefaultNamespace is an error for when the default namespace is deleted
+	ErrDefaultNamespace = errors.New("default namespace can't be deleted")
+	// ErrInvalidEnvironment is an error for when an environment is named with anything but lowercase letters, digits, '-' and '_'
+	ErrInvalidEnvironment = errors.New("environment name must be 1 to 63 lowercase letters, digits, '-' or '_', starting and ending with a letter or digit")
+	// ErrInvalidPromotion is an error for when a version is promoted from an environment to the same environment
+	ErrInvalidPromotion = errors.New("a version must be promoted to another environment")
+	// ErrInvalidSort is an error for when a list is sorted by an unknown field or in an unknown order
+	ErrInvalidSort = errors.New("sort must be name, created_at or version, and order asc or desc")
+	// ErrInvalidCursor is an error for when a page starts at a cursor that is malformed or was returned for another sort order
+	ErrInvalidCursor = errors.New("cursor is invalid or belongs to a list in another sort order")
+	// ErrInvalidQuery is an error for when configurations are listed with a malformed name glob or an empty created range
+	ErrInvalidQuery = errors.New("name must be a valid glob, and created_after must come before created_before")
+	// ErrInvalidPredicate is an error for when a value predicate isn't a path into the value, an operator and a JSON literal
+	ErrInvalidPredicate = errors.New("where must be a path into the value, an operator (==, !=, <, <=, >, >=) and a JSON literal, such as value.region == \"eu\"")
+	// ErrInvalidSelector is an error for when configurations are listed with a label selector that isn't comma separated requirements
+	ErrInvalidSelector = errors.New("selector must be comma separated requirements such as tier=critical, tier!=critical, tier in (critical,high), tier notin (low), tier or !tier")
+	// ErrInvalidLabel is an error for when a configuration is labeled with an invalid key or value
+	ErrInvalidLabel = errors.New("label key must be a name of 1 to 63 letters, digits, '-', '_' or '.', starting and ending with a letter or digit, optionally prefixed by a DNS subdomain and '/', and its value such a name or empty")
+	// ErrInvalidAnnotation is an error for when a configuration is annotated with an invalid key or with more than 256 KiB
+	ErrInvalidAnnotation = errors.New("annotation key must be a valid label key, and annotations may hold at most 256 KiB")
+	// ErrInvalidAlias is an error for when an alias is named with anything but lowercase letters, digits, '-' and '_', or moved to no version
+	ErrInvalidAlias = errors.New("alias name must be 1 to 63 lowercase letters, digits, '-' or '_', starting and ending with a letter or digit, and it must point at a version")
+	// ErrAliasConflict is an error for when the latest revision of an alias is not the revision the client expected to move
+	ErrAliasConflict = errors.New("alias has been moved since the expected revision")
+	// ErrVersionConflict is an error for when the latest version is not the version the client expected to replace
+	ErrVersionConflict = errors.New("configuration has been modified since the expected version")
 )
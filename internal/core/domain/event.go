@@ -0,0 +1,49 @@
+package domain
+
+import "strings"
+
+// ConfigEventType is the kind of write that created a configuration version
+type ConfigEventType string
+
+const (
+	ConfigEventPut      ConfigEventType = "put"
+	ConfigEventRollback ConfigEventType = "rollback"
+	ConfigEventDelete   ConfigEventType = "delete"
+	ConfigEventRestore  ConfigEventType = "restore"
+	ConfigEventPromote  ConfigEventType = "promote"
+	ConfigEventPurge    ConfigEventType = "purge" // Config only has the name, no version is left
+)
+
+// ConfigEvent represents a change of a configuration.
+// Revisions increase with every event, across configurations, for as long as the service runs
+type ConfigEvent struct {
+	Revision uint64
+	Type     ConfigEventType
+	Config   *Config
+}
+
+// WatchFilter selects the configurations a watcher is notified about, an empty field matches any configuration
+type WatchFilter struct {
+	Namespace  string
+	Name       string
+	NamePrefix string
+	Type       string
+}
+
+// Matches reports whether a version of a configuration passes the filter
+func (f WatchFilter) Matches(config *Config) bool {
+	if f.Namespace != "" && config.Namespace != f.Namespace {
+		return false
+	}
+	if f.Name != "" && config.Name != f.Name {
+		return false
+	}
+	if f.NamePrefix != "" && !strings.HasPrefix(config.Name, f.NamePrefix) {
+		return false
+	}
+	if f.Type != "" && config.Type != f.Type {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxAnnotationsSize is how many bytes the keys and values of the annotations of a configuration may hold in all
+const maxAnnotationsSize = 256 * 1024
+
+var (
+	// labelNamePattern is the name part of a label key, and a label value that isn't empty
+	labelNamePattern = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+	// labelPrefixPattern is the optional prefix of a label key, a DNS subdomain such as example.com
+	labelPrefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	// setRequirementPattern is a requirement of a selector on a set of values, such as tier in (critical,high)
+	setRequirementPattern = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// ValidLabelKey reports whether a key can be given to a label or annotation: a name of up to 63 letters, digits, '-',
+// '_' and '.', starting and ending with a letter or digit, optionally prefixed by a DNS subdomain and a '/', such as
+// example.com/team
+func ValidLabelKey(key string) bool {
+	prefix, name, prefixed := strings.Cut(key, "/")
+	if !prefixed {
+		return labelNamePattern.MatchString(key)
+	}
+
+	return len(prefix) <= 253 && labelPrefixPattern.MatchString(prefix) && labelNamePattern.MatchString(name)
+}
+
+// ValidLabelValue reports whether a label can have a value, which is empty or named like the name of a key
+func ValidLabelValue(value string) bool {
+	return value == "" || labelNamePattern.MatchString(value)
+}
+
+// ValidateLabels fails with ErrInvalidLabel if a key or value of the labels is invalid
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !ValidLabelKey(key) || !ValidLabelValue(value) {
+			return ErrInvalidLabel
+		}
+	}
+
+	return nil
+}
+
+// ValidateAnnotations fails with ErrInvalidAnnotation if a key of the annotations is invalid, or if they hold more than
+// 256 KiB. Their values are free-form
+func ValidateAnnotations(annotations map[string]string) error {
+	size := 0
+	for key, value := range annotations {
+		if !ValidLabelKey(key) {
+			return ErrInvalidAnnotation
+		}
+		size += len(key) + len(value)
+	}
+
+	if size > maxAnnotationsSize {
+		return ErrInvalidAnnotation
+	}
+
+	return nil
+}
+
+// LabelOperator is how a requirement of a label selector tests a label
+type LabelOperator string
+
+const (
+	LabelIn           LabelOperator = "in"    // The label has one of the values
+	LabelNotIn        LabelOperator = "notin" // The label is missing or has none of the values
+	LabelExists       LabelOperator = "exists"
+	LabelDoesNotExist LabelOperator = "!"
+)
+
+// LabelRequirement is one requirement of a label selector. tier=critical is read as tier in (critical), and
+// tier!=critical as tier notin (critical)
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string // The values of in and notin
+}
+
+// Matches reports whether labels meet the requirement
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case LabelIn:
+		return ok && slices.Contains(r.Values, value)
+	case LabelNotIn:
+		return !ok || !slices.Contains(r.Values, value)
+	case LabelExists:
+		return ok
+	default:
+		return !ok
+	}
+}
+
+// LabelSelector selects the configurations whose labels meet every requirement, like the label selectors of Kubernetes
+type LabelSelector []LabelRequirement
+
+// Matches reports whether labels meet every requirement of the selector
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseLabelSelector reads a selector of comma separated requirements, such as
+// team=payments,tier in (critical,high),!deprecated, failing with ErrInvalidSelector if it isn't one. The
+// requirements are key=value, key==value, key!=value, key in (values), key notin (values), key and !key
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var parsed LabelSelector
+
+	for _, term := range splitRequirements(selector) {
+		requirement, err := parseLabelRequirement(strings.TrimSpace(term))
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, requirement)
+	}
+
+	return parsed, nil
+}
+
+// splitRequirements splits a selector at the commas that aren't within the parentheses of a set of values
+func splitRequirements(selector string) []string {
+	if strings.TrimSpace(selector) == "" {
+		return nil
+	}
+
+	var (
+		terms []string
+		depth int
+		start int
+	)
+
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(terms, selector[start:])
+}
+
+// parseLabelRequirement reads one requirement of a selector
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	requirement := LabelRequirement{Key: term, Operator: LabelExists}
+
+	if match := setRequirementPattern.FindStringSubmatch(term); match != nil {
+		requirement.Key, requirement.Operator = match[1], LabelOperator(match[2])
+		for _, value := range strings.Split(match[3], ",") {
+			requirement.Values = append(requirement.Values, strings.TrimSpace(value))
+		}
+	} else if key, found := strings.CutPrefix(term, "!"); found && !strings.HasPrefix(key, "=") {
+		requirement.Key, requirement.Operator = strings.TrimSpace(key), LabelDoesNotExist
+	} else if key, value, found := strings.Cut(term, "!="); found {
+		requirement.Key, requirement.Operator, requirement.Values = strings.TrimSpace(key), LabelNotIn, []string{strings.TrimSpace(value)}
+	} else if key, value, found := strings.Cut(term, "="); found {
+		value = strings.TrimPrefix(value, "=") // key==value is the same as key=value
+		requirement.Key, requirement.Operator, requirement.Values = strings.TrimSpace(key), LabelIn, []string{strings.TrimSpace(value)}
+	}
+
+	if !ValidLabelKey(requirement.Key) {
+		return requirement, ErrInvalidSelector
+	}
+
+	for _, value := range requirement.Values {
+		if !ValidLabelValue(value) {
+			return requirement, ErrInvalidSelector
+		}
+	}
+
+	return requirement, nil
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// DefaultNamespace holds the configurations of the routes and requests that don't name a namespace.
+// It always exists and can't be deleted
+const DefaultNamespace = "default"
+
+// namespaceNamePattern is a lowercase name that is safe in paths and log keys, such as payments or team_a
+var namespaceNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]{0,61}[a-z0-9])?$`)
+
+// Namespace groups configurations, names of configurations are unique within a namespace
+type Namespace struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"` // Free-form details, such as the owning team
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// ValidNamespaceName reports whether a name can be given to a namespace
+func ValidNamespaceName(name string) bool {
+	return namespaceNamePattern.MatchString(name)
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// SortField is what a list of configurations, or of the versions of one, is ordered by
+type SortField string
+
+const (
+	// SortByName orders by namespace and name, the default. Versions of a configuration are then in version order
+	SortByName SortField = "name"
+	// SortByCreatedAt orders by when a version, or the latest version of a configuration, was written
+	SortByCreatedAt SortField = "created_at"
+	// SortByVersion orders by the version number, or the latest version number of a configuration
+	SortByVersion SortField = "version"
+)
+
+// SortOrder is the direction of a sorted list
+type SortOrder string
+
+const (
+	// SortAscending lists the smallest first, the default
+	SortAscending SortOrder = "asc"
+	// SortDescending lists the largest first
+	SortDescending SortOrder = "desc"
+)
+
+// PageRequest asks for a page of a sorted list. A page starts after Cursor, the position of the last item of the
+// previous page, or at the start of the list without one, and then skips Skip items. Empty fields sort by name in
+// ascending order
+type PageRequest struct {
+	Sort   SortField
+	Order  SortOrder
+	Cursor *Cursor
+	Skip   uint64
+	Limit  uint64
+}
+
+// Resolve returns the page request with the default sort field and order filled in. It fails with ErrInvalidSort for
+// an unknown field or order, and with ErrInvalidCursor for a cursor of a list in another order
+func (p PageRequest) Resolve() (PageRequest, error) {
+	if p.Sort == "" {
+		p.Sort = SortByName
+	}
+	if p.Order == "" {
+		p.Order = SortAscending
+	}
+
+	switch {
+	case p.Sort != SortByName && p.Sort != SortByCreatedAt && p.Sort != SortByVersion:
+		return p, ErrInvalidSort
+	case p.Order != SortAscending && p.Order != SortDescending:
+		return p, ErrInvalidSort
+	case p.Cursor != nil && (p.Cursor.Sort != p.Sort || p.Cursor.Order != p.Order):
+		return p, ErrInvalidCursor
+	}
+
+	return p, nil
+}
+
+// ConfigPage is a page of a list of configurations, or of the versions of one
+type ConfigPage struct {
+	Configs []*Config
+	Next    *Cursor // Where the next page starts, nil on the last page
+	Total   uint64  // How many items the whole list holds
+}
+
+// CompareConfigs orders two configuration versions by a sort field, ties are broken by namespace, name and version so
+// that no two versions are equal. It returns a negative number when a comes first, and a positive one when b does
+func CompareConfigs(a, b *Config, sort SortField) int {
+	if c := CompareLatest(a, b, sort); c != 0 {
+		return c
+	}
+
+	return a.Version - b.Version
+}
+
+// CompareLatest orders the latest versions of two configurations like CompareConfigs, but ties are only broken by
+// namespace and name. A list of latest versions holds one per configuration, so a configuration keeps its place by
+// name when it gets a new version, and a cursor on it still continues the list after it
+func CompareLatest(a, b *Config, sort SortField) int {
+	switch sort {
+	case SortByCreatedAt:
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+	case SortByVersion:
+		if c := a.Version - b.Version; c != 0 {
+			return c
+		}
+	}
+
+	if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.Name, b.Name)
+}
+
+// Cursor is the position of a configuration version in a sorted list. Clients get it as an opaque token, which is
+// only valid for the same sort field and order
+type Cursor struct {
+	Sort      SortField `json:"s"`
+	Order     SortOrder `json:"o"`
+	Namespace string    `json:"ns,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	Version   int       `json:"v,omitempty"`
+	CreatedAt int64     `json:"t,omitempty"` // Unix nanoseconds
+}
+
+// NewCursor returns the position of a configuration version in a list sorted like the page request
+func NewCursor(config *Config, page PageRequest) *Cursor {
+	return &Cursor{
+		Sort:      page.Sort,
+		Order:     page.Order,
+		Namespace: config.Namespace,
+		Name:      config.Name,
+		Version:   config.Version,
+		CreatedAt: config.CreatedAt.UnixNano(),
+	}
+}
+
+// Key returns a configuration version that has the sort key of the cursor, to compare with CompareConfigs
+func (c *Cursor) Key() *Config {
+	return &Config{
+		Namespace: c.Namespace,
+		Name:      c.Name,
+		Version:   c.Version,
+		CreatedAt: time.Unix(0, c.CreatedAt),
+	}
+}
+
+// Token encodes the cursor for a client
+func (c *Cursor) Token() string {
+	data, _ := json.Marshal(c) // A struct of strings and numbers always marshals
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseCursor decodes the token of a cursor, failing with ErrInvalidCursor if it isn't one
+func ParseCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Sort == "" || cursor.Order == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,11 @@
+package domain
+
+// PatchType is the format of a document that describes a partial update of a configuration value
+type PatchType string
+
+const (
+	// PatchTypeMerge is an RFC 7396 JSON Merge Patch
+	PatchTypeMerge PatchType = "merge"
+	// PatchTypeJSON is an RFC 6902 JSON Patch
+	PatchTypeJSON PatchType = "json"
+)
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+// PrincipalKind is the kind of credential a caller authenticated with
+type PrincipalKind string
+
+const (
+	// PrincipalUser is a caller identified by a JWT bearer token
+	PrincipalUser PrincipalKind = "user"
+	// PrincipalApiKey is a caller identified by an API key
+	PrincipalApiKey PrincipalKind = "api_key"
+)
+
+// Principal represents the authenticated caller of a request
+type Principal struct {
+	Kind    PrincipalKind
+	Subject string        // The sub claim of a token, or the ID of an API key
+	Scopes  []ApiKeyScope // The scopes of an API key, or those a token grants a user
+}
+
+// HasScope reports whether the caller may perform a kind of operation
+func (p *Principal) HasScope(scope ApiKeyScope) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// Name identifies the caller in the history of a configuration: the subject of a user, or api_key: followed by the
+// ID of an API key
+func (p *Principal) Name() string {
+	if p.Kind == PrincipalApiKey {
+		return string(PrincipalApiKey) + ":" + p.Subject
+	}
+
+	return p.Subject
+}
+
+// principalKey is the context key of the caller of a request
+type principalKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx that carries the caller of a request
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller of a request, or false if the request isn't authenticated
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"encoding/json"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigQuery limits the configurations that are listed to those whose latest version passes every field. An empty
+// field doesn't limit them
+type ConfigQuery struct {
+	Type          string
+	NamePrefix    string
+	NameGlob      string    // Matched like path.Match, e.g. payments_*
+	CreatedAfter  time.Time // Written after this time
+	CreatedBefore time.Time // Written before this time
+	Values        []ValuePredicate
+	Labels        LabelSelector
+}
+
+// IsEmpty reports whether the query lists every configuration
+func (q ConfigQuery) IsEmpty() bool {
+	return q.Type == "" && q.NamePrefix == "" && q.NameGlob == "" && q.CreatedAfter.IsZero() && q.CreatedBefore.IsZero() && len(q.Values) == 0 && len(q.Labels) == 0
+}
+
+// Validate fails with ErrInvalidQuery for a malformed name glob or a created range that is empty
+func (q ConfigQuery) Validate() error {
+	if _, err := path.Match(q.NameGlob, ""); err != nil {
+		return ErrInvalidQuery
+	}
+
+	if !q.CreatedAfter.IsZero() && !q.CreatedBefore.IsZero() && !q.CreatedAfter.Before(q.CreatedBefore) {
+		return ErrInvalidQuery
+	}
+
+	return nil
+}
+
+// Matches reports whether the latest version of a configuration passes the query
+func (q ConfigQuery) Matches(config *Config) bool {
+	switch {
+	case q.Type != "" && config.Type != q.Type:
+		return false
+	case !strings.HasPrefix(config.Name, q.NamePrefix):
+		return false
+	case !q.CreatedAfter.IsZero() && !config.CreatedAt.After(q.CreatedAfter):
+		return false
+	case !q.CreatedBefore.IsZero() && !config.CreatedAt.Before(q.CreatedBefore):
+		return false
+	}
+
+	if q.NameGlob != "" {
+		if matched, err := path.Match(q.NameGlob, config.Name); err != nil || !matched {
+			return false
+		}
+	}
+
+	for _, predicate := range q.Values {
+		if !predicate.Matches(config.Value) {
+			return false
+		}
+	}
+
+	return q.Labels.Matches(config.Labels)
+}
+
+// ValueOperator compares a field of the value of a configuration with an operand
+type ValueOperator string
+
+const (
+	ValueEqual          ValueOperator = "=="
+	ValueNotEqual       ValueOperator = "!="
+	ValueLess           ValueOperator = "<"
+	ValueLessOrEqual    ValueOperator = "<="
+	ValueGreater        ValueOperator = ">"
+	ValueGreaterOrEqual ValueOperator = ">="
+)
+
+// PathElement is a step into a JSON document, the key of an object or else the index of an array
+type PathElement struct {
+	Key   string
+	Index int
+}
+
+// ValuePredicate compares the field of the value of a configuration at a path with a JSON literal, such as
+// value.region == "eu". A field that is missing is only unequal to anything, and the ordering operators compare
+// numbers with numbers and strings with strings
+type ValuePredicate struct {
+	Path     []PathElement
+	Operator ValueOperator
+	Operand  interface{} // A string, float64, bool or nil
+}
+
+var (
+	// valueOperators are the operators of a predicate, the longer ones first so that <= isn't read as <
+	valueOperators = []ValueOperator{ValueEqual, ValueNotEqual, ValueLessOrEqual, ValueGreaterOrEqual, ValueLess, ValueGreater}
+	// pathElementPattern is a key of a path, optionally followed by array indices
+	pathElementPattern = regexp.MustCompile(`^([A-Za-z0-9_-]+)((?:\[[0-9]+\])*)$`)
+	// pathIndexPattern is an array index of a path element
+	pathIndexPattern = regexp.MustCompile(`\[([0-9]+)\]`)
+)
+
+// ParseValuePredicate reads a predicate such as value.region == "eu" or value.limits[0].max >= 10, failing with
+// ErrInvalidPredicate if it isn't one
+func ParseValuePredicate(expression string) (ValuePredicate, error) {
+	var predicate ValuePredicate
+
+	at := strings.IndexAny(expression, "=!<>")
+	if at < 0 {
+		return predicate, ErrInvalidPredicate
+	}
+
+	for _, operator := range valueOperators {
+		if strings.HasPrefix(expression[at:], string(operator)) {
+			predicate.Operator = operator
+			break
+		}
+	}
+	if predicate.Operator == "" {
+		return predicate, ErrInvalidPredicate
+	}
+
+	fieldPath, ok := strings.CutPrefix(strings.TrimSpace(expression[:at]), "value.")
+	if !ok {
+		return predicate, ErrInvalidPredicate
+	}
+
+	for _, element := range strings.Split(fieldPath, ".") {
+		match := pathElementPattern.FindStringSubmatch(element)
+		if match == nil {
+			return predicate, ErrInvalidPredicate
+		}
+
+		predicate.Path = append(predicate.Path, PathElement{Key: match[1]})
+		for _, index := range pathIndexPattern.FindAllStringSubmatch(match[2], -1) {
+			i, err := strconv.Atoi(index[1])
+			if err != nil {
+				return predicate, ErrInvalidPredicate
+			}
+			predicate.Path = append(predicate.Path, PathElement{Index: i})
+		}
+	}
+
+	if err := json.Unmarshal([]byte(expression[at+len(predicate.Operator):]), &predicate.Operand); err != nil {
+		return predicate, ErrInvalidPredicate
+	}
+
+	switch predicate.Operand.(type) {
+	case string, float64:
+	case bool, nil:
+		if predicate.Operator != ValueEqual && predicate.Operator != ValueNotEqual {
+			return predicate, ErrInvalidPredicate // Only numbers and strings are ordered
+		}
+	default:
+		return predicate, ErrInvalidPredicate // Objects and arrays aren't compared
+	}
+
+	return predicate, nil
+}
+
+// JSONPath returns the path of the predicate as a JSON path such as $."limits"[0]."max"
+func (p ValuePredicate) JSONPath() string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, element := range p.Path {
+		if element.Key != "" {
+			b.WriteString("." + strconv.Quote(element.Key))
+		} else {
+			b.WriteString("[" + strconv.Itoa(element.Index) + "]")
+		}
+	}
+
+	return b.String()
+}
+
+// Matches reports whether a configuration value passes the predicate
+func (p ValuePredicate) Matches(value map[string]interface{}) bool {
+	var field interface{} = value
+	for _, element := range p.Path {
+		var ok bool
+		if field, ok = step(field, element); !ok {
+			return p.Operator == ValueNotEqual
+		}
+	}
+
+	if p.Operator == ValueEqual || p.Operator == ValueNotEqual {
+		return equalScalars(field, p.Operand) == (p.Operator == ValueEqual)
+	}
+
+	c, ok := compareScalars(field, p.Operand)
+	if !ok {
+		return false
+	}
+
+	switch p.Operator {
+	case ValueLess:
+		return c < 0
+	case ValueLessOrEqual:
+		return c <= 0
+	case ValueGreater:
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+// step returns the field of a JSON document at one element of a path
+func step(document interface{}, element PathElement) (interface{}, bool) {
+	if element.Key != "" {
+		object, ok := document.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		field, ok := object[element.Key]
+		return field, ok
+	}
+
+	array, ok := document.([]interface{})
+	if !ok || element.Index >= len(array) {
+		return nil, false
+	}
+
+	return array[element.Index], true
+}
+
+// number returns a JSON number as a float64, whichever numeric type it was decoded into
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// equalScalars reports whether a JSON field is equal to an operand, a number equals a number of the same value
+func equalScalars(field, operand interface{}) bool {
+	if c, ok := compareScalars(field, operand); ok {
+		return c == 0
+	}
+
+	switch field.(type) {
+	case bool, nil:
+		return field == operand
+	}
+
+	return false
+}
+
+// compareScalars orders two numbers or two strings, it fails for anything else
+func compareScalars(field, operand interface{}) (int, bool) {
+	if a, ok := number(field); ok {
+		b, ok := number(operand)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		}
+		return 0, true
+	}
+
+	a, ok := field.(string)
+	if !ok {
+		return 0, false
+	}
+	b, ok := operand.(string)
+	if !ok {
+		return 0, false
+	}
+
+	return strings.Compare(a, b), true
+}
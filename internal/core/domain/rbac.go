@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"path"
+	"slices"
+)
+
+// Permission is a kind of operation on configurations that a role may allow
+type Permission string
+
+const (
+	// PermissionRead allows reading, listing, diffing and watching configurations
+	PermissionRead Permission = "read"
+	// PermissionWrite allows creating, replacing, patching, validating, deleting and restoring configurations
+	PermissionWrite Permission = "write"
+	// PermissionRollback allows rolling a configuration back to an earlier version
+	PermissionRollback Permission = "rollback"
+	// PermissionPromote allows promoting a version of a configuration from an environment to another, and moving the
+	// aliases of a configuration between its versions
+	PermissionPromote Permission = "promote"
+	// PermissionAdmin allows purging configurations and managing API keys
+	PermissionAdmin Permission = "admin"
+)
+
+// Role is a set of permissions that is bound to callers
+type Role string
+
+const (
+	// RoleViewer may only read configurations
+	RoleViewer Role = "viewer"
+	// RoleEditor may read and change configurations
+	RoleEditor Role = "editor"
+	// RoleReleaser may read configurations, roll them back, promote them between environments and move their aliases
+	RoleReleaser Role = "releaser"
+	// RoleAdmin may do anything
+	RoleAdmin Role = "admin"
+)
+
+// rolePermissions are the permissions of each role
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:   {PermissionRead},
+	RoleEditor:   {PermissionRead, PermissionWrite},
+	RoleReleaser: {PermissionRead, PermissionRollback, PermissionPromote},
+	RoleAdmin:    {PermissionRead, PermissionWrite, PermissionRollback, PermissionPromote, PermissionAdmin},
+}
+
+// Known reports whether a role exists
+func (r Role) Known() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Allows reports whether a role has a permission
+func (r Role) Allows(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+// RoleBinding grants a role to a user or an API key, on the configurations of a namespace whose name matches a glob
+// and whose type is a config type. An empty namespace, glob or type doesn't limit the binding
+type RoleBinding struct {
+	Role      Role          `json:"role"`
+	Kind      PrincipalKind `json:"kind"`
+	Subject   string        `json:"subject"` // The sub claim of a user's tokens, or the ID of an API key
+	Namespace string        `json:"namespace,omitempty"`
+	Names     string        `json:"names,omitempty"` // Glob of configuration names, such as payments_*
+	Type      string        `json:"type,omitempty"`
+}
+
+// Binds reports whether the binding applies to a caller
+func (b *RoleBinding) Binds(principal *Principal) bool {
+	return b.Kind == principal.Kind && b.Subject == principal.Subject
+}
+
+// Covers reports whether the binding applies to a configuration. A configuration that doesn't exist yet, or is
+// deleted, has no type and is only covered by bindings that aren't limited to a type
+func (b *RoleBinding) Covers(namespace, name, configType string) bool {
+	if b.Namespace != "" && b.Namespace != namespace {
+		return false
+	}
+
+	if b.Names != "" {
+		if matched, err := path.Match(b.Names, name); err != nil || !matched {
+			return false
+		}
+	}
+
+	return b.Type == "" || b.Type == configType
+}
+
+// CoversAll reports whether the binding applies to every configuration of a namespace, or of every namespace if it is
+// empty
+func (b *RoleBinding) CoversAll(namespace string) bool {
+	return (b.Namespace == "" || b.Namespace == namespace) && b.Names == "" && b.Type == ""
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SchemaCompatibility is the rule a new version of a schema must follow with respect to the previous version
+type SchemaCompatibility string
+
+const (
+	// SchemaCompatibilityNone only requires the current configurations of the type to match the new version
+	SchemaCompatibilityNone SchemaCompatibility = "none"
+	// SchemaCompatibilityBackward requires the new version to accept values written for the previous version
+	SchemaCompatibilityBackward SchemaCompatibility = "backward"
+	// SchemaCompatibilityForward requires the previous version to accept values written for the new version
+	SchemaCompatibilityForward SchemaCompatibility = "forward"
+	// SchemaCompatibilityFull requires both backward and forward compatibility
+	SchemaCompatibilityFull SchemaCompatibility = "full"
+)
+
+// Schema represents a version of the JSON schema that validates the value of every Config of a type.
+type Schema struct {
+	Type          string                 `json:"type"`
+	Version       int                    `json:"version"`
+	Definition    map[string]interface{} `json:"definition"`
+	Compatibility SchemaCompatibility    `json:"compatibility"`
+	CreatedAt     time.Time              `json:"created_at,omitempty"` // Optional field for creation timestamp
+}
+
+// IncompatibleConfig identifies a configuration whose latest version doesn't match a new schema version
+type IncompatibleConfig struct {
+	Namespace   string      `json:"namespace"`
+	Name        string      `json:"name"`
+	Version     int         `json:"version"`
+	Environment string      `json:"environment,omitempty"` // Environment whose effective value doesn't match, empty for the base value
+	Violations  []Violation `json:"violations"`
+}
+
+// SchemaCompatibilityError reports why a new schema version was rejected
+type SchemaCompatibilityError struct {
+	Type          string
+	Compatibility SchemaCompatibility
+	Issues        []string             // Changes from the previous version that break the compatibility mode
+	Configs       []IncompatibleConfig // Current configurations of the type that don't match the new version
+}
+
+func (e *SchemaCompatibilityError) Error() string {
+	return fmt.Sprintf("schema %q is incompatible: %d %s compatibility issues, %d configurations don't match", e.Type, len(e.Issues), e.Compatibility, len(e.Configs))
+}
+
+// Is makes errors.Is(err, ErrIncompatibleSchema) hold for every compatibility report
+func (e *SchemaCompatibilityError) Is(target error) bool {
+	return target == ErrIncompatibleSchema
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "fmt"
+
+// Violation is a single reason why a value doesn't match its schema
+type Violation struct {
+	Pointer string `json:"pointer"` // JSON pointer to the offending field of the value, empty for the value itself
+	Keyword string `json:"keyword"` // JSON schema keyword that failed, e.g. required or minimum
+	Message string `json:"message"`
+}
+
+// ValidationError reports every violation found while validating a value against its schema
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: value has %d violations", ErrInvalidSchema, len(e.Violations))
+}
+
+// Is makes errors.Is(err, ErrInvalidSchema) hold for every validation error
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidSchema
+}
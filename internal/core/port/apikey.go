@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+type ApiKeyRepository interface {
+	// CreateApiKey stores a new API key. It fails with domain.ErrConflictingData if the ID is taken
+	CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, error)
+	GetApiKey(ctx context.Context, id string) (*domain.ApiKey, error)
+	// ListApiKeys lists the API keys, revoked ones included, oldest first
+	ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error)
+	// RotateApiKey replaces the hash of the secret of an API key. It fails with domain.ErrApiKeyRevoked if the key is revoked
+	RotateApiKey(ctx context.Context, id, hash string) (*domain.ApiKey, error)
+	// RevokeApiKey marks an API key as revoked. It fails with domain.ErrApiKeyRevoked if the key is already revoked
+	RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error)
+}
@@ -0,0 +1,11 @@
+package port
+
+import (
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+type TokenService interface {
+	// VerifyToken checks the signature and claims of a bearer token, returning the caller it was issued to.
+	// It fails with domain.ErrExpiredToken for an expired token and domain.ErrInvalidToken for any other bad token
+	VerifyToken(token string) (*domain.Principal, error)
+}
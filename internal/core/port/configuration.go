@@ -6,11 +6,40 @@ import (
 	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
 )
 
+// ConfigurationRepository stores the versions of configurations. A name identifies a configuration within its namespace,
+// PutConfiguration writes to the namespace of the config
 type ConfigurationRepository interface {
-	PutConfiguration(ctx context.Context, config *domain.Config) (*domain.Config, error)
-	GetConfiguration(ctx context.Context, name string) (*domain.Config, error)
-	ListConfigurations(ctx context.Context, skip, limit uint64) ([]*domain.Config, error)
-	ListConfigurationVersions(ctx context.Context, name string, skip, limit uint64) ([]*domain.Config, error)
-	GetConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error)
-	RollbackConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error)
+	// PutConfiguration fails with domain.ErrVersionConflict if expectedVersion is not zero and isn't the latest version
+	PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error)
+	GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error)
+	// ListConfigurations lists a page of the latest version of the configurations of a namespace, or of every namespace
+	// if it is empty, that pass the query, sorted as domain.CompareConfigs orders them. It also returns how many
+	// configurations pass the query
+	ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64, error)
+	// ListConfigurationVersions pages through the versions that pass the filter, sorted like ListConfigurations, and
+	// returns how many versions pass it. It fails with domain.ErrDataNotFound only if the configuration has no versions
+	// at all
+	ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) ([]*domain.Config, uint64, error)
+	GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error)
+	// RollbackConfigurationVersion copies a version as the new latest version, recorded as written by the change
+	RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int, change domain.Change) (*domain.Config, error)
+	// DeleteConfiguration appends a tombstone version, after which the configuration is left out of
+	// GetConfiguration and ListConfigurations but its history is kept
+	DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int, change domain.Change) (*domain.Config, error)
+	// RestoreConfiguration copies the version before the tombstone as the new latest version
+	RestoreConfiguration(ctx context.Context, namespace, name string, change domain.Change) (*domain.Config, error)
+	// PurgeConfiguration permanently erases every version of a configuration, and its aliases
+	PurgeConfiguration(ctx context.Context, namespace, name string) error
+
+	// MoveAlias points an alias of a configuration at a version, or clears it for version 0, recording the move as the
+	// next revision of the alias. It fails with domain.ErrAliasConflict if expectedRevision is not zero and isn't the
+	// latest revision
+	MoveAlias(ctx context.Context, alias *domain.Alias, expectedRevision int) (*domain.Alias, error)
+	// GetAlias returns the latest revision of an alias, which is cleared if it points at no version. It fails with
+	// domain.ErrDataNotFound if the alias has never been moved
+	GetAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, error)
+	// ListAliases lists the latest revision of the aliases of a configuration that point at a version, ordered by alias
+	ListAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error)
+	// ListAliasRevisions lists a page of the revisions of an alias, oldest first
+	ListAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error)
 }
@@ -38,9 +38,175 @@ func (_m *MockConfigurationRepository) EXPECT() *MockConfigurationRepository_Exp
 	return &MockConfigurationRepository_Expecter{mock: &_m.Mock}
 }
 
+// DeleteConfiguration provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) DeleteConfiguration(ctx context.Context, namespace string, name string, expectedVersion int, change domain.Change) (*domain.Config, error) {
+	ret := _mock.Called(ctx, namespace, name, expectedVersion, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConfiguration")
+	}
+
+	var r0 *domain.Config
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, domain.Change) (*domain.Config, error)); ok {
+		return returnFunc(ctx, namespace, name, expectedVersion, change)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, domain.Change) *domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, name, expectedVersion, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Config)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int, domain.Change) error); ok {
+		r1 = returnFunc(ctx, namespace, name, expectedVersion, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigurationRepository_DeleteConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConfiguration'
+type MockConfigurationRepository_DeleteConfiguration_Call struct {
+	*mock.Call
+}
+
+// DeleteConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+//   - expectedVersion int
+//   - change domain.Change
+func (_e *MockConfigurationRepository_Expecter) DeleteConfiguration(ctx interface{}, namespace interface{}, name interface{}, expectedVersion interface{}, change interface{}) *MockConfigurationRepository_DeleteConfiguration_Call {
+	return &MockConfigurationRepository_DeleteConfiguration_Call{Call: _e.mock.On("DeleteConfiguration", ctx, namespace, name, expectedVersion, change)}
+}
+
+func (_c *MockConfigurationRepository_DeleteConfiguration_Call) Run(run func(ctx context.Context, namespace string, name string, expectedVersion int, change domain.Change)) *MockConfigurationRepository_DeleteConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 domain.Change
+		if args[4] != nil {
+			arg4 = args[4].(domain.Change)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_DeleteConfiguration_Call) Return(config *domain.Config, err error) *MockConfigurationRepository_DeleteConfiguration_Call {
+	_c.Call.Return(config, err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_DeleteConfiguration_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, expectedVersion int, change domain.Change) (*domain.Config, error)) *MockConfigurationRepository_DeleteConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlias provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) GetAlias(ctx context.Context, namespace string, name string, alias string) (*domain.Alias, error) {
+	ret := _mock.Called(ctx, namespace, name, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlias")
+	}
+
+	var r0 *domain.Alias
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.Alias, error)); ok {
+		return returnFunc(ctx, namespace, name, alias)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.Alias); ok {
+		r0 = returnFunc(ctx, namespace, name, alias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Alias)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, namespace, name, alias)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigurationRepository_GetAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlias'
+type MockConfigurationRepository_GetAlias_Call struct {
+	*mock.Call
+}
+
+// GetAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+//   - alias string
+func (_e *MockConfigurationRepository_Expecter) GetAlias(ctx interface{}, namespace interface{}, name interface{}, alias interface{}) *MockConfigurationRepository_GetAlias_Call {
+	return &MockConfigurationRepository_GetAlias_Call{Call: _e.mock.On("GetAlias", ctx, namespace, name, alias)}
+}
+
+func (_c *MockConfigurationRepository_GetAlias_Call) Run(run func(ctx context.Context, namespace string, name string, alias string)) *MockConfigurationRepository_GetAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_GetAlias_Call) Return(alias1 *domain.Alias, err error) *MockConfigurationRepository_GetAlias_Call {
+	_c.Call.Return(alias1, err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_GetAlias_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, alias string) (*domain.Alias, error)) *MockConfigurationRepository_GetAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConfiguration provides a mock function for the type MockConfigurationRepository
-func (_mock *MockConfigurationRepository) GetConfiguration(ctx context.Context, name string) (*domain.Config, error) {
-	ret := _mock.Called(ctx, name)
+func (_mock *MockConfigurationRepository) GetConfiguration(ctx context.Context, namespace string, name string) (*domain.Config, error) {
+	ret := _mock.Called(ctx, namespace, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConfiguration")
@@ -48,18 +214,18 @@ func (_mock *MockConfigurationRepository) GetConfiguration(ctx context.Context,
 
 	var r0 *domain.Config
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Config, error)); ok {
-		return returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Config, error)); ok {
+		return returnFunc(ctx, namespace, name)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Config); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, namespace, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,12 +239,13 @@ type MockConfigurationRepository_GetConfiguration_Call struct {
 
 // GetConfiguration is a helper method to define mock.On call
 //   - ctx context.Context
+//   - namespace string
 //   - name string
-func (_e *MockConfigurationRepository_Expecter) GetConfiguration(ctx interface{}, name interface{}) *MockConfigurationRepository_GetConfiguration_Call {
-	return &MockConfigurationRepository_GetConfiguration_Call{Call: _e.mock.On("GetConfiguration", ctx, name)}
+func (_e *MockConfigurationRepository_Expecter) GetConfiguration(ctx interface{}, namespace interface{}, name interface{}) *MockConfigurationRepository_GetConfiguration_Call {
+	return &MockConfigurationRepository_GetConfiguration_Call{Call: _e.mock.On("GetConfiguration", ctx, namespace, name)}
 }
 
-func (_c *MockConfigurationRepository_GetConfiguration_Call) Run(run func(ctx context.Context, name string)) *MockConfigurationRepository_GetConfiguration_Call {
+func (_c *MockConfigurationRepository_GetConfiguration_Call) Run(run func(ctx context.Context, namespace string, name string)) *MockConfigurationRepository_GetConfiguration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,9 +255,14 @@ func (_c *MockConfigurationRepository_GetConfiguration_Call) Run(run func(ctx co
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -101,14 +273,14 @@ func (_c *MockConfigurationRepository_GetConfiguration_Call) Return(config *doma
 	return _c
 }
 
-func (_c *MockConfigurationRepository_GetConfiguration_Call) RunAndReturn(run func(ctx context.Context, name string) (*domain.Config, error)) *MockConfigurationRepository_GetConfiguration_Call {
+func (_c *MockConfigurationRepository_GetConfiguration_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string) (*domain.Config, error)) *MockConfigurationRepository_GetConfiguration_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetConfigurationVersion provides a mock function for the type MockConfigurationRepository
-func (_mock *MockConfigurationRepository) GetConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error) {
-	ret := _mock.Called(ctx, name, version)
+func (_mock *MockConfigurationRepository) GetConfigurationVersion(ctx context.Context, namespace string, name string, version int) (*domain.Config, error) {
+	ret := _mock.Called(ctx, namespace, name, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConfigurationVersion")
@@ -116,18 +288,18 @@ func (_mock *MockConfigurationRepository) GetConfigurationVersion(ctx context.Co
 
 	var r0 *domain.Config
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*domain.Config, error)); ok {
-		return returnFunc(ctx, name, version)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) (*domain.Config, error)); ok {
+		return returnFunc(ctx, namespace, name, version)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *domain.Config); ok {
-		r0 = returnFunc(ctx, name, version)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) *domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, name, version)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
-		r1 = returnFunc(ctx, name, version)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = returnFunc(ctx, namespace, name, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -141,13 +313,14 @@ type MockConfigurationRepository_GetConfigurationVersion_Call struct {
 
 // GetConfigurationVersion is a helper method to define mock.On call
 //   - ctx context.Context
+//   - namespace string
 //   - name string
 //   - version int
-func (_e *MockConfigurationRepository_Expecter) GetConfigurationVersion(ctx interface{}, name interface{}, version interface{}) *MockConfigurationRepository_GetConfigurationVersion_Call {
-	return &MockConfigurationRepository_GetConfigurationVersion_Call{Call: _e.mock.On("GetConfigurationVersion", ctx, name, version)}
+func (_e *MockConfigurationRepository_Expecter) GetConfigurationVersion(ctx interface{}, namespace interface{}, name interface{}, version interface{}) *MockConfigurationRepository_GetConfigurationVersion_Call {
+	return &MockConfigurationRepository_GetConfigurationVersion_Call{Call: _e.mock.On("GetConfigurationVersion", ctx, namespace, name, version)}
 }
 
-func (_c *MockConfigurationRepository_GetConfigurationVersion_Call) Run(run func(ctx context.Context, name string, version int)) *MockConfigurationRepository_GetConfigurationVersion_Call {
+func (_c *MockConfigurationRepository_GetConfigurationVersion_Call) Run(run func(ctx context.Context, namespace string, name string, version int)) *MockConfigurationRepository_GetConfigurationVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -157,14 +330,19 @@ func (_c *MockConfigurationRepository_GetConfigurationVersion_Call) Run(run func
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -175,37 +353,209 @@ func (_c *MockConfigurationRepository_GetConfigurationVersion_Call) Return(confi
 	return _c
 }
 
-func (_c *MockConfigurationRepository_GetConfigurationVersion_Call) RunAndReturn(run func(ctx context.Context, name string, version int) (*domain.Config, error)) *MockConfigurationRepository_GetConfigurationVersion_Call {
+func (_c *MockConfigurationRepository_GetConfigurationVersion_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, version int) (*domain.Config, error)) *MockConfigurationRepository_GetConfigurationVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAliasRevisions provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) ListAliasRevisions(ctx context.Context, namespace string, name string, alias string, skip uint64, limit uint64) ([]*domain.Alias, error) {
+	ret := _mock.Called(ctx, namespace, name, alias, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAliasRevisions")
+	}
+
+	var r0 []*domain.Alias
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, uint64) ([]*domain.Alias, error)); ok {
+		return returnFunc(ctx, namespace, name, alias, skip, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, uint64) []*domain.Alias); ok {
+		r0 = returnFunc(ctx, namespace, name, alias, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Alias)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, uint64, uint64) error); ok {
+		r1 = returnFunc(ctx, namespace, name, alias, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigurationRepository_ListAliasRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAliasRevisions'
+type MockConfigurationRepository_ListAliasRevisions_Call struct {
+	*mock.Call
+}
+
+// ListAliasRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+//   - alias string
+//   - skip uint64
+//   - limit uint64
+func (_e *MockConfigurationRepository_Expecter) ListAliasRevisions(ctx interface{}, namespace interface{}, name interface{}, alias interface{}, skip interface{}, limit interface{}) *MockConfigurationRepository_ListAliasRevisions_Call {
+	return &MockConfigurationRepository_ListAliasRevisions_Call{Call: _e.mock.On("ListAliasRevisions", ctx, namespace, name, alias, skip, limit)}
+}
+
+func (_c *MockConfigurationRepository_ListAliasRevisions_Call) Run(run func(ctx context.Context, namespace string, name string, alias string, skip uint64, limit uint64)) *MockConfigurationRepository_ListAliasRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 uint64
+		if args[4] != nil {
+			arg4 = args[4].(uint64)
+		}
+		var arg5 uint64
+		if args[5] != nil {
+			arg5 = args[5].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_ListAliasRevisions_Call) Return(aliass []*domain.Alias, err error) *MockConfigurationRepository_ListAliasRevisions_Call {
+	_c.Call.Return(aliass, err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_ListAliasRevisions_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, alias string, skip uint64, limit uint64) ([]*domain.Alias, error)) *MockConfigurationRepository_ListAliasRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAliases provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) ListAliases(ctx context.Context, namespace string, name string) ([]*domain.Alias, error) {
+	ret := _mock.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAliases")
+	}
+
+	var r0 []*domain.Alias
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]*domain.Alias, error)); ok {
+		return returnFunc(ctx, namespace, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []*domain.Alias); ok {
+		r0 = returnFunc(ctx, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Alias)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigurationRepository_ListAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAliases'
+type MockConfigurationRepository_ListAliases_Call struct {
+	*mock.Call
+}
+
+// ListAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+func (_e *MockConfigurationRepository_Expecter) ListAliases(ctx interface{}, namespace interface{}, name interface{}) *MockConfigurationRepository_ListAliases_Call {
+	return &MockConfigurationRepository_ListAliases_Call{Call: _e.mock.On("ListAliases", ctx, namespace, name)}
+}
+
+func (_c *MockConfigurationRepository_ListAliases_Call) Run(run func(ctx context.Context, namespace string, name string)) *MockConfigurationRepository_ListAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_ListAliases_Call) Return(aliass []*domain.Alias, err error) *MockConfigurationRepository_ListAliases_Call {
+	_c.Call.Return(aliass, err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_ListAliases_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string) ([]*domain.Alias, error)) *MockConfigurationRepository_ListAliases_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListConfigurationVersions provides a mock function for the type MockConfigurationRepository
-func (_mock *MockConfigurationRepository) ListConfigurationVersions(ctx context.Context, name string, skip uint64, limit uint64) ([]*domain.Config, error) {
-	ret := _mock.Called(ctx, name, skip, limit)
+func (_mock *MockConfigurationRepository) ListConfigurationVersions(ctx context.Context, namespace string, name string, filter domain.VersionFilter, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	ret := _mock.Called(ctx, namespace, name, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListConfigurationVersions")
 	}
 
 	var r0 []*domain.Config
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) ([]*domain.Config, error)); ok {
-		return returnFunc(ctx, name, skip, limit)
+	var r1 uint64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.VersionFilter, domain.PageRequest) ([]*domain.Config, uint64, error)); ok {
+		return returnFunc(ctx, namespace, name, filter, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) []*domain.Config); ok {
-		r0 = returnFunc(ctx, name, skip, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.VersionFilter, domain.PageRequest) []*domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, name, filter, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
-		r1 = returnFunc(ctx, name, skip, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.VersionFilter, domain.PageRequest) uint64); ok {
+		r1 = returnFunc(ctx, namespace, name, filter, page)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(uint64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, domain.VersionFilter, domain.PageRequest) error); ok {
+		r2 = returnFunc(ctx, namespace, name, filter, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockConfigurationRepository_ListConfigurationVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfigurationVersions'
@@ -215,14 +565,15 @@ type MockConfigurationRepository_ListConfigurationVersions_Call struct {
 
 // ListConfigurationVersions is a helper method to define mock.On call
 //   - ctx context.Context
+//   - namespace string
 //   - name string
-//   - skip uint64
-//   - limit uint64
-func (_e *MockConfigurationRepository_Expecter) ListConfigurationVersions(ctx interface{}, name interface{}, skip interface{}, limit interface{}) *MockConfigurationRepository_ListConfigurationVersions_Call {
-	return &MockConfigurationRepository_ListConfigurationVersions_Call{Call: _e.mock.On("ListConfigurationVersions", ctx, name, skip, limit)}
+//   - filter domain.VersionFilter
+//   - page domain.PageRequest
+func (_e *MockConfigurationRepository_Expecter) ListConfigurationVersions(ctx interface{}, namespace interface{}, name interface{}, filter interface{}, page interface{}) *MockConfigurationRepository_ListConfigurationVersions_Call {
+	return &MockConfigurationRepository_ListConfigurationVersions_Call{Call: _e.mock.On("ListConfigurationVersions", ctx, namespace, name, filter, page)}
 }
 
-func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) Run(run func(ctx context.Context, name string, skip uint64, limit uint64)) *MockConfigurationRepository_ListConfigurationVersions_Call {
+func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) Run(run func(ctx context.Context, namespace string, name string, filter domain.VersionFilter, page domain.PageRequest)) *MockConfigurationRepository_ListConfigurationVersions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -232,60 +583,71 @@ func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) Run(run fu
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 uint64
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(uint64)
+			arg2 = args[2].(string)
 		}
-		var arg3 uint64
+		var arg3 domain.VersionFilter
 		if args[3] != nil {
-			arg3 = args[3].(uint64)
+			arg3 = args[3].(domain.VersionFilter)
+		}
+		var arg4 domain.PageRequest
+		if args[4] != nil {
+			arg4 = args[4].(domain.PageRequest)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) Return(configs []*domain.Config, err error) *MockConfigurationRepository_ListConfigurationVersions_Call {
-	_c.Call.Return(configs, err)
+func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) Return(configs []*domain.Config, v uint64, err error) *MockConfigurationRepository_ListConfigurationVersions_Call {
+	_c.Call.Return(configs, v, err)
 	return _c
 }
 
-func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) RunAndReturn(run func(ctx context.Context, name string, skip uint64, limit uint64) ([]*domain.Config, error)) *MockConfigurationRepository_ListConfigurationVersions_Call {
+func (_c *MockConfigurationRepository_ListConfigurationVersions_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, filter domain.VersionFilter, page domain.PageRequest) ([]*domain.Config, uint64, error)) *MockConfigurationRepository_ListConfigurationVersions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListConfigurations provides a mock function for the type MockConfigurationRepository
-func (_mock *MockConfigurationRepository) ListConfigurations(ctx context.Context, skip uint64, limit uint64) ([]*domain.Config, error) {
-	ret := _mock.Called(ctx, skip, limit)
+func (_mock *MockConfigurationRepository) ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64, error) {
+	ret := _mock.Called(ctx, namespace, query, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListConfigurations")
 	}
 
 	var r0 []*domain.Config
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]*domain.Config, error)); ok {
-		return returnFunc(ctx, skip, limit)
+	var r1 uint64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.ConfigQuery, domain.PageRequest) ([]*domain.Config, uint64, error)); ok {
+		return returnFunc(ctx, namespace, query, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*domain.Config); ok {
-		r0 = returnFunc(ctx, skip, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.ConfigQuery, domain.PageRequest) []*domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, query, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
-		r1 = returnFunc(ctx, skip, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.ConfigQuery, domain.PageRequest) uint64); ok {
+		r1 = returnFunc(ctx, namespace, query, page)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(uint64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, domain.ConfigQuery, domain.PageRequest) error); ok {
+		r2 = returnFunc(ctx, namespace, query, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockConfigurationRepository_ListConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfigurations'
@@ -295,48 +657,191 @@ type MockConfigurationRepository_ListConfigurations_Call struct {
 
 // ListConfigurations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - skip uint64
-//   - limit uint64
-func (_e *MockConfigurationRepository_Expecter) ListConfigurations(ctx interface{}, skip interface{}, limit interface{}) *MockConfigurationRepository_ListConfigurations_Call {
-	return &MockConfigurationRepository_ListConfigurations_Call{Call: _e.mock.On("ListConfigurations", ctx, skip, limit)}
+//   - namespace string
+//   - query domain.ConfigQuery
+//   - page domain.PageRequest
+func (_e *MockConfigurationRepository_Expecter) ListConfigurations(ctx interface{}, namespace interface{}, query interface{}, page interface{}) *MockConfigurationRepository_ListConfigurations_Call {
+	return &MockConfigurationRepository_ListConfigurations_Call{Call: _e.mock.On("ListConfigurations", ctx, namespace, query, page)}
 }
 
-func (_c *MockConfigurationRepository_ListConfigurations_Call) Run(run func(ctx context.Context, skip uint64, limit uint64)) *MockConfigurationRepository_ListConfigurations_Call {
+func (_c *MockConfigurationRepository_ListConfigurations_Call) Run(run func(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest)) *MockConfigurationRepository_ListConfigurations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uint64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(uint64)
+			arg1 = args[1].(string)
 		}
-		var arg2 uint64
+		var arg2 domain.ConfigQuery
 		if args[2] != nil {
-			arg2 = args[2].(uint64)
+			arg2 = args[2].(domain.ConfigQuery)
+		}
+		var arg3 domain.PageRequest
+		if args[3] != nil {
+			arg3 = args[3].(domain.PageRequest)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConfigurationRepository_ListConfigurations_Call) Return(configs []*domain.Config, err error) *MockConfigurationRepository_ListConfigurations_Call {
-	_c.Call.Return(configs, err)
+func (_c *MockConfigurationRepository_ListConfigurations_Call) Return(configs []*domain.Config, v uint64, err error) *MockConfigurationRepository_ListConfigurations_Call {
+	_c.Call.Return(configs, v, err)
 	return _c
 }
 
-func (_c *MockConfigurationRepository_ListConfigurations_Call) RunAndReturn(run func(ctx context.Context, skip uint64, limit uint64) ([]*domain.Config, error)) *MockConfigurationRepository_ListConfigurations_Call {
+func (_c *MockConfigurationRepository_ListConfigurations_Call) RunAndReturn(run func(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) ([]*domain.Config, uint64, error)) *MockConfigurationRepository_ListConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveAlias provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) MoveAlias(ctx context.Context, alias *domain.Alias, expectedRevision int) (*domain.Alias, error) {
+	ret := _mock.Called(ctx, alias, expectedRevision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveAlias")
+	}
+
+	var r0 *domain.Alias
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Alias, int) (*domain.Alias, error)); ok {
+		return returnFunc(ctx, alias, expectedRevision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Alias, int) *domain.Alias); ok {
+		r0 = returnFunc(ctx, alias, expectedRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Alias)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Alias, int) error); ok {
+		r1 = returnFunc(ctx, alias, expectedRevision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigurationRepository_MoveAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveAlias'
+type MockConfigurationRepository_MoveAlias_Call struct {
+	*mock.Call
+}
+
+// MoveAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alias *domain.Alias
+//   - expectedRevision int
+func (_e *MockConfigurationRepository_Expecter) MoveAlias(ctx interface{}, alias interface{}, expectedRevision interface{}) *MockConfigurationRepository_MoveAlias_Call {
+	return &MockConfigurationRepository_MoveAlias_Call{Call: _e.mock.On("MoveAlias", ctx, alias, expectedRevision)}
+}
+
+func (_c *MockConfigurationRepository_MoveAlias_Call) Run(run func(ctx context.Context, alias *domain.Alias, expectedRevision int)) *MockConfigurationRepository_MoveAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Alias
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Alias)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_MoveAlias_Call) Return(alias1 *domain.Alias, err error) *MockConfigurationRepository_MoveAlias_Call {
+	_c.Call.Return(alias1, err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_MoveAlias_Call) RunAndReturn(run func(ctx context.Context, alias *domain.Alias, expectedRevision int) (*domain.Alias, error)) *MockConfigurationRepository_MoveAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeConfiguration provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) PurgeConfiguration(ctx context.Context, namespace string, name string) error {
+	ret := _mock.Called(ctx, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeConfiguration")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfigurationRepository_PurgeConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeConfiguration'
+type MockConfigurationRepository_PurgeConfiguration_Call struct {
+	*mock.Call
+}
+
+// PurgeConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+func (_e *MockConfigurationRepository_Expecter) PurgeConfiguration(ctx interface{}, namespace interface{}, name interface{}) *MockConfigurationRepository_PurgeConfiguration_Call {
+	return &MockConfigurationRepository_PurgeConfiguration_Call{Call: _e.mock.On("PurgeConfiguration", ctx, namespace, name)}
+}
+
+func (_c *MockConfigurationRepository_PurgeConfiguration_Call) Run(run func(ctx context.Context, namespace string, name string)) *MockConfigurationRepository_PurgeConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_PurgeConfiguration_Call) Return(err error) *MockConfigurationRepository_PurgeConfiguration_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_PurgeConfiguration_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string) error) *MockConfigurationRepository_PurgeConfiguration_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // PutConfiguration provides a mock function for the type MockConfigurationRepository
-func (_mock *MockConfigurationRepository) PutConfiguration(ctx context.Context, config *domain.Config) (*domain.Config, error) {
-	ret := _mock.Called(ctx, config)
+func (_mock *MockConfigurationRepository) PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	ret := _mock.Called(ctx, config, expectedVersion)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutConfiguration")
@@ -344,18 +849,18 @@ func (_mock *MockConfigurationRepository) PutConfiguration(ctx context.Context,
 
 	var r0 *domain.Config
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Config) (*domain.Config, error)); ok {
-		return returnFunc(ctx, config)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Config, int) (*domain.Config, error)); ok {
+		return returnFunc(ctx, config, expectedVersion)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Config) *domain.Config); ok {
-		r0 = returnFunc(ctx, config)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Config, int) *domain.Config); ok {
+		r0 = returnFunc(ctx, config, expectedVersion)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Config) error); ok {
-		r1 = returnFunc(ctx, config)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Config, int) error); ok {
+		r1 = returnFunc(ctx, config, expectedVersion)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -370,11 +875,12 @@ type MockConfigurationRepository_PutConfiguration_Call struct {
 // PutConfiguration is a helper method to define mock.On call
 //   - ctx context.Context
 //   - config *domain.Config
-func (_e *MockConfigurationRepository_Expecter) PutConfiguration(ctx interface{}, config interface{}) *MockConfigurationRepository_PutConfiguration_Call {
-	return &MockConfigurationRepository_PutConfiguration_Call{Call: _e.mock.On("PutConfiguration", ctx, config)}
+//   - expectedVersion int
+func (_e *MockConfigurationRepository_Expecter) PutConfiguration(ctx interface{}, config interface{}, expectedVersion interface{}) *MockConfigurationRepository_PutConfiguration_Call {
+	return &MockConfigurationRepository_PutConfiguration_Call{Call: _e.mock.On("PutConfiguration", ctx, config, expectedVersion)}
 }
 
-func (_c *MockConfigurationRepository_PutConfiguration_Call) Run(run func(ctx context.Context, config *domain.Config)) *MockConfigurationRepository_PutConfiguration_Call {
+func (_c *MockConfigurationRepository_PutConfiguration_Call) Run(run func(ctx context.Context, config *domain.Config, expectedVersion int)) *MockConfigurationRepository_PutConfiguration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -384,9 +890,14 @@ func (_c *MockConfigurationRepository_PutConfiguration_Call) Run(run func(ctx co
 		if args[1] != nil {
 			arg1 = args[1].(*domain.Config)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -397,14 +908,94 @@ func (_c *MockConfigurationRepository_PutConfiguration_Call) Return(config1 *dom
 	return _c
 }
 
-func (_c *MockConfigurationRepository_PutConfiguration_Call) RunAndReturn(run func(ctx context.Context, config *domain.Config) (*domain.Config, error)) *MockConfigurationRepository_PutConfiguration_Call {
+func (_c *MockConfigurationRepository_PutConfiguration_Call) RunAndReturn(run func(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error)) *MockConfigurationRepository_PutConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreConfiguration provides a mock function for the type MockConfigurationRepository
+func (_mock *MockConfigurationRepository) RestoreConfiguration(ctx context.Context, namespace string, name string, change domain.Change) (*domain.Config, error) {
+	ret := _mock.Called(ctx, namespace, name, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreConfiguration")
+	}
+
+	var r0 *domain.Config
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Change) (*domain.Config, error)); ok {
+		return returnFunc(ctx, namespace, name, change)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Change) *domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, name, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Config)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Change) error); ok {
+		r1 = returnFunc(ctx, namespace, name, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigurationRepository_RestoreConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreConfiguration'
+type MockConfigurationRepository_RestoreConfiguration_Call struct {
+	*mock.Call
+}
+
+// RestoreConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - name string
+//   - change domain.Change
+func (_e *MockConfigurationRepository_Expecter) RestoreConfiguration(ctx interface{}, namespace interface{}, name interface{}, change interface{}) *MockConfigurationRepository_RestoreConfiguration_Call {
+	return &MockConfigurationRepository_RestoreConfiguration_Call{Call: _e.mock.On("RestoreConfiguration", ctx, namespace, name, change)}
+}
+
+func (_c *MockConfigurationRepository_RestoreConfiguration_Call) Run(run func(ctx context.Context, namespace string, name string, change domain.Change)) *MockConfigurationRepository_RestoreConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.Change
+		if args[3] != nil {
+			arg3 = args[3].(domain.Change)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigurationRepository_RestoreConfiguration_Call) Return(config *domain.Config, err error) *MockConfigurationRepository_RestoreConfiguration_Call {
+	_c.Call.Return(config, err)
+	return _c
+}
+
+func (_c *MockConfigurationRepository_RestoreConfiguration_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, change domain.Change) (*domain.Config, error)) *MockConfigurationRepository_RestoreConfiguration_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // RollbackConfigurationVersion provides a mock function for the type MockConfigurationRepository
-func (_mock *MockConfigurationRepository) RollbackConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error) {
-	ret := _mock.Called(ctx, name, version)
+func (_mock *MockConfigurationRepository) RollbackConfigurationVersion(ctx context.Context, namespace string, name string, version int, change domain.Change) (*domain.Config, error) {
+	ret := _mock.Called(ctx, namespace, name, version, change)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RollbackConfigurationVersion")
@@ -412,18 +1003,18 @@ func (_mock *MockConfigurationRepository) RollbackConfigurationVersion(ctx conte
 
 	var r0 *domain.Config
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*domain.Config, error)); ok {
-		return returnFunc(ctx, name, version)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, domain.Change) (*domain.Config, error)); ok {
+		return returnFunc(ctx, namespace, name, version, change)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *domain.Config); ok {
-		r0 = returnFunc(ctx, name, version)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int, domain.Change) *domain.Config); ok {
+		r0 = returnFunc(ctx, namespace, name, version, change)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
-		r1 = returnFunc(ctx, name, version)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int, domain.Change) error); ok {
+		r1 = returnFunc(ctx, namespace, name, version, change)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -437,13 +1028,15 @@ type MockConfigurationRepository_RollbackConfigurationVersion_Call struct {
 
 // RollbackConfigurationVersion is a helper method to define mock.On call
 //   - ctx context.Context
+//   - namespace string
 //   - name string
 //   - version int
-func (_e *MockConfigurationRepository_Expecter) RollbackConfigurationVersion(ctx interface{}, name interface{}, version interface{}) *MockConfigurationRepository_RollbackConfigurationVersion_Call {
-	return &MockConfigurationRepository_RollbackConfigurationVersion_Call{Call: _e.mock.On("RollbackConfigurationVersion", ctx, name, version)}
+//   - change domain.Change
+func (_e *MockConfigurationRepository_Expecter) RollbackConfigurationVersion(ctx interface{}, namespace interface{}, name interface{}, version interface{}, change interface{}) *MockConfigurationRepository_RollbackConfigurationVersion_Call {
+	return &MockConfigurationRepository_RollbackConfigurationVersion_Call{Call: _e.mock.On("RollbackConfigurationVersion", ctx, namespace, name, version, change)}
 }
 
-func (_c *MockConfigurationRepository_RollbackConfigurationVersion_Call) Run(run func(ctx context.Context, name string, version int)) *MockConfigurationRepository_RollbackConfigurationVersion_Call {
+func (_c *MockConfigurationRepository_RollbackConfigurationVersion_Call) Run(run func(ctx context.Context, namespace string, name string, version int, change domain.Change)) *MockConfigurationRepository_RollbackConfigurationVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -453,14 +1046,24 @@ func (_c *MockConfigurationRepository_RollbackConfigurationVersion_Call) Run(run
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 domain.Change
+		if args[4] != nil {
+			arg4 = args[4].(domain.Change)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -471,7 +1074,7 @@ func (_c *MockConfigurationRepository_RollbackConfigurationVersion_Call) Return(
 	return _c
 }
 
-func (_c *MockConfigurationRepository_RollbackConfigurationVersion_Call) RunAndReturn(run func(ctx context.Context, name string, version int) (*domain.Config, error)) *MockConfigurationRepository_RollbackConfigurationVersion_Call {
+func (_c *MockConfigurationRepository_RollbackConfigurationVersion_Call) RunAndReturn(run func(ctx context.Context, namespace string, name string, version int, change domain.Change) (*domain.Config, error)) *MockConfigurationRepository_RollbackConfigurationVersion_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// NamespaceRepository stores namespaces. A new repository holds the default namespace
+type NamespaceRepository interface {
+	// CreateNamespace stores a new namespace. It fails with domain.ErrConflictingData if the name is taken
+	CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error)
+	GetNamespace(ctx context.Context, name string) (*domain.Namespace, error)
+	// ListNamespaces lists the namespaces ordered by name
+	ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error)
+	DeleteNamespace(ctx context.Context, name string) error
+}
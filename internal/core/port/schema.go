@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+type SchemaRepository interface {
+	// PutSchema appends a new version to the schema of a type, registering the type if it doesn't exist.
+	// It fails with domain.ErrVersionConflict if expectedVersion is not zero and isn't the latest version
+	PutSchema(ctx context.Context, schema *domain.Schema, expectedVersion int) (*domain.Schema, error)
+	GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error)
+	ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error)
+	ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error)
+	GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error)
+	// DeleteSchema deletes every version of the schema of a type
+	DeleteSchema(ctx context.Context, schemaType string) error
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+)
+
+// apiKeyPrefix starts every API key, so that leaked keys are easy to recognize
+const apiKeyPrefix = "cms_"
+
+// bootstrapApiKeyID identifies the administrator API key created on startup. Generated IDs are hex, so they never
+// collide with it
+const bootstrapApiKeyID = "bootstrap"
+
+type ApiKeyServicer interface {
+	// CreateApiKey creates an API key, returning it together with the key to hand out, which is never shown again
+	CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, string, error)
+	ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error)
+	// RotateApiKey replaces the secret of an API key, returning the new key to hand out. The old key stops working
+	RotateApiKey(ctx context.Context, id string) (*domain.ApiKey, string, error)
+	RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error)
+	// BootstrapApiKey makes sure an API key with every scope, the ID "bootstrap" and the given hash of its secret
+	// exists, so that a service that only accepts API keys can be administered from the start. The key to hand out is
+	// cms_bootstrap_<secret>. A changed hash rotates the key, a revoked one is left revoked
+	BootstrapApiKey(ctx context.Context, hash string) (*domain.ApiKey, error)
+	// VerifyApiKey returns the caller an API key was issued to. It fails with domain.ErrUnauthorized for an unknown,
+	// revoked or expired key
+	VerifyApiKey(ctx context.Context, key string) (*domain.Principal, error)
+}
+
+type apiKeyService struct {
+	repo port.ApiKeyRepository
+}
+
+func NewApiKeyService(repo port.ApiKeyRepository) ApiKeyServicer {
+	return &apiKeyService{
+		repo,
+	}
+}
+
+// randomHex returns n random bytes, hex encoded
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// hashSecret returns the hex encoded SHA-256 of the secret of an API key.
+// Secrets are random, so a fast hash is as good as a password hash
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// newSecret generates the secret of an API key, returning the key to hand out and the hash to store
+func newSecret(id string) (string, string, error) {
+	secret, err := randomHex(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	return apiKeyPrefix + id + "_" + secret, hashSecret(secret), nil
+}
+
+func (s *apiKeyService) CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, string, error) {
+	if key.Name == "" || len(key.Scopes) == 0 {
+		return nil, "", domain.ErrInvalidApiKey
+	}
+	for _, scope := range key.Scopes {
+		if !slices.Contains(domain.ApiKeyScopes, scope) {
+			return nil, "", domain.ErrInvalidApiKey
+		}
+	}
+	if key.Expired(time.Now()) {
+		return nil, "", domain.ErrInvalidApiKey
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return nil, "", err
+	}
+
+	plaintext, hash, err := newSecret(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	newKey := &domain.ApiKey{
+		ID:        id,
+		Name:      key.Name,
+		Hash:      hash,
+		Scopes:    key.Scopes,
+		ExpiresAt: key.ExpiresAt,
+	}
+
+	created, err := s.repo.CreateApiKey(ctx, newKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return created, plaintext, nil
+}
+
+func (s *apiKeyService) ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error) {
+	return s.repo.ListApiKeys(ctx, skip, limit)
+}
+
+func (s *apiKeyService) RotateApiKey(ctx context.Context, id string) (*domain.ApiKey, string, error) {
+	plaintext, hash, err := newSecret(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rotated, err := s.repo.RotateApiKey(ctx, id, hash)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rotated, plaintext, nil
+}
+
+func (s *apiKeyService) RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	return s.repo.RevokeApiKey(ctx, id)
+}
+
+func (s *apiKeyService) BootstrapApiKey(ctx context.Context, hash string) (*domain.ApiKey, error) {
+	if _, err := hex.DecodeString(hash); err != nil || len(hash) != sha256.Size*2 {
+		return nil, domain.ErrInvalidApiKey // Not a hash made by hashSecret
+	}
+	hash = strings.ToLower(hash)
+
+	stored, err := s.repo.GetApiKey(ctx, bootstrapApiKeyID)
+	if err == domain.ErrDataNotFound {
+		return s.repo.CreateApiKey(ctx, &domain.ApiKey{
+			ID:     bootstrapApiKeyID,
+			Name:   "bootstrap",
+			Hash:   hash,
+			Scopes: domain.ApiKeyScopes,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// An administrator who revoked the key meant it, a restart doesn't bring it back
+	if stored.Revoked() || stored.Hash == hash {
+		return stored, nil
+	}
+
+	return s.repo.RotateApiKey(ctx, bootstrapApiKeyID, hash)
+}
+
+func (s *apiKeyService) VerifyApiKey(ctx context.Context, key string) (*domain.Principal, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(key, apiKeyPrefix), "_")
+	if !ok || !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	stored, err := s.repo.GetApiKey(ctx, id)
+	if err == domain.ErrDataNotFound {
+		return nil, domain.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(stored.Hash)) != 1 {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if stored.Revoked() || stored.Expired(time.Now()) {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return &domain.Principal{
+		Kind:    domain.PrincipalApiKey,
+		Subject: stored.ID,
+		Scopes:  stored.Scopes,
+	}, nil
+}
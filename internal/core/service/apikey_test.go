@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	mockRepo := port.NewMockApiKeyRepository(t)
+
+	apiKeyService := NewApiKeyService(mockRepo)
+
+	var stored *domain.ApiKey
+	mockRepo.On("CreateApiKey", context.Background(), mock.AnythingOfType("*domain.ApiKey")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*domain.ApiKey) }).
+		Return(func(ctx context.Context, key *domain.ApiKey) *domain.ApiKey { return key }, nil)
+
+	created, key, err := apiKeyService.CreateApiKey(context.Background(), &domain.ApiKey{Name: "ci", Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !strings.HasPrefix(key, apiKeyPrefix+created.ID+"_") {
+		t.Fatalf("expected a key that starts with the prefix and ID %s, got %s", created.ID, key)
+	}
+	if strings.Contains(stored.Hash, strings.TrimPrefix(key, apiKeyPrefix+created.ID+"_")) {
+		t.Fatalf("expected only the hash of the secret to be stored, got %s", stored.Hash)
+	}
+
+	// The key handed out verifies against the stored hash
+	mockRepo.On("GetApiKey", context.Background(), created.ID).Return(stored, nil)
+
+	principal, err := apiKeyService.VerifyApiKey(context.Background(), key)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if principal.Kind != domain.PrincipalApiKey || principal.Subject != created.ID || !principal.HasScope(domain.ApiKeyScopeRead) || principal.HasScope(domain.ApiKeyScopeWrite) {
+		t.Fatalf("expected the API key %s with the read scope, got %+v", created.ID, principal)
+	}
+}
+
+func TestCreateApiKeyInvalid(t *testing.T) {
+	mockRepo := port.NewMockApiKeyRepository(t)
+
+	apiKeyService := NewApiKeyService(mockRepo)
+
+	tests := []struct {
+		name string
+		key  *domain.ApiKey
+	}{
+		{"NoName", &domain.ApiKey{Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}}},
+		{"NoScopes", &domain.ApiKey{Name: "ci"}},
+		{"UnknownScope", &domain.ApiKey{Name: "ci", Scopes: []domain.ApiKeyScope{"delete"}}},
+		{"Expired", &domain.ApiKey{Name: "ci", Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}, ExpiresAt: time.Now().Add(-time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := apiKeyService.CreateApiKey(context.Background(), tt.key)
+			if err != domain.ErrInvalidApiKey {
+				t.Fatalf("expected error %v, got %v", domain.ErrInvalidApiKey, err)
+			}
+		})
+	}
+}
+
+func TestRotateApiKey(t *testing.T) {
+	mockRepo := port.NewMockApiKeyRepository(t)
+
+	apiKeyService := NewApiKeyService(mockRepo)
+
+	oldKey := "cms_abc_" + strings.Repeat("0", 64)
+	stored := &domain.ApiKey{ID: "abc", Hash: hashSecret(strings.Repeat("0", 64)), Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeWrite}}
+
+	mockRepo.On("RotateApiKey", context.Background(), "abc", mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { stored.Hash = args.String(2) }).
+		Return(stored, nil)
+	mockRepo.On("GetApiKey", context.Background(), "abc").Return(stored, nil)
+
+	_, newKey, err := apiKeyService.RotateApiKey(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := apiKeyService.VerifyApiKey(context.Background(), newKey); err != nil {
+		t.Fatalf("expected the new key to verify, got %v", err)
+	}
+	if _, err := apiKeyService.VerifyApiKey(context.Background(), oldKey); err != domain.ErrUnauthorized {
+		t.Fatalf("expected error %v for the old key, got %v", domain.ErrUnauthorized, err)
+	}
+}
+
+func TestVerifyApiKey(t *testing.T) {
+	secret := strings.Repeat("1", 64)
+	valid := &domain.ApiKey{ID: "valid", Hash: hashSecret(secret), Scopes: []domain.ApiKeyScope{domain.ApiKeyScopeRead}, ExpiresAt: time.Now().Add(time.Hour)}
+	revoked := &domain.ApiKey{ID: "revoked", Hash: hashSecret(secret), RevokedAt: time.Now()}
+	expired := &domain.ApiKey{ID: "expired", Hash: hashSecret(secret), ExpiresAt: time.Now().Add(-time.Second)}
+
+	tests := []struct {
+		name    string
+		key     string
+		stored  *domain.ApiKey
+		repoErr error
+		wantErr error
+	}{
+		{"Valid", "cms_valid_" + secret, valid, nil, nil},
+		{"WrongSecret", "cms_valid_" + strings.Repeat("2", 64), valid, nil, domain.ErrUnauthorized},
+		{"Revoked", "cms_revoked_" + secret, revoked, nil, domain.ErrUnauthorized},
+		{"Expired", "cms_expired_" + secret, expired, nil, domain.ErrUnauthorized},
+		{"Unknown", "cms_unknown_" + secret, nil, domain.ErrDataNotFound, domain.ErrUnauthorized},
+		{"StorageFailure", "cms_valid_" + secret, nil, domain.ErrInternal, domain.ErrInternal},
+		{"NoPrefix", "valid_" + secret, nil, nil, domain.ErrUnauthorized},
+		{"NoSecret", "cms_valid", nil, nil, domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := port.NewMockApiKeyRepository(t)
+
+			apiKeyService := NewApiKeyService(mockRepo)
+
+			if tt.stored != nil || tt.repoErr != nil {
+				id := strings.Split(tt.key, "_")[1]
+				mockRepo.On("GetApiKey", context.Background(), id).Return(tt.stored, tt.repoErr)
+			}
+
+			principal, err := apiKeyService.VerifyApiKey(context.Background(), tt.key)
+			if err != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && principal.Subject != tt.stored.ID {
+				t.Fatalf("expected API key %s, got %+v", tt.stored.ID, principal)
+			}
+		})
+	}
+}
+
+func TestBootstrapApiKey(t *testing.T) {
+	secret := strings.Repeat("3", 64)
+	hash := hashSecret(secret)
+
+	t.Run("Created", func(t *testing.T) {
+		mockRepo := port.NewMockApiKeyRepository(t)
+
+		apiKeyService := NewApiKeyService(mockRepo)
+
+		var stored *domain.ApiKey
+		mockRepo.On("GetApiKey", context.Background(), "bootstrap").Return(nil, domain.ErrDataNotFound).Once()
+		mockRepo.On("CreateApiKey", context.Background(), mock.AnythingOfType("*domain.ApiKey")).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(*domain.ApiKey) }).
+			Return(func(ctx context.Context, key *domain.ApiKey) *domain.ApiKey { return key }, nil)
+
+		if _, err := apiKeyService.BootstrapApiKey(context.Background(), hash); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// The key made of the secret verifies with every scope
+		mockRepo.On("GetApiKey", context.Background(), "bootstrap").Return(stored, nil)
+
+		principal, err := apiKeyService.VerifyApiKey(context.Background(), "cms_bootstrap_"+secret)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if principal.Subject != "bootstrap" || !principal.HasScope(domain.ApiKeyScopeAdmin) {
+			t.Fatalf("expected the bootstrap key with the admin scope, got %+v", principal)
+		}
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		mockRepo := port.NewMockApiKeyRepository(t)
+
+		apiKeyService := NewApiKeyService(mockRepo)
+
+		mockRepo.On("GetApiKey", context.Background(), "bootstrap").Return(&domain.ApiKey{ID: "bootstrap", Hash: hash}, nil)
+
+		if _, err := apiKeyService.BootstrapApiKey(context.Background(), strings.ToUpper(hash)); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("Rotated", func(t *testing.T) {
+		mockRepo := port.NewMockApiKeyRepository(t)
+
+		apiKeyService := NewApiKeyService(mockRepo)
+
+		mockRepo.On("GetApiKey", context.Background(), "bootstrap").Return(&domain.ApiKey{ID: "bootstrap", Hash: hashSecret("old")}, nil)
+		mockRepo.On("RotateApiKey", context.Background(), "bootstrap", hash).Return(&domain.ApiKey{ID: "bootstrap", Hash: hash}, nil)
+
+		if _, err := apiKeyService.BootstrapApiKey(context.Background(), hash); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		mockRepo := port.NewMockApiKeyRepository(t)
+
+		apiKeyService := NewApiKeyService(mockRepo)
+
+		mockRepo.On("GetApiKey", context.Background(), "bootstrap").Return(&domain.ApiKey{ID: "bootstrap", Hash: hashSecret("old"), RevokedAt: time.Now()}, nil)
+
+		key, err := apiKeyService.BootstrapApiKey(context.Background(), hash)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !key.Revoked() {
+			t.Fatalf("expected the key to stay revoked, got %+v", key)
+		}
+	})
+
+	t.Run("InvalidHash", func(t *testing.T) {
+		apiKeyService := NewApiKeyService(port.NewMockApiKeyRepository(t))
+
+		if _, err := apiKeyService.BootstrapApiKey(context.Background(), secret[:10]); err != domain.ErrInvalidApiKey {
+			t.Fatalf("expected error %v, got %v", domain.ErrInvalidApiKey, err)
+		}
+	})
+}
@@ -0,0 +1,540 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// Authorizer decides whether the caller of a request may perform an operation, from the roles bound to it
+type Authorizer struct {
+	bindings []domain.RoleBinding
+}
+
+// NewAuthorizer creates an authorizer that grants the roles of the bindings
+func NewAuthorizer(bindings []domain.RoleBinding) *Authorizer {
+	return &Authorizer{
+		bindings,
+	}
+}
+
+// Authorize fails with domain.ErrForbidden unless a role bound to the caller allows an operation on a configuration,
+// and with domain.ErrUnauthorized if the request isn't authenticated, since no role can be bound to an unknown caller
+func (a *Authorizer) Authorize(ctx context.Context, permission domain.Permission, namespace, name, configType string) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return domain.ErrUnauthorized
+	}
+
+	for _, binding := range a.bindings {
+		if binding.Binds(principal) && binding.Role.Allows(permission) && binding.Covers(namespace, name, configType) {
+			return nil
+		}
+	}
+
+	return domain.ErrForbidden
+}
+
+// Authenticated fails with domain.ErrUnauthorized if the request isn't authenticated. It guards the operations that
+// leave out what the caller may not read, rather than failing
+func (a *Authorizer) Authenticated(ctx context.Context) error {
+	if _, ok := domain.PrincipalFromContext(ctx); !ok {
+		return domain.ErrUnauthorized
+	}
+
+	return nil
+}
+
+// AuthorizeAll reports whether a role bound to the caller allows an operation on every configuration of a namespace,
+// or of every namespace if it is empty. It never does for a request that isn't authenticated
+func (a *Authorizer) AuthorizeAll(ctx context.Context, permission domain.Permission, namespace string) bool {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, binding := range a.bindings {
+		if binding.Binds(principal) && binding.Role.Allows(permission) && binding.CoversAll(namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authorizedConfigurationService checks the roles of the caller before every operation of a configuration service
+type authorizedConfigurationService struct {
+	next       ConfigurationServicer
+	authorizer *Authorizer
+}
+
+// NewAuthorizedConfigurationService wraps a configuration service so that every operation is checked by an authorizer.
+// Operations on an existing configuration are checked against the type of its latest version
+func NewAuthorizedConfigurationService(next ConfigurationServicer, authorizer *Authorizer) ConfigurationServicer {
+	return &authorizedConfigurationService{
+		next,
+		authorizer,
+	}
+}
+
+// authorizeCurrent checks a permission on the latest version of a configuration
+func (s *authorizedConfigurationService) authorizeCurrent(ctx context.Context, permission domain.Permission, namespace, name string) error {
+	configType := ""
+
+	latest, err := s.next.GetConfiguration(ctx, namespace, name)
+	if err == nil {
+		configType = latest.Type
+	} else if err != domain.ErrDataNotFound {
+		return err
+	}
+
+	return s.authorizer.Authorize(ctx, permission, namespace, name, configType)
+}
+
+// authorizePut checks that the caller may write both the latest version of a configuration and its new type.
+// A configuration that doesn't exist yet, or is deleted, only needs its new type to be writable
+func (s *authorizedConfigurationService) authorizePut(ctx context.Context, config *domain.Config) error {
+	latest, err := s.next.GetConfiguration(ctx, config.Namespace, config.Name)
+	if err == nil {
+		err = s.authorizer.Authorize(ctx, domain.PermissionWrite, config.Namespace, config.Name, latest.Type)
+	} else if err == domain.ErrDataNotFound {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.authorizer.Authorize(ctx, domain.PermissionWrite, config.Namespace, config.Name, config.Type)
+}
+
+func (s *authorizedConfigurationService) PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	if err := s.authorizePut(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return s.next.PutConfiguration(ctx, config, expectedVersion)
+}
+
+func (s *authorizedConfigurationService) ValidateConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	if err := s.authorizePut(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return s.next.ValidateConfiguration(ctx, config, expectedVersion)
+}
+
+func (s *authorizedConfigurationService) PatchConfiguration(ctx context.Context, namespace, name string, patchType domain.PatchType, patch []byte, expectedVersion int) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionWrite, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.PatchConfiguration(ctx, namespace, name, patchType, patch, expectedVersion)
+}
+
+func (s *authorizedConfigurationService) PutConfigurationOverlay(ctx context.Context, namespace, name, environment string, overlay map[string]interface{}, expectedVersion int) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionWrite, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.PutConfigurationOverlay(ctx, namespace, name, environment, overlay, expectedVersion)
+}
+
+func (s *authorizedConfigurationService) PutConfigurationLabels(ctx context.Context, namespace, name string, labels, annotations map[string]string, expectedVersion int) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionWrite, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.PutConfigurationLabels(ctx, namespace, name, labels, annotations, expectedVersion)
+}
+
+func (s *authorizedConfigurationService) GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.GetConfiguration(ctx, namespace, name)
+}
+
+func (s *authorizedConfigurationService) WaitForConfiguration(ctx context.Context, namespace, name string, afterVersion int, timeout time.Duration) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.WaitForConfiguration(ctx, namespace, name, afterVersion, timeout)
+}
+
+// readable returns the configurations the caller may read
+func (s *authorizedConfigurationService) readable(ctx context.Context, configs []*domain.Config) []*domain.Config {
+	readable := make([]*domain.Config, 0, len(configs))
+	for _, config := range configs {
+		if s.authorizer.Authorize(ctx, domain.PermissionRead, config.Namespace, config.Name, config.Type) == nil {
+			readable = append(readable, config)
+		}
+	}
+
+	return readable
+}
+
+// countReadable counts the configurations of a namespace that pass a query and the caller may read, which takes reading
+// every one of them
+func (s *authorizedConfigurationService) countReadable(ctx context.Context, namespace string, query domain.ConfigQuery) (uint64, error) {
+	const pageSize = 100
+
+	var total uint64
+
+	page := domain.PageRequest{Limit: pageSize}
+	for {
+		configs, err := s.next.ListConfigurations(ctx, namespace, query, page)
+		if err != nil {
+			return 0, err
+		}
+
+		total += uint64(len(s.readable(ctx, configs.Configs)))
+
+		if configs.Next == nil {
+			return total, nil
+		}
+		page.Cursor = configs.Next
+	}
+}
+
+// ListConfigurations leaves out the configurations the caller may not read, so a page can be shorter than limit.
+// The next page still starts after the last configuration of the whole page. The total only counts what the caller
+// may read, which for a caller who may only read some configurations is counted by reading them all
+func (s *authorizedConfigurationService) ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) (*domain.ConfigPage, error) {
+	if err := s.authorizer.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	configs, err := s.next.ListConfigurations(ctx, namespace, query, page)
+	if err != nil {
+		return nil, err
+	}
+
+	total := configs.Total
+	if !s.authorizer.AuthorizeAll(ctx, domain.PermissionRead, namespace) {
+		if total, err = s.countReadable(ctx, namespace, query); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.ConfigPage{Configs: s.readable(ctx, configs.Configs), Next: configs.Next, Total: total}, nil
+}
+
+func (s *authorizedConfigurationService) ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) (*domain.ConfigPage, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.ListConfigurationVersions(ctx, namespace, name, filter, page)
+}
+
+func (s *authorizedConfigurationService) GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.GetConfigurationVersion(ctx, namespace, name, version)
+}
+
+func (s *authorizedConfigurationService) RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRollback, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.RollbackConfigurationVersion(ctx, namespace, name, version)
+}
+
+func (s *authorizedConfigurationService) PromoteConfigurationVersion(ctx context.Context, namespace, name string, version int, from, to string) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionPromote, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.PromoteConfigurationVersion(ctx, namespace, name, version, from, to)
+}
+
+func (s *authorizedConfigurationService) MoveConfigurationAlias(ctx context.Context, namespace, name, alias string, version, expectedRevision int) (*domain.Alias, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionPromote, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.MoveConfigurationAlias(ctx, namespace, name, alias, version, expectedRevision)
+}
+
+func (s *authorizedConfigurationService) ClearConfigurationAlias(ctx context.Context, namespace, name, alias string, expectedRevision int) (*domain.Alias, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionPromote, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.ClearConfigurationAlias(ctx, namespace, name, alias, expectedRevision)
+}
+
+func (s *authorizedConfigurationService) GetConfigurationAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, *domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, nil, err
+	}
+
+	return s.next.GetConfigurationAlias(ctx, namespace, name, alias)
+}
+
+func (s *authorizedConfigurationService) ListConfigurationAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.ListConfigurationAliases(ctx, namespace, name)
+}
+
+func (s *authorizedConfigurationService) ListConfigurationAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.ListConfigurationAliasRevisions(ctx, namespace, name, alias, skip, limit)
+}
+
+func (s *authorizedConfigurationService) DiffConfigurationVersions(ctx context.Context, namespace, name string, from, to int, format domain.DiffFormat) (*domain.ConfigDiff, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionRead, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.DiffConfigurationVersions(ctx, namespace, name, from, to, format)
+}
+
+func (s *authorizedConfigurationService) DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionWrite, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.DeleteConfiguration(ctx, namespace, name, expectedVersion)
+}
+
+func (s *authorizedConfigurationService) RestoreConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	if err := s.authorizeCurrent(ctx, domain.PermissionWrite, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.next.RestoreConfiguration(ctx, namespace, name)
+}
+
+func (s *authorizedConfigurationService) PurgeConfiguration(ctx context.Context, namespace, name string) error {
+	if err := s.authorizeCurrent(ctx, domain.PermissionAdmin, namespace, name); err != nil {
+		return err
+	}
+
+	return s.next.PurgeConfiguration(ctx, namespace, name)
+}
+
+// authorizedWatchService leaves the changes of the configurations the caller may not read out of a watch
+type authorizedWatchService struct {
+	next       WatchServicer
+	authorizer *Authorizer
+}
+
+// NewAuthorizedWatchService wraps a watch service so that watchers only receive the changes they may read
+func NewAuthorizedWatchService(next WatchServicer, authorizer *Authorizer) WatchServicer {
+	return &authorizedWatchService{
+		next,
+		authorizer,
+	}
+}
+
+func (s *authorizedWatchService) Watch(ctx context.Context, filter domain.WatchFilter, lastRevision uint64) (<-chan *domain.ConfigEvent, error) {
+	if err := s.authorizer.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	events, err := s.next.Watch(ctx, filter, lastRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := make(chan *domain.ConfigEvent)
+	go func() {
+		defer close(readable)
+
+		for event := range events {
+			if s.authorizer.Authorize(ctx, domain.PermissionRead, event.Config.Namespace, event.Config.Name, event.Config.Type) != nil {
+				continue
+			}
+
+			select {
+			case readable <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return readable, nil
+}
+
+// authorizedApiKeyService restricts the management of API keys to administrators of every configuration
+type authorizedApiKeyService struct {
+	next       ApiKeyServicer
+	authorizer *Authorizer
+}
+
+// NewAuthorizedApiKeyService wraps an API key service so that only callers with the admin role on every configuration
+// manage API keys. Verifying a key isn't checked, it's how callers authenticate
+func NewAuthorizedApiKeyService(next ApiKeyServicer, authorizer *Authorizer) ApiKeyServicer {
+	return &authorizedApiKeyService{
+		next,
+		authorizer,
+	}
+}
+
+// authorizeAdmin checks that the caller is an administrator of every configuration. A binding limited to a namespace,
+// some names or a type doesn't match the empty namespace, name and type
+func (s *authorizedApiKeyService) authorizeAdmin(ctx context.Context) error {
+	return s.authorizer.Authorize(ctx, domain.PermissionAdmin, "", "", "")
+}
+
+func (s *authorizedApiKeyService) CreateApiKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, string, error) {
+	if err := s.authorizeAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+
+	return s.next.CreateApiKey(ctx, key)
+}
+
+func (s *authorizedApiKeyService) ListApiKeys(ctx context.Context, skip, limit uint64) ([]*domain.ApiKey, error) {
+	if err := s.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.ListApiKeys(ctx, skip, limit)
+}
+
+func (s *authorizedApiKeyService) RotateApiKey(ctx context.Context, id string) (*domain.ApiKey, string, error) {
+	if err := s.authorizeAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+
+	return s.next.RotateApiKey(ctx, id)
+}
+
+func (s *authorizedApiKeyService) RevokeApiKey(ctx context.Context, id string) (*domain.ApiKey, error) {
+	if err := s.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.RevokeApiKey(ctx, id)
+}
+
+func (s *authorizedApiKeyService) BootstrapApiKey(ctx context.Context, hash string) (*domain.ApiKey, error) {
+	if err := s.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.BootstrapApiKey(ctx, hash)
+}
+
+func (s *authorizedApiKeyService) VerifyApiKey(ctx context.Context, key string) (*domain.Principal, error) {
+	return s.next.VerifyApiKey(ctx, key)
+}
+
+// authorizedNamespaceService restricts creating and deleting a namespace to its administrators
+type authorizedNamespaceService struct {
+	next       NamespaceServicer
+	authorizer *Authorizer
+}
+
+// NewAuthorizedNamespaceService wraps a namespace service so that only callers with the admin role on every
+// configuration of a namespace create or delete it. Namespaces are listed to every caller
+func NewAuthorizedNamespaceService(next NamespaceServicer, authorizer *Authorizer) NamespaceServicer {
+	return &authorizedNamespaceService{
+		next,
+		authorizer,
+	}
+}
+
+func (s *authorizedNamespaceService) CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error) {
+	if err := s.authorizer.Authorize(ctx, domain.PermissionAdmin, namespace.Name, "", ""); err != nil {
+		return nil, err
+	}
+
+	return s.next.CreateNamespace(ctx, namespace)
+}
+
+func (s *authorizedNamespaceService) GetNamespace(ctx context.Context, name string) (*domain.Namespace, error) {
+	return s.next.GetNamespace(ctx, name)
+}
+
+func (s *authorizedNamespaceService) ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error) {
+	return s.next.ListNamespaces(ctx, skip, limit)
+}
+
+func (s *authorizedNamespaceService) DeleteNamespace(ctx context.Context, name string) error {
+	if err := s.authorizer.Authorize(ctx, domain.PermissionAdmin, name, "", ""); err != nil {
+		return err
+	}
+
+	return s.next.DeleteNamespace(ctx, name)
+}
+
+// authorizedSchemaService restricts changing the schema of a type to the editors of every configuration of the type
+type authorizedSchemaService struct {
+	next       SchemaServicer
+	authorizer *Authorizer
+}
+
+// NewAuthorizedSchemaService wraps a schema service so that only callers with the write permission on every
+// configuration of a type, in every namespace, register or delete its schema. A schema governs the configurations of
+// its type wherever they are, so a binding limited to a namespace or some names doesn't cover it. Schemas are read by
+// every authenticated caller
+func NewAuthorizedSchemaService(next SchemaServicer, authorizer *Authorizer) SchemaServicer {
+	return &authorizedSchemaService{
+		next,
+		authorizer,
+	}
+}
+
+func (s *authorizedSchemaService) PutSchema(ctx context.Context, schema *domain.Schema) (*domain.Schema, error) {
+	if err := s.authorizer.Authorize(ctx, domain.PermissionWrite, "", "", schema.Type); err != nil {
+		return nil, err
+	}
+
+	return s.next.PutSchema(ctx, schema)
+}
+
+func (s *authorizedSchemaService) GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error) {
+	if err := s.authorizer.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.GetSchema(ctx, schemaType)
+}
+
+func (s *authorizedSchemaService) ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error) {
+	if err := s.authorizer.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.ListSchemas(ctx, skip, limit)
+}
+
+func (s *authorizedSchemaService) ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error) {
+	if err := s.authorizer.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.ListSchemaVersions(ctx, schemaType, skip, limit)
+}
+
+func (s *authorizedSchemaService) GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error) {
+	if err := s.authorizer.Authenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.next.GetSchemaVersion(ctx, schemaType, version)
+}
+
+func (s *authorizedSchemaService) DeleteSchema(ctx context.Context, schemaType string) error {
+	if err := s.authorizer.Authorize(ctx, domain.PermissionWrite, "", "", schemaType); err != nil {
+		return err
+	}
+
+	return s.next.DeleteSchema(ctx, schemaType)
+}
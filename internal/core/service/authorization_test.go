@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/stretchr/testify/mock"
+)
+
+func userContext(subject string) context.Context {
+	return domain.ContextWithPrincipal(context.Background(), &domain.Principal{Kind: domain.PrincipalUser, Subject: subject})
+}
+
+func TestAuthorize(t *testing.T) {
+	authorizer := NewAuthorizer([]domain.RoleBinding{
+		{Role: domain.RoleEditor, Kind: domain.PrincipalUser, Subject: "alice", Names: "payments_*"},
+		{Role: domain.RoleViewer, Kind: domain.PrincipalUser, Subject: "alice"},
+		{Role: domain.RoleReleaser, Kind: domain.PrincipalApiKey, Subject: "deploy", Type: "person"},
+		{Role: domain.RoleAdmin, Kind: domain.PrincipalUser, Subject: "root"},
+		{Role: domain.RoleEditor, Kind: domain.PrincipalUser, Subject: "carol", Namespace: "payments"},
+	})
+
+	apiKey := domain.ContextWithPrincipal(context.Background(), &domain.Principal{Kind: domain.PrincipalApiKey, Subject: "deploy"})
+	// A user named like an API key doesn't get its roles
+	impostor := userContext("deploy")
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		permission domain.Permission
+		namespace  string
+		config     string
+		configType string
+		wantErr    error
+	}{
+		{"ReadAnywhere", userContext("alice"), domain.PermissionRead, domain.DefaultNamespace, "orders_config", "order", nil},
+		{"WriteMatchingName", userContext("alice"), domain.PermissionWrite, domain.DefaultNamespace, "payments_config", "payment", nil},
+		{"WriteOtherName", userContext("alice"), domain.PermissionWrite, domain.DefaultNamespace, "orders_config", "order", domain.ErrForbidden},
+		{"EditorRollback", userContext("alice"), domain.PermissionRollback, domain.DefaultNamespace, "payments_config", "payment", domain.ErrForbidden},
+		{"ReleaserRollbackMatchingType", apiKey, domain.PermissionRollback, domain.DefaultNamespace, "person_config", "person", nil},
+		{"ReleaserRollbackOtherType", apiKey, domain.PermissionRollback, domain.DefaultNamespace, "order_config", "order", domain.ErrForbidden},
+		{"ReleaserPromote", apiKey, domain.PermissionPromote, domain.DefaultNamespace, "person_config", "person", nil},
+		{"EditorPromote", userContext("alice"), domain.PermissionPromote, domain.DefaultNamespace, "payments_config", "payment", domain.ErrForbidden},
+		{"ReleaserWrite", apiKey, domain.PermissionWrite, domain.DefaultNamespace, "person_config", "person", domain.ErrForbidden},
+		{"NoType", apiKey, domain.PermissionRead, domain.DefaultNamespace, "person_config", "", domain.ErrForbidden},
+		{"OtherKind", impostor, domain.PermissionRead, domain.DefaultNamespace, "person_config", "person", domain.ErrForbidden},
+		{"Unbound", userContext("bob"), domain.PermissionRead, domain.DefaultNamespace, "person_config", "person", domain.ErrForbidden},
+		{"Admin", userContext("root"), domain.PermissionAdmin, domain.DefaultNamespace, "person_config", "person", nil},
+		{"WriteInNamespace", userContext("carol"), domain.PermissionWrite, "payments", "person_config", "person", nil},
+		{"WriteInOtherNamespace", userContext("carol"), domain.PermissionWrite, domain.DefaultNamespace, "person_config", "person", domain.ErrForbidden},
+		{"Unauthenticated", context.Background(), domain.PermissionRead, domain.DefaultNamespace, "person_config", "person", domain.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizer.Authorize(tt.ctx, tt.permission, tt.namespace, tt.config, tt.configType)
+			if err != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizedConfigurationService(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	authorizer := NewAuthorizer([]domain.RoleBinding{
+		{Role: domain.RoleEditor, Kind: domain.PrincipalUser, Subject: "alice", Type: "person"},
+		{Role: domain.RoleReleaser, Kind: domain.PrincipalUser, Subject: "bob"},
+	})
+	configurationService := NewAuthorizedConfigurationService(NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks()), authorizer)
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, "person_config").Return(&domain.Config{Name: "person_config", Type: "person", Value: value, Version: 1}, nil)
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, "order_config").Return(&domain.Config{Name: "order_config", Type: "order", Version: 1}, nil)
+
+	// An editor of a type may replace its configurations, but not roll them back, promote them or read other types
+	mockRepo.On("PutConfiguration", mock.Anything, mock.AnythingOfType("*domain.Config"), 1).Return(&domain.Config{Name: "person_config", Type: "person", Version: 2}, nil)
+	if _, err := configurationService.PutConfiguration(userContext("alice"), &domain.Config{Namespace: domain.DefaultNamespace, Name: "person_config", Type: "person", Value: value}, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.RollbackConfigurationVersion(userContext("alice"), domain.DefaultNamespace, "person_config", 1); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a rollback by an editor, got %v", domain.ErrForbidden, err)
+	}
+	if _, err := configurationService.PromoteConfigurationVersion(userContext("alice"), domain.DefaultNamespace, "person_config", 1, "staging", "prod"); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a promotion by an editor, got %v", domain.ErrForbidden, err)
+	}
+	if _, err := configurationService.GetConfiguration(userContext("alice"), domain.DefaultNamespace, "order_config"); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a read of another type, got %v", domain.ErrForbidden, err)
+	}
+
+	// Changing the type of a configuration needs the write permission on the type it had too
+	if _, err := configurationService.PutConfiguration(userContext("alice"), &domain.Config{Namespace: domain.DefaultNamespace, Name: "order_config", Type: "person", Value: value}, 0); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a type change, got %v", domain.ErrForbidden, err)
+	}
+
+	// An editor of a type may create configurations of that type, but not of another one
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, "new_person_config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("PutConfiguration", mock.Anything, mock.AnythingOfType("*domain.Config"), 0).Return(&domain.Config{Name: "new_person_config", Type: "person", Version: 1}, nil).Once()
+	if _, err := configurationService.PutConfiguration(userContext("alice"), &domain.Config{Namespace: domain.DefaultNamespace, Name: "new_person_config", Type: "person", Value: value}, 0); err != nil {
+		t.Fatalf("expected no error for a new configuration of the editor's type, got %v", err)
+	}
+	if _, err := configurationService.PutConfiguration(userContext("alice"), &domain.Config{Namespace: domain.DefaultNamespace, Name: "new_person_config", Type: "order", Value: value}, 0); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a new configuration of another type, got %v", domain.ErrForbidden, err)
+	}
+
+	// A releaser may roll back, but not write
+	mockRepo.On("GetConfigurationVersion", mock.Anything, domain.DefaultNamespace, "person_config", 1).Return(&domain.Config{Name: "person_config", Type: "person", Value: value, Version: 1}, nil)
+	mockRepo.On("RollbackConfigurationVersion", mock.Anything, domain.DefaultNamespace, "person_config", 1, domain.Change{CreatedBy: "bob"}).Return(&domain.Config{Name: "person_config", Type: "person", Version: 2, RollbackedVersion: 1}, nil)
+	if _, err := configurationService.RollbackConfigurationVersion(userContext("bob"), domain.DefaultNamespace, "person_config", 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.DeleteConfiguration(userContext("bob"), domain.DefaultNamespace, "order_config", 0); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a delete by a releaser, got %v", domain.ErrForbidden, err)
+	}
+	if err := configurationService.PurgeConfiguration(userContext("bob"), domain.DefaultNamespace, "order_config"); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a purge by a releaser, got %v", domain.ErrForbidden, err)
+	}
+
+	// Moving an alias is a release, an editor may only read where it points
+	mockRepo.On("MoveAlias", mock.Anything, mock.AnythingOfType("*domain.Alias"), 0).Return(&domain.Alias{Alias: "stable", Version: 1, Revision: 1}, nil).Once()
+	if _, err := configurationService.MoveConfigurationAlias(userContext("bob"), domain.DefaultNamespace, "person_config", "stable", 1, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.MoveConfigurationAlias(userContext("alice"), domain.DefaultNamespace, "person_config", "stable", 1, 0); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for a move by an editor, got %v", domain.ErrForbidden, err)
+	}
+	mockRepo.On("ListAliases", mock.Anything, domain.DefaultNamespace, "person_config").Return([]*domain.Alias{{Alias: "stable", Version: 1, Revision: 1}}, nil)
+	if _, err := configurationService.ListConfigurationAliases(userContext("alice"), domain.DefaultNamespace, "person_config"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Lists leave out what the caller may not read
+	mockRepo.On("ListConfigurations", mock.Anything, domain.DefaultNamespace, domain.ConfigQuery{}, mock.Anything).Return([]*domain.Config{{Name: "order_config", Type: "order"}, {Name: "person_config", Type: "person"}}, uint64(2), nil)
+	configs, err := configurationService.ListConfigurations(userContext("alice"), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(configs.Configs) != 1 || configs.Configs[0].Name != "person_config" {
+		t.Fatalf("expected only person_config, got %v", configs.Configs)
+	}
+	if configs.Total != 1 {
+		t.Fatalf("expected the total to only count person_config, got %d", configs.Total)
+	}
+
+	// The total of a caller who may read every configuration is the one of the storage
+	configs, err = configurationService.ListConfigurations(userContext("bob"), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(configs.Configs) != 2 || configs.Total != 2 {
+		t.Fatalf("expected both configurations of a total of 2, got %v", configs)
+	}
+
+	// A caller who isn't authenticated is rejected rather than shown an empty list
+	if _, err := configurationService.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10}); err != domain.ErrUnauthorized {
+		t.Fatalf("expected error %v, got %v", domain.ErrUnauthorized, err)
+	}
+	if _, err := configurationService.GetConfiguration(context.Background(), domain.DefaultNamespace, "person_config"); err != domain.ErrUnauthorized {
+		t.Fatalf("expected error %v, got %v", domain.ErrUnauthorized, err)
+	}
+}
+
+func TestAuthorizedWatchService(t *testing.T) {
+	events := NewEventBus(10)
+	authorizer := NewAuthorizer([]domain.RoleBinding{
+		{Role: domain.RoleViewer, Kind: domain.PrincipalUser, Subject: "alice", Names: "person_*"},
+	})
+	watchService := NewAuthorizedWatchService(events, authorizer)
+
+	ctx, cancel := context.WithCancel(userContext("alice"))
+	defer cancel()
+
+	ch, err := watchService.Watch(ctx, domain.WatchFilter{}, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	events.publish(domain.ConfigEventPut, &domain.Config{Name: "order_config", Type: "order", Version: 1})
+	events.publish(domain.ConfigEventPut, &domain.Config{Name: "person_config", Type: "person", Version: 1})
+
+	select {
+	case event := <-ch:
+		if event.Config.Name != "person_config" {
+			t.Fatalf("expected the change of person_config, got %v", event.Config)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the change of person_config")
+	}
+
+	if _, err := watchService.Watch(context.Background(), domain.WatchFilter{}, 0); err != domain.ErrUnauthorized {
+		t.Fatalf("expected error %v, got %v", domain.ErrUnauthorized, err)
+	}
+}
+
+func TestAuthorizedApiKeyService(t *testing.T) {
+	mockRepo := port.NewMockApiKeyRepository(t)
+
+	authorizer := NewAuthorizer([]domain.RoleBinding{
+		{Role: domain.RoleAdmin, Kind: domain.PrincipalUser, Subject: "root"},
+		{Role: domain.RoleAdmin, Kind: domain.PrincipalUser, Subject: "alice", Names: "payments_*"},
+	})
+	apiKeyService := NewAuthorizedApiKeyService(NewApiKeyService(mockRepo), authorizer)
+
+	mockRepo.On("ListApiKeys", mock.Anything, uint64(0), uint64(10)).Return([]*domain.ApiKey{}, nil)
+
+	if _, err := apiKeyService.ListApiKeys(userContext("root"), 0, 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// An administrator of some configurations doesn't manage API keys
+	if _, err := apiKeyService.ListApiKeys(userContext("alice"), 0, 10); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v, got %v", domain.ErrForbidden, err)
+	}
+}
+
+func TestAuthorizedNamespaceService(t *testing.T) {
+	mockRepo := port.NewMockNamespaceRepository(t)
+
+	authorizer := NewAuthorizer([]domain.RoleBinding{
+		{Role: domain.RoleAdmin, Kind: domain.PrincipalUser, Subject: "alice", Namespace: "payments"},
+		{Role: domain.RoleAdmin, Kind: domain.PrincipalUser, Subject: "bob", Namespace: "payments", Names: "eu_*"},
+	})
+	namespaceService := NewAuthorizedNamespaceService(NewNamespaceService(mockRepo, port.NewMockConfigurationRepository(t)), authorizer)
+
+	mockRepo.On("CreateNamespace", mock.Anything, mock.AnythingOfType("*domain.Namespace")).Return(&domain.Namespace{Name: "payments"}, nil)
+
+	if _, err := namespaceService.CreateNamespace(userContext("alice"), &domain.Namespace{Name: "payments"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := namespaceService.CreateNamespace(userContext("alice"), &domain.Namespace{Name: "orders"}); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for another namespace, got %v", domain.ErrForbidden, err)
+	}
+	// An administrator of some configurations of a namespace doesn't manage the namespace
+	if _, err := namespaceService.CreateNamespace(userContext("bob"), &domain.Namespace{Name: "payments"}); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v, got %v", domain.ErrForbidden, err)
+	}
+}
+
+func TestAuthorizedSchemaService(t *testing.T) {
+	mockRepo := newPersonSchemaRepository(t)
+	mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+	authorizer := NewAuthorizer([]domain.RoleBinding{
+		{Role: domain.RoleEditor, Kind: domain.PrincipalUser, Subject: "alice", Type: "person"},
+		{Role: domain.RoleEditor, Kind: domain.PrincipalUser, Subject: "carol", Namespace: "payments"},
+		{Role: domain.RoleViewer, Kind: domain.PrincipalUser, Subject: "dave"},
+	})
+	schemaService := NewAuthorizedSchemaService(NewSchemaService(mockRepo, mockConfigRepo, NewWriteLocks()), authorizer)
+
+	// An editor of every configuration of a type deletes its schema
+	mockConfigRepo.On("ListConfigurations", mock.Anything, "", domain.ConfigQuery{Type: "person"}, mock.Anything).Return([]*domain.Config{}, uint64(0), nil)
+	mockRepo.On("DeleteSchema", mock.Anything, "person").Return(nil)
+	if err := schemaService.DeleteSchema(userContext("alice"), "person"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// A schema governs its type in every namespace, an editor of one namespace or a viewer doesn't change it
+	for _, subject := range []string{"carol", "dave"} {
+		if _, err := schemaService.PutSchema(userContext(subject), personSchema(2)); err != domain.ErrForbidden {
+			t.Fatalf("expected error %v for %s, got %v", domain.ErrForbidden, subject, err)
+		}
+		if err := schemaService.DeleteSchema(userContext(subject), "person"); err != domain.ErrForbidden {
+			t.Fatalf("expected error %v for %s, got %v", domain.ErrForbidden, subject, err)
+		}
+	}
+	if _, err := schemaService.PutSchema(userContext("alice"), &domain.Schema{Type: "order"}); err != domain.ErrForbidden {
+		t.Fatalf("expected error %v for another type, got %v", domain.ErrForbidden, err)
+	}
+
+	// Every authenticated caller reads the schemas
+	if _, err := schemaService.GetSchema(userContext("carol"), "person"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := schemaService.GetSchema(context.Background(), "person"); err != domain.ErrUnauthorized {
+		t.Fatalf("expected error %v, got %v", domain.ErrUnauthorized, err)
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// compatibilityIssues lists the changes from the previous to the next definition that break the compatibility mode.
+// Only the structure of object schemas is compared: property types, required properties and additionalProperties,
+// the current configurations of the type catch whatever else a new version rejects
+func compatibilityIssues(mode domain.SchemaCompatibility, previous, next map[string]interface{}) []string {
+	var issues []string
+
+	if mode == domain.SchemaCompatibilityBackward || mode == domain.SchemaCompatibilityFull {
+		issues = append(issues, readIssues("backward", "previous version", previous, "new version", next, "")...)
+	}
+
+	if mode == domain.SchemaCompatibilityForward || mode == domain.SchemaCompatibilityFull {
+		issues = append(issues, readIssues("forward", "new version", next, "previous version", previous, "")...)
+	}
+
+	return issues
+}
+
+// readIssues lists why values written for the writer schema may be rejected by the reader schema at path
+func readIssues(mode, writerName string, writer map[string]interface{}, readerName string, reader map[string]interface{}, path string) []string {
+	var issues []string
+
+	location := path
+	if location == "" {
+		location = "/"
+	}
+
+	if readerType, ok := reader["type"]; ok && !reflect.DeepEqual(writer["type"], readerType) {
+		issues = append(issues, fmt.Sprintf("%s: %s: type is %v in the %s but %v in the %s", mode, location, writer["type"], writerName, readerType, readerName))
+	}
+
+	// A value written without a property the reader requires is rejected
+	writerRequired := stringSet(writer["required"])
+	for _, name := range slices.Sorted(maps.Keys(stringSet(reader["required"]))) {
+		if !writerRequired[name] {
+			issues = append(issues, fmt.Sprintf("%s: %s/%s is required by the %s but not by the %s", mode, path, name, readerName, writerName))
+		}
+	}
+
+	writerProperties := objectProperties(writer)
+	readerProperties := objectProperties(reader)
+
+	// A reader that rejects unknown properties can't read the properties only the writer defines
+	if reader["additionalProperties"] == false {
+		for _, name := range slices.Sorted(maps.Keys(writerProperties)) {
+			if _, ok := readerProperties[name]; !ok {
+				issues = append(issues, fmt.Sprintf("%s: %s/%s is allowed by the %s but not by the %s", mode, path, name, writerName, readerName))
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(writerProperties)) {
+		writerProperty, ok := writerProperties[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		readerProperty, ok := readerProperties[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		issues = append(issues, readIssues(mode, writerName, writerProperty, readerName, readerProperty, path+"/"+name)...)
+	}
+
+	return issues
+}
+
+// objectProperties returns the properties keyword of an object schema
+func objectProperties(definition map[string]interface{}) map[string]interface{} {
+	properties, _ := definition["properties"].(map[string]interface{})
+	return properties
+}
+
+// stringSet returns the strings of a JSON array as a set
+func stringSet(value interface{}) map[string]bool {
+	set := make(map[string]bool)
+
+	values, _ := value.([]interface{})
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			set[s] = true
+		}
+	}
+
+	return set
+}
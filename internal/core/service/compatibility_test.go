@@ -0,0 +1,73 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestCompatibilityIssues(t *testing.T) {
+	previous := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"address": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"city": map[string]interface{}{"type": "string"}},
+			},
+		},
+		"required":             []interface{}{"name"},
+		"additionalProperties": false,
+	}
+
+	addsRequired := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"age":  map[string]interface{}{"type": "integer"},
+			"address": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"city": map[string]interface{}{"type": "integer"}},
+			},
+		},
+		"required": []interface{}{"name", "age"},
+	}
+
+	tests := []struct {
+		name string
+		mode domain.SchemaCompatibility
+		next map[string]interface{}
+		want []string
+	}{
+		{name: "Unchanged", mode: domain.SchemaCompatibilityFull, next: previous, want: nil},
+		{name: "None", mode: domain.SchemaCompatibilityNone, next: addsRequired, want: nil},
+		{
+			name: "Backward",
+			mode: domain.SchemaCompatibilityBackward,
+			next: addsRequired,
+			want: []string{
+				"backward: /age is required by the new version but not by the previous version",
+				"backward: /address/city: type is string in the previous version but integer in the new version",
+			},
+		},
+		{
+			name: "Forward",
+			mode: domain.SchemaCompatibilityForward,
+			next: addsRequired,
+			want: []string{
+				"forward: /age is allowed by the new version but not by the previous version",
+				"forward: /address/city: type is integer in the new version but string in the previous version",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compatibilityIssues(tt.mode, previous, tt.next)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("compatibilityIssues() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
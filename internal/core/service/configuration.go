@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
 	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
@@ -9,79 +11,781 @@ import (
 )
 
 type ConfigurationServicer interface {
-	PutConfiguration(ctx context.Context, config *domain.Config) (*domain.Config, error)
-	GetConfiguration(ctx context.Context, name string) (*domain.Config, error)
-	ListConfigurations(ctx context.Context, skip, limit uint64) ([]*domain.Config, error)
-	ListConfigurationVersions(ctx context.Context, name string, skip, limit uint64) ([]*domain.Config, error)
-	GetConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error)
-	RollbackConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error)
+	PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error)
+	ValidateConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error)
+	PatchConfiguration(ctx context.Context, namespace, name string, patchType domain.PatchType, patch []byte, expectedVersion int) (*domain.Config, error)
+	PutConfigurationOverlay(ctx context.Context, namespace, name, environment string, overlay map[string]interface{}, expectedVersion int) (*domain.Config, error)
+	PutConfigurationLabels(ctx context.Context, namespace, name string, labels, annotations map[string]string, expectedVersion int) (*domain.Config, error)
+	GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error)
+	WaitForConfiguration(ctx context.Context, namespace, name string, afterVersion int, timeout time.Duration) (*domain.Config, error)
+	ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) (*domain.ConfigPage, error)
+	ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) (*domain.ConfigPage, error)
+	GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error)
+	RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error)
+	PromoteConfigurationVersion(ctx context.Context, namespace, name string, version int, from, to string) (*domain.Config, error)
+	MoveConfigurationAlias(ctx context.Context, namespace, name, alias string, version, expectedRevision int) (*domain.Alias, error)
+	ClearConfigurationAlias(ctx context.Context, namespace, name, alias string, expectedRevision int) (*domain.Alias, error)
+	GetConfigurationAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, *domain.Config, error)
+	ListConfigurationAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error)
+	ListConfigurationAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error)
+	DiffConfigurationVersions(ctx context.Context, namespace, name string, from, to int, format domain.DiffFormat) (*domain.ConfigDiff, error)
+	DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int) (*domain.Config, error)
+	RestoreConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error)
+	PurgeConfiguration(ctx context.Context, namespace, name string) error
 }
 
 type configurationService struct {
-	repo    port.ConfigurationRepository
-	schemas map[string]*jsonschema.Schema
+	repo          port.ConfigurationRepository
+	namespaceRepo port.NamespaceRepository
+	schemaRepo    port.SchemaRepository
+	events        *EventBus
+
+	// locks serialize each write with the event it publishes, so that the events of a configuration are in version
+	// order, and with the schema changes of its type
+	locks *WriteLocks
+
+	mu       sync.Mutex
+	compiled map[schemaKey]compiledSchema // schema versions are immutable, so each is only compiled once
+}
+
+// schemaKey identifies a version of the schema of a type
+type schemaKey struct {
+	schemaType string
+	version    int
+}
+
+// compiledSchema is a schema version compiled from the definition registered at createdAt.
+// A type that is deleted and registered again starts over at version 1, the timestamp tells the two apart
+type compiledSchema struct {
+	createdAt time.Time
+	schema    *jsonschema.Schema
 }
 
-func NewConfigurationService(repo port.ConfigurationRepository) ConfigurationServicer {
-	schemas := make(map[string]*jsonschema.Schema)
+func NewConfigurationService(repo port.ConfigurationRepository, namespaceRepo port.NamespaceRepository, schemaRepo port.SchemaRepository, events *EventBus, locks *WriteLocks) ConfigurationServicer {
+	return &configurationService{
+		repo:          repo,
+		namespaceRepo: namespaceRepo,
+		schemaRepo:    schemaRepo,
+		events:        events,
+		locks:         locks,
+		compiled:      make(map[schemaKey]compiledSchema),
+	}
+}
 
-	// Compile schema
-	compiler := jsonschema.NewCompiler()
-	schema, err := compiler.Compile([]byte(`{
-			    "type": "object",
-			    "properties": {
-			        "name": {"type": "string", "minLength": 1},
-			        "age": {"type": "integer", "minimum": 0}
-			    },
-			    "required": ["name","age"]
-			}`))
+// lockWrites locks the writes to a configuration, returning the function that unlocks them
+func (s *configurationService) lockWrites(namespace, name string) func() {
+	return s.locks.lock(namespace, name)
+}
+
+// schema looks up the latest schema version of a config type, compiling it the first time it is used
+func (s *configurationService) schema(ctx context.Context, configType string) (*domain.Schema, *jsonschema.Schema, error) {
+	registered, err := s.schemaRepo.GetSchema(ctx, configType)
+	if err == domain.ErrDataNotFound {
+		return nil, nil, domain.ErrInvalidSchema // Schema not found for the config type
+	}
 	if err != nil {
-		panic(err) // Handle schema compilation error
+		return nil, nil, err
 	}
 
-	schemas["person"] = schema
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return &configurationService{
-		repo,
-		schemas,
+	key := schemaKey{registered.Type, registered.Version}
+	if c, ok := s.compiled[key]; ok && c.createdAt.Equal(registered.CreatedAt) {
+		return registered, c.schema, nil
+	}
+
+	schema, err := compileSchema(registered)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.compiled[key] = compiledSchema{registered.CreatedAt, schema}
+
+	return registered, schema, nil
+}
+
+// validate validates the value of a config against the latest schema of its type, and so does its effective value in
+// every environment. It records the schema version it was validated against
+func (s *configurationService) validate(ctx context.Context, config *domain.Config) error {
+	registered, schema, err := s.schema(ctx, config.Type)
+	if err != nil {
+		return err
+	}
+
+	if err := validateValue(schema, config.Value); err != nil {
+		return err // Lists every field that doesn't match the schema
+	}
+
+	if err := domain.ValidateLabels(config.Labels); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateAnnotations(config.Annotations); err != nil {
+		return err
+	}
+
+	for environment := range config.Overlays {
+		if err := validateValue(schema, config.ForEnvironment(environment).Value); err != nil {
+			return err
+		}
+	}
+
+	config.SchemaVersion = registered.Version
+
+	return nil
+}
+
+// revalidate checks that a version about to be copied as the latest version of a configuration still passes the latest
+// schema of its type, which may have changed or been deleted since the version was written. A version that doesn't
+// exist or is a tombstone is left to the repository to reject. The caller must hold the write lock of the configuration
+func (s *configurationService) revalidate(ctx context.Context, namespace, name string, version int) error {
+	config, err := s.repo.GetConfigurationVersion(ctx, namespace, name, version)
+	if err == domain.ErrDataNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if config.Deleted {
+		return nil
+	}
+
+	copied := *config // validate records the schema version on the config, the stored version is left as it was
+	return s.validate(ctx, &copied)
+}
+
+// checkNamespace fails with domain.ErrNamespaceNotFound unless a configuration can be written to a namespace
+func (s *configurationService) checkNamespace(ctx context.Context, namespace string) error {
+	_, err := s.namespaceRepo.GetNamespace(ctx, namespace)
+	if err == domain.ErrDataNotFound {
+		return domain.ErrNamespaceNotFound
+	}
+
+	return err
+}
+
+// changeFromContext describes the change a request makes: the authenticated caller and the reason it gives, if any
+func changeFromContext(ctx context.Context) domain.Change {
+	change := domain.Change{
+		Message: domain.ChangeMessageFromContext(ctx),
+	}
+
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		change.CreatedBy = principal.Name()
+	}
+
+	return change
+}
+
+// PutConfiguration replaces the base value of a configuration, the overlays of its environments are kept and so are
+// its labels and annotations unless the config sets them
+func (s *configurationService) PutConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	if err := s.checkNamespace(ctx, config.Namespace); err != nil {
+		return nil, err
+	}
+
+	defer s.lockWrites(config.Namespace, config.Name)()
+
+	if err := s.keep(ctx, config); err != nil {
+		return nil, err
+	}
+
+	return s.put(ctx, domain.ConfigEventPut, config, expectedVersion)
+}
+
+// keep sets what a write of the base value carries over from the latest version of a configuration: its overlays,
+// and its labels and annotations where the config leaves them nil. A new or deleted configuration has none to keep
+func (s *configurationService) keep(ctx context.Context, config *domain.Config) error {
+	config.Overlays = nil
+
+	latest, err := s.repo.GetConfiguration(ctx, config.Namespace, config.Name)
+	if err == domain.ErrDataNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	config.Overlays = latest.Overlays
+	if config.Labels == nil {
+		config.Labels = latest.Labels
+	}
+	if config.Annotations == nil {
+		config.Annotations = latest.Annotations
+	}
+
+	return nil
+}
+
+// put validates and stores a new version of a configuration, publishing the event of the write.
+// The caller must hold the write lock of the configuration
+func (s *configurationService) put(ctx context.Context, eventType domain.ConfigEventType, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	if err := s.validate(ctx, config); err != nil {
+		return nil, err
+	}
+
+	change := changeFromContext(ctx)
+	config.CreatedBy = change.CreatedBy
+	config.ChangeMessage = change.Message
+
+	createdConfig, err := s.repo.PutConfiguration(ctx, config, expectedVersion)
+	if err != nil {
+		return nil, err
 	}
+
+	s.events.publish(eventType, createdConfig)
+
+	return createdConfig, nil
+}
+
+// withOverlay returns a copy of the latest version of a configuration, to be written as its next version, whose
+// environment has another overlay. An empty overlay is removed, the environment then gets the base value
+func withOverlay(latest *domain.Config, environment string, overlay map[string]interface{}) *domain.Config {
+	config := &domain.Config{
+		Namespace:   latest.Namespace,
+		Name:        latest.Name,
+		Type:        latest.Type,
+		Value:       latest.Value,
+		Overlays:    make(map[string]map[string]interface{}, len(latest.Overlays)+1),
+		Environment: environment,
+		Labels:      latest.Labels,
+		Annotations: latest.Annotations,
+	}
+
+	for env, o := range latest.Overlays {
+		config.Overlays[env] = o
+	}
+
+	if len(overlay) > 0 {
+		config.Overlays[environment] = overlay
+	} else {
+		delete(config.Overlays, environment)
+	}
+
+	return config
 }
 
-func (s *configurationService) PutConfiguration(ctx context.Context, config *domain.Config) (*domain.Config, error) {
+// PutConfigurationOverlay replaces the overlay of an environment on the latest version of a configuration and stores
+// the result as a new version, once the effective value of the environment is validated
+func (s *configurationService) PutConfigurationOverlay(ctx context.Context, namespace, name, environment string, overlay map[string]interface{}, expectedVersion int) (*domain.Config, error) {
+	if !domain.ValidEnvironmentName(environment) {
+		return nil, domain.ErrInvalidEnvironment
+	}
+
+	defer s.lockWrites(namespace, name)()
+
+	latest, err := s.repo.GetConfiguration(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && expectedVersion != latest.Version {
+		return nil, domain.ErrVersionConflict // The client changed a version that is no longer the latest
+	}
+
+	return s.put(ctx, domain.ConfigEventPut, withOverlay(latest, environment, overlay), latest.Version)
+}
 
-	schema, ok := s.schemas[config.Type]
+// PutConfigurationLabels replaces the labels and the annotations of the latest version of a configuration and stores
+// the result as a new version with the same value. Either left nil is kept as it was, an empty one is removed
+func (s *configurationService) PutConfigurationLabels(ctx context.Context, namespace, name string, labels, annotations map[string]string, expectedVersion int) (*domain.Config, error) {
+	defer s.lockWrites(namespace, name)()
+
+	latest, err := s.repo.GetConfiguration(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && expectedVersion != latest.Version {
+		return nil, domain.ErrVersionConflict // The client relabeled a version that is no longer the latest
+	}
+
+	config := &domain.Config{
+		Namespace:   latest.Namespace,
+		Name:        latest.Name,
+		Type:        latest.Type,
+		Value:       latest.Value,
+		Overlays:    latest.Overlays,
+		Labels:      latest.Labels,
+		Annotations: latest.Annotations,
+	}
+
+	if labels != nil {
+		config.Labels = labels
+	}
+	if annotations != nil {
+		config.Annotations = annotations
+	}
+
+	return s.put(ctx, domain.ConfigEventPut, config, latest.Version)
+}
+
+// ValidateConfiguration runs the checks of PutConfiguration without storing anything,
+// returning the version PutConfiguration would create
+func (s *configurationService) ValidateConfiguration(ctx context.Context, config *domain.Config, expectedVersion int) (*domain.Config, error) {
+	if err := s.checkNamespace(ctx, config.Namespace); err != nil {
+		return nil, err
+	}
+
+	validated := *config // Leave the caller's config as it was
+	if err := s.keep(ctx, &validated); err != nil {
+		return nil, err
+	}
+
+	if err := s.validate(ctx, &validated); err != nil {
+		return nil, err
+	}
 
-	if !ok {
-		return nil, domain.ErrInvalidSchema // Schema not found for the config type
+	latestVersion, err := s.latestVersion(ctx, config.Namespace, config.Name)
+	if err != nil {
+		return nil, err
 	}
 
-	result := schema.ValidateMap(config.Value)
-	if !result.IsValid() {
-		/*
-			for field, err := range result.Errors {
-				fmt.Printf("- %s: %s\n", field, err.Message)
-			}
-		*/
-		return nil, domain.ErrInvalidSchema
+	if expectedVersion != 0 && expectedVersion != latestVersion {
+		return nil, domain.ErrVersionConflict // The same check the repository makes on write
 	}
 
-	return s.repo.PutConfiguration(ctx, config)
+	validated.Version = latestVersion + 1
+	validated.RollbackedVersion = 0
+
+	return &validated, nil
 }
 
-func (s *configurationService) GetConfiguration(ctx context.Context, name string) (*domain.Config, error) {
-	return s.repo.GetConfiguration(ctx, name)
+// latestVersion returns the number of the latest version of a configuration, or 0 for a new one
+func (s *configurationService) latestVersion(ctx context.Context, namespace, name string) (int, error) {
+	last, err := s.lastVersion(ctx, namespace, name)
+	if err != nil || last == nil {
+		return 0, err
+	}
+
+	return last.Version, nil
 }
 
-func (s *configurationService) ListConfigurations(ctx context.Context, skip, limit uint64) ([]*domain.Config, error) {
-	return s.repo.ListConfigurations(ctx, skip, limit)
+// lastVersion returns the version at the end of the history of a configuration, or nil for a new one.
+// A deleted configuration is missing from GetConfiguration, its last version is the tombstone at the end of its history
+func (s *configurationService) lastVersion(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	latest, err := s.repo.GetConfiguration(ctx, namespace, name)
+	if err == nil {
+		return latest, nil
+	}
+	if err != domain.ErrDataNotFound {
+		return nil, err
+	}
+
+	// The latest version of a deleted configuration is its tombstone
+	versions, _, err := s.repo.ListConfigurationVersions(ctx, namespace, name, domain.VersionFilter{}, domain.PageRequest{Sort: domain.SortByVersion, Order: domain.SortDescending, Limit: 1})
+	if err == domain.ErrDataNotFound {
+		return nil, nil // A new config starts at 1
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	return versions[0], nil
 }
 
-func (s *configurationService) ListConfigurationVersions(ctx context.Context, name string, skip, limit uint64) ([]*domain.Config, error) {
-	return s.repo.ListConfigurationVersions(ctx, name, skip, limit)
+// PatchConfiguration applies a patch to the value of the latest version and stores the result as a new version.
+// The write fails with domain.ErrVersionConflict if another version is written after the one that was patched
+func (s *configurationService) PatchConfiguration(ctx context.Context, namespace, name string, patchType domain.PatchType, patch []byte, expectedVersion int) (*domain.Config, error) {
+	latest, err := s.repo.GetConfiguration(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != 0 && expectedVersion != latest.Version {
+		return nil, domain.ErrVersionConflict // The client patched a version that is no longer the latest
+	}
+
+	value, err := applyPatch(patchType, latest.Value, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &domain.Config{
+		Namespace: latest.Namespace,
+		Name:      latest.Name,
+		Type:      latest.Type,
+		Value:     value,
+	}
+
+	return s.PutConfiguration(ctx, config, latest.Version)
 }
-func (s *configurationService) GetConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error) {
-	return s.repo.GetConfigurationVersion(ctx, name, version)
+
+func (s *configurationService) GetConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	return s.repo.GetConfiguration(ctx, namespace, name)
 }
-func (s *configurationService) RollbackConfigurationVersion(ctx context.Context, name string, version int) (*domain.Config, error) {
-	return s.repo.RollbackConfigurationVersion(ctx, name, version)
+
+// WaitForConfiguration returns the latest version of a configuration once it is newer than afterVersion. It fails with
+// domain.ErrNotModified if no newer version is written within timeout, and domain.ErrDataNotFound if the configuration
+// doesn't exist or is deleted meanwhile
+func (s *configurationService) WaitForConfiguration(ctx context.Context, namespace, name string, afterVersion int, timeout time.Duration) (*domain.Config, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel() // Ends the watch, so a waiting client holds no goroutine once it returns
+
+	// Watch before reading, so that a version written in between isn't missed
+	events, err := s.events.Watch(waitCtx, domain.WatchFilter{Namespace: namespace, Name: name}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := s.repo.GetConfiguration(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Version > afterVersion {
+		return config, nil
+	}
+
+	for event := range events {
+		switch {
+		case event.Type == domain.ConfigEventDelete || event.Type == domain.ConfigEventPurge:
+			return nil, domain.ErrDataNotFound
+		case event.Config.Version > afterVersion:
+			return event.Config, nil
+		}
+	}
+
+	// The watch ended, because the wait timed out, the caller gave up, or it fell behind
+	switch {
+	case ctx.Err() != nil:
+		return nil, ctx.Err()
+	case waitCtx.Err() != nil:
+		return nil, domain.ErrNotModified
+	default:
+		return s.repo.GetConfiguration(ctx, namespace, name)
+	}
+}
+
+// lookahead asks a repository for one more item than a page holds, which tells whether another page follows
+func lookahead(page domain.PageRequest) domain.PageRequest {
+	page.Limit++
+	return page
+}
+
+// newConfigPage trims the lookahead item off a page of configurations, the next page then starts after the last item
+func newConfigPage(configs []*domain.Config, total uint64, page domain.PageRequest) *domain.ConfigPage {
+	if uint64(len(configs)) <= page.Limit || page.Limit == 0 {
+		return &domain.ConfigPage{Configs: configs, Total: total}
+	}
+
+	configs = configs[:page.Limit]
+
+	return &domain.ConfigPage{Configs: configs, Next: domain.NewCursor(configs[len(configs)-1], page), Total: total}
+}
+
+func (s *configurationService) ListConfigurations(ctx context.Context, namespace string, query domain.ConfigQuery, page domain.PageRequest) (*domain.ConfigPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	page, err := page.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	configs, total, err := s.repo.ListConfigurations(ctx, namespace, query, lookahead(page))
+	if err != nil {
+		return nil, err
+	}
+
+	return newConfigPage(configs, total, page), nil
+}
+
+func (s *configurationService) ListConfigurationVersions(ctx context.Context, namespace, name string, filter domain.VersionFilter, page domain.PageRequest) (*domain.ConfigPage, error) {
+	page, err := page.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	versions, total, err := s.repo.ListConfigurationVersions(ctx, namespace, name, filter, lookahead(page))
+	if err != nil {
+		return nil, err
+	}
+
+	return newConfigPage(versions, total, page), nil
+}
+func (s *configurationService) GetConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	return s.repo.GetConfigurationVersion(ctx, namespace, name, version)
+}
+
+// RollbackConfigurationVersion copies a version of a configuration as its new latest version. It fails with
+// domain.ErrNamespaceNotFound if the namespace was deleted, and with domain.ErrDataNotFound if the configuration is
+// deleted
+func (s *configurationService) RollbackConfigurationVersion(ctx context.Context, namespace, name string, version int) (*domain.Config, error) {
+	if err := s.checkNamespace(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	defer s.lockWrites(namespace, name)()
+
+	// A deleted configuration is brought back by a restore, not by a rollback
+	if _, err := s.repo.GetConfiguration(ctx, namespace, name); err != nil {
+		return nil, err
+	}
+
+	if err := s.revalidate(ctx, namespace, name, version); err != nil {
+		return nil, err
+	}
+
+	config, err := s.repo.RollbackConfigurationVersion(ctx, namespace, name, version, changeFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.publish(domain.ConfigEventRollback, config)
+
+	return config, nil
+}
+
+// PromoteConfigurationVersion copies the overlay an environment had in a version of a configuration to another
+// environment, as a new version that records where it was promoted from. The base value is shared by every
+// environment, so only the overlay is copied
+func (s *configurationService) PromoteConfigurationVersion(ctx context.Context, namespace, name string, version int, from, to string) (*domain.Config, error) {
+	if !domain.ValidEnvironmentName(from) || !domain.ValidEnvironmentName(to) {
+		return nil, domain.ErrInvalidEnvironment
+	}
+
+	if from == to {
+		return nil, domain.ErrInvalidPromotion
+	}
+
+	defer s.lockWrites(namespace, name)()
+
+	source, err := s.repo.GetConfigurationVersion(ctx, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.Deleted {
+		return nil, domain.ErrDataNotFound // A tombstone has no overlay to promote
+	}
+
+	latest, err := s.repo.GetConfiguration(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	config := withOverlay(latest, to, source.Overlays[from])
+	config.PromotedFrom = from
+	config.PromotedVersion = version
+
+	return s.put(ctx, domain.ConfigEventPromote, config, latest.Version)
+}
+
+// MoveConfigurationAlias points an alias of a configuration at one of its versions, creating the alias if it was never
+// moved. It fails with domain.ErrAliasConflict if expectedRevision is not zero and the alias was moved since, and with
+// domain.ErrDataNotFound if the configuration is deleted or the version doesn't exist or is a tombstone
+func (s *configurationService) MoveConfigurationAlias(ctx context.Context, namespace, name, alias string, version, expectedRevision int) (*domain.Alias, error) {
+	if !domain.ValidAliasName(alias) || version <= 0 {
+		return nil, domain.ErrInvalidAlias
+	}
+
+	defer s.lockWrites(namespace, name)()
+
+	if _, err := s.repo.GetConfiguration(ctx, namespace, name); err != nil {
+		return nil, err
+	}
+
+	target, err := s.repo.GetConfigurationVersion(ctx, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.Deleted {
+		return nil, domain.ErrDataNotFound // A tombstone has no value to read through the alias
+	}
+
+	return s.moveAlias(ctx, namespace, name, alias, version, expectedRevision)
+}
+
+// ClearConfigurationAlias points an alias of a configuration at no version, its revisions are kept. It fails with
+// domain.ErrDataNotFound if the alias isn't set
+func (s *configurationService) ClearConfigurationAlias(ctx context.Context, namespace, name, alias string, expectedRevision int) (*domain.Alias, error) {
+	if !domain.ValidAliasName(alias) {
+		return nil, domain.ErrInvalidAlias
+	}
+
+	defer s.lockWrites(namespace, name)()
+
+	current, err := s.repo.GetAlias(ctx, namespace, name, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if !current.IsSet() {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return s.moveAlias(ctx, namespace, name, alias, 0, expectedRevision)
+}
+
+// moveAlias records the move of an alias by the caller. The caller must hold the write lock of the configuration
+func (s *configurationService) moveAlias(ctx context.Context, namespace, name, alias string, version, expectedRevision int) (*domain.Alias, error) {
+	change := changeFromContext(ctx)
+
+	return s.repo.MoveAlias(ctx, &domain.Alias{
+		Namespace:     namespace,
+		Name:          name,
+		Alias:         alias,
+		Version:       version,
+		MovedBy:       change.CreatedBy,
+		ChangeMessage: change.Message,
+	}, expectedRevision)
+}
+
+// GetConfigurationAlias returns an alias of a configuration and the version it points at. It fails with
+// domain.ErrDataNotFound if the configuration is deleted or the alias isn't set
+func (s *configurationService) GetConfigurationAlias(ctx context.Context, namespace, name, alias string) (*domain.Alias, *domain.Config, error) {
+	if _, err := s.repo.GetConfiguration(ctx, namespace, name); err != nil {
+		return nil, nil, err
+	}
+
+	found, err := s.repo.GetAlias(ctx, namespace, name, alias)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !found.IsSet() {
+		return nil, nil, domain.ErrDataNotFound
+	}
+
+	config, err := s.repo.GetConfigurationVersion(ctx, namespace, name, found.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return found, config, nil
+}
+
+// ListConfigurationAliases fails with domain.ErrDataNotFound if the configuration is deleted
+func (s *configurationService) ListConfigurationAliases(ctx context.Context, namespace, name string) ([]*domain.Alias, error) {
+	if _, err := s.repo.GetConfiguration(ctx, namespace, name); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListAliases(ctx, namespace, name)
+}
+
+// ListConfigurationAliasRevisions lists every move of an alias, including those that cleared it. It fails with
+// domain.ErrDataNotFound if the alias was never moved
+func (s *configurationService) ListConfigurationAliasRevisions(ctx context.Context, namespace, name, alias string, skip, limit uint64) ([]*domain.Alias, error) {
+	if _, err := s.repo.GetAlias(ctx, namespace, name, alias); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListAliasRevisions(ctx, namespace, name, alias, skip, limit)
+}
+
+// DiffConfigurationVersions compares two versions of a configuration, to being 0 compares with the latest version
+func (s *configurationService) DiffConfigurationVersions(ctx context.Context, namespace, name string, from, to int, format domain.DiffFormat) (*domain.ConfigDiff, error) {
+	if format != domain.DiffFormatPatch && format != domain.DiffFormatUnified {
+		return nil, domain.ErrUnsupportedDiffFormat
+	}
+
+	fromConfig, err := s.repo.GetConfigurationVersion(ctx, namespace, name, from)
+	if err != nil {
+		return nil, err
+	}
+
+	var toConfig *domain.Config
+	if to == 0 {
+		toConfig, err = s.repo.GetConfiguration(ctx, namespace, name)
+	} else {
+		toConfig, err = s.repo.GetConfigurationVersion(ctx, namespace, name, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &domain.ConfigDiff{
+		Namespace:   namespace,
+		Name:        name,
+		FromVersion: fromConfig.Version,
+		ToVersion:   toConfig.Version,
+		FromType:    fromConfig.Type,
+		ToType:      toConfig.Type,
+	}
+
+	switch format {
+	case domain.DiffFormatPatch:
+		diff.Operations, err = diffValues(fromConfig.Value, toConfig.Value)
+	case domain.DiffFormatUnified:
+		diff.Unified, err = unifiedDiff(fromConfig, toConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func (s *configurationService) DeleteConfiguration(ctx context.Context, namespace, name string, expectedVersion int) (*domain.Config, error) {
+	defer s.lockWrites(namespace, name)()
+
+	tombstone, err := s.repo.DeleteConfiguration(ctx, namespace, name, expectedVersion, changeFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.publish(domain.ConfigEventDelete, tombstone)
+
+	return tombstone, nil
+}
+
+// RestoreConfiguration fails with domain.ErrNamespaceNotFound if the namespace of the configuration was deleted after it
+func (s *configurationService) RestoreConfiguration(ctx context.Context, namespace, name string) (*domain.Config, error) {
+	if err := s.checkNamespace(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	defer s.lockWrites(namespace, name)()
+
+	last, err := s.lastVersion(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil && last.Deleted {
+		// A configuration can't be deleted twice in a row, so the version before the tombstone has a value
+		if err := s.revalidate(ctx, namespace, name, last.Version-1); err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := s.repo.RestoreConfiguration(ctx, namespace, name, changeFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.publish(domain.ConfigEventRestore, config)
+
+	return config, nil
+}
+
+func (s *configurationService) PurgeConfiguration(ctx context.Context, namespace, name string) error {
+	defer s.lockWrites(namespace, name)()
+
+	// The type of the last version, a tombstone if the configuration is deleted, lets watchers of a type see the purge
+	purged := &domain.Config{Namespace: namespace, Name: name}
+	last, err := s.lastVersion(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	if last != nil {
+		purged.Type = last.Type
+	}
+
+	if err := s.repo.PurgeConfiguration(ctx, namespace, name); err != nil {
+		return err
+	}
+
+	s.events.publish(domain.ConfigEventPurge, purged)
+
+	return nil
 }
@@ -2,20 +2,26 @@ package service
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
 	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestPutConfigurationSuccess(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1, SchemaVersion: 1}, 0).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
 
-	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1})
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}, 0)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -27,9 +33,11 @@ func TestPutConfigurationSuccess(t *testing.T) {
 func TestPutConfigurationUkknownType(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Name: "test-config", Type: "unknown-schema", Value: map[string]interface{}{"name": "John"}, Version: 1})
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
+
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "unknown-schema", Value: map[string]interface{}{"name": "John"}, Version: 1}, 0)
 	if config != nil || err == nil {
 		t.Fatalf("expected error, got config: %v, error: %v", config, err)
 	}
@@ -42,26 +50,39 @@ func TestPutConfigurationUkknownType(t *testing.T) {
 func TestPutConfigurationInvalidValue(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
 
-	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John"}, Version: 1})
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John"}, Version: 1}, 0)
 	if config != nil || err == nil {
 		t.Fatalf("expected error, got config: %v, error: %v", config, err)
 	}
 
-	if err != domain.ErrInvalidSchema {
-		t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
+	if !errors.Is(err, domain.ErrInvalidSchema) {
+		t.Fatalf("expected error %v, got %v", domain.ErrInvalidSchema, err)
+	}
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	want := []domain.Violation{{Pointer: "/age", Keyword: "required", Message: "Required property 'age' is missing"}}
+	if !reflect.DeepEqual(validationErr.Violations, want) {
+		t.Fatalf("expected violations %v, got %v", want, validationErr.Violations)
 	}
 }
 
 func TestPutConfigurationError(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Name: "error-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "error-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1, SchemaVersion: 1}, 0).Return(nil, domain.ErrDataNotFound)
 
-	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Name: "error-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1})
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "error-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}, 0)
 	if config != nil || err == nil {
 		t.Fatalf("expected error, got config: %v, error: %v", config, err)
 	}
@@ -71,16 +92,45 @@ func TestPutConfigurationError(t *testing.T) {
 	}
 }
 
+func TestPutConfigurationUnknownNamespace(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: "payments", Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}}, 0)
+	if config != nil || err != domain.ErrNamespaceNotFound {
+		t.Fatalf("expected error %v, got config: %v, error: %v", domain.ErrNamespaceNotFound, config, err)
+	}
+}
+
+func TestPutConfigurationVersionConflict(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, SchemaVersion: 1}, 3).Return(nil, domain.ErrVersionConflict)
+
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}}, 3)
+	if config != nil || err == nil {
+		t.Fatalf("expected error, got config: %v, error: %v", config, err)
+	}
+
+	if err != domain.ErrVersionConflict {
+		t.Fatalf("expected error %v, got %v", domain.ErrVersionConflict, err)
+	}
+}
+
 func TestGetConfiguration(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("GetConfiguration", context.Background(), "test-config").Return(&domain.Config{Name: "test-config", Version: 1}, nil)
-	mockRepo.On("GetConfiguration", context.Background(), "non-existent-config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Name: "test-config", Version: 1}, nil)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "non-existent-config").Return(nil, domain.ErrDataNotFound)
 
 	t.Run("Success", func(t *testing.T) {
-		config, err := configurationService.GetConfiguration(context.Background(), "test-config")
+		config, err := configurationService.GetConfiguration(context.Background(), domain.DefaultNamespace, "test-config")
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -90,7 +140,7 @@ func TestGetConfiguration(t *testing.T) {
 	})
 
 	t.Run("Error", func(t *testing.T) {
-		config, err := configurationService.GetConfiguration(context.Background(), "non-existent-config")
+		config, err := configurationService.GetConfiguration(context.Background(), domain.DefaultNamespace, "non-existent-config")
 		if config != nil || err == nil {
 			t.Fatalf("expected error, got config: %v, error: %v", config, err)
 		}
@@ -104,20 +154,71 @@ func TestGetConfiguration(t *testing.T) {
 func TestListConfigurations(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("ListConfigurations", context.Background(), uint64(0), uint64(10)).Return([]*domain.Config{
+	// The repository is asked for one configuration more than the page holds, to tell whether a next page follows
+	mockRepo.On("ListConfigurations", context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 11}).Return([]*domain.Config{
 		{Name: "config1", Version: 1},
 		{Name: "config2", Version: 2},
-	}, nil)
+	}, uint64(2), nil)
 
 	t.Run("Success", func(t *testing.T) {
-		configs, err := configurationService.ListConfigurations(context.Background(), 0, 10)
+		configs, err := configurationService.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Limit: 10})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(configs.Configs) != 2 || configs.Configs[0].Name != "config1" || configs.Configs[1].Name != "config2" {
+			t.Fatalf("expected two configs with names 'config1' and 'config2', got %v", configs.Configs)
+		}
+		if configs.Next != nil {
+			t.Fatalf("expected no next page, got %v", configs.Next)
+		}
+		if configs.Total != 2 {
+			t.Fatalf("expected a total of 2, got %d", configs.Total)
+		}
+	})
+
+	t.Run("NextPage", func(t *testing.T) {
+		page := domain.PageRequest{Sort: domain.SortByVersion, Order: domain.SortDescending, Limit: 1}
+		mockRepo.On("ListConfigurations", context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Sort: domain.SortByVersion, Order: domain.SortDescending, Limit: 2}).Return([]*domain.Config{
+			{Namespace: domain.DefaultNamespace, Name: "config2", Version: 2},
+			{Namespace: domain.DefaultNamespace, Name: "config1", Version: 1},
+		}, uint64(2), nil).Once()
+
+		configs, err := configurationService.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, page)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
-		if len(configs) != 2 || configs[0].Name != "config1" || configs[1].Name != "config2" {
-			t.Fatalf("expected two configs with names 'config1' and 'config2', got %v", configs)
+		if len(configs.Configs) != 1 || configs.Configs[0].Name != "config2" {
+			t.Fatalf("expected a page with config2, got %v", configs.Configs)
+		}
+		if configs.Next == nil || configs.Next.Name != "config2" || configs.Next.Sort != domain.SortByVersion || configs.Next.Order != domain.SortDescending {
+			t.Fatalf("expected the next page to start after config2, got %v", configs.Next)
+		}
+		if configs.Total != 2 {
+			t.Fatalf("expected the total to count every page, got %d", configs.Total)
+		}
+	})
+
+	t.Run("InvalidSort", func(t *testing.T) {
+		if _, err := configurationService.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Sort: "type", Limit: 10}); err != domain.ErrInvalidSort {
+			t.Fatalf("expected error %v, got %v", domain.ErrInvalidSort, err)
+		}
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		now := time.Now()
+		for _, query := range []domain.ConfigQuery{{NameGlob: "payments_["}, {CreatedAfter: now, CreatedBefore: now}} {
+			if _, err := configurationService.ListConfigurations(context.Background(), domain.DefaultNamespace, query, domain.PageRequest{Limit: 10}); err != domain.ErrInvalidQuery {
+				t.Fatalf("expected error %v for %v, got %v", domain.ErrInvalidQuery, query, err)
+			}
+		}
+	})
+
+	t.Run("CursorOfAnotherOrder", func(t *testing.T) {
+		cursor := &domain.Cursor{Sort: domain.SortByName, Order: domain.SortAscending, Namespace: domain.DefaultNamespace, Name: "config1"}
+		if _, err := configurationService.ListConfigurations(context.Background(), domain.DefaultNamespace, domain.ConfigQuery{}, domain.PageRequest{Order: domain.SortDescending, Cursor: cursor, Limit: 10}); err != domain.ErrInvalidCursor {
+			t.Fatalf("expected error %v, got %v", domain.ErrInvalidCursor, err)
 		}
 	})
 }
@@ -125,20 +226,35 @@ func TestListConfigurations(t *testing.T) {
 func TestListConfigurationVersions(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("ListConfigurationVersions", context.Background(), "test-config", uint64(0), uint64(10)).Return([]*domain.Config{
+	mockRepo.On("ListConfigurationVersions", context.Background(), domain.DefaultNamespace, "test-config", domain.VersionFilter{}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 11}).Return([]*domain.Config{
 		{Name: "test-config", Version: 1},
 		{Name: "test-config", Version: 2},
-	}, nil)
+	}, uint64(2), nil)
 
 	t.Run("Success", func(t *testing.T) {
-		configs, err := configurationService.ListConfigurationVersions(context.Background(), "test-config", 0, 10)
+		configs, err := configurationService.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, "test-config", domain.VersionFilter{}, domain.PageRequest{Limit: 10})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(configs.Configs) != 2 || configs.Configs[0].Version != 1 || configs.Configs[1].Version != 2 || configs.Next != nil {
+			t.Fatalf("expected two versions of 'test-config' on the last page, got %v", configs)
+		}
+	})
+
+	t.Run("NextPage", func(t *testing.T) {
+		mockRepo.On("ListConfigurationVersions", context.Background(), domain.DefaultNamespace, "test-config", domain.VersionFilter{}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 2}).Return([]*domain.Config{
+			{Name: "test-config", Version: 1},
+			{Name: "test-config", Version: 2},
+		}, uint64(2), nil).Once()
+
+		configs, err := configurationService.ListConfigurationVersions(context.Background(), domain.DefaultNamespace, "test-config", domain.VersionFilter{}, domain.PageRequest{Limit: 1})
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
-		if len(configs) != 2 || configs[0].Version != 1 || configs[1].Version != 2 {
-			t.Fatalf("expected two versions of 'test-config', got %v", configs)
+		if len(configs.Configs) != 1 || configs.Next == nil || configs.Next.Version != 1 || configs.Total != 2 {
+			t.Fatalf("expected version 1 and a next page after it, got %v", configs)
 		}
 	})
 }
@@ -146,13 +262,13 @@ func TestListConfigurationVersions(t *testing.T) {
 func TestGetConfigurationVersion(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("GetConfigurationVersion", context.Background(), "test-config", 1).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
-	mockRepo.On("GetConfigurationVersion", context.Background(), "test-config", 999).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 1).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 999).Return(nil, domain.ErrDataNotFound)
 
 	t.Run("Success", func(t *testing.T) {
-		config, err := configurationService.GetConfigurationVersion(context.Background(), "test-config", 1)
+		config, err := configurationService.GetConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 1)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -162,7 +278,7 @@ func TestGetConfigurationVersion(t *testing.T) {
 	})
 
 	t.Run("Error", func(t *testing.T) {
-		config, err := configurationService.GetConfigurationVersion(context.Background(), "test-config", 999)
+		config, err := configurationService.GetConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 999)
 		if config != nil || err == nil {
 			t.Fatalf("expected error, got config: %v, error: %v", config, err)
 		}
@@ -176,13 +292,18 @@ func TestGetConfigurationVersion(t *testing.T) {
 func TestRollbackConfigurationVersion(t *testing.T) {
 	mockRepo := port.NewMockConfigurationRepository(t)
 
-	configurationService := NewConfigurationService(mockRepo)
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
 
-	mockRepo.On("RollbackConfigurationVersion", context.Background(), "test-config", 1).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
-	mockRepo.On("RollbackConfigurationVersion", context.Background(), "test-config", 999).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Name: "test-config", Type: "person", Version: 3}, nil)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "deleted-config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 1).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}, nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John"}, Version: 2}, nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 999).Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("RollbackConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 1, domain.Change{}).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
+	mockRepo.On("RollbackConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 999, domain.Change{}).Return(nil, domain.ErrDataNotFound)
 
 	t.Run("Success", func(t *testing.T) {
-		config, err := configurationService.RollbackConfigurationVersion(context.Background(), "test-config", 1)
+		config, err := configurationService.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 1)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -192,7 +313,7 @@ func TestRollbackConfigurationVersion(t *testing.T) {
 	})
 
 	t.Run("Error", func(t *testing.T) {
-		config, err := configurationService.RollbackConfigurationVersion(context.Background(), "test-config", 999)
+		config, err := configurationService.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 999)
 		if config != nil || err == nil {
 			t.Fatalf("expected error, got config: %v, error: %v", config, err)
 		}
@@ -201,4 +322,776 @@ func TestRollbackConfigurationVersion(t *testing.T) {
 			t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
 		}
 	})
+
+	// Version 2 was written before the latest schema required an age
+	t.Run("NoLongerValid", func(t *testing.T) {
+		config, err := configurationService.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 2)
+		if config != nil {
+			t.Fatalf("expected no config, got %v", config)
+		}
+
+		var validationErr *domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+	})
+
+	// The latest version of a deleted configuration is its tombstone, a restore brings it back instead
+	t.Run("Deleted", func(t *testing.T) {
+		config, err := configurationService.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, "deleted-config", 1)
+		if config != nil || err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got config: %v, error: %v", domain.ErrDataNotFound, config, err)
+		}
+	})
+
+	t.Run("NamespaceNotFound", func(t *testing.T) {
+		config, err := configurationService.RollbackConfigurationVersion(context.Background(), "missing", "test-config", 1)
+		if config != nil || err != domain.ErrNamespaceNotFound {
+			t.Fatalf("expected error %v, got config: %v, error: %v", domain.ErrNamespaceNotFound, config, err)
+		}
+	})
+}
+
+func TestPutConfigurationUsesLatestSchemaVersion(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+	mockSchemaRepo := port.NewMockSchemaRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), mockSchemaRepo, NewEventBus(10), NewWriteLocks())
+
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
+
+	value := map[string]interface{}{"name": "John"}
+
+	// The age is required until the second version of the schema
+	mockSchemaRepo.On("GetSchema", context.Background(), "person").Return(personSchema(1), nil).Once()
+
+	_, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 0)
+	if !errors.Is(err, domain.ErrInvalidSchema) {
+		t.Fatalf("expected error %v, got %v", domain.ErrInvalidSchema, err)
+	}
+
+	updated := personSchema(2)
+	updated.Definition["required"] = []interface{}{"name"}
+	mockSchemaRepo.On("GetSchema", context.Background(), "person").Return(updated, nil).Once()
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, SchemaVersion: 2}, 0).Return(&domain.Config{Name: "test-config", Version: 1, SchemaVersion: 2}, nil)
+
+	config, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if config.SchemaVersion != 2 {
+		t.Fatalf("expected config validated against schema version 2, got %v", config)
+	}
+}
+
+// personSchema returns a version of the schema of the person type
+func personSchema(version int) *domain.Schema {
+	return &domain.Schema{
+		Type:    "person",
+		Version: version,
+		Definition: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"name": map[string]interface{}{"type": "string", "minLength": 1},
+				"age":  map[string]interface{}{"type": "integer", "minimum": 0},
+			},
+			"required": []interface{}{"name", "age"},
+		},
+		Compatibility: domain.SchemaCompatibilityBackward,
+		CreatedAt:     time.Unix(int64(version), 0),
+	}
+}
+
+// newPersonSchemaRepository returns a schema repository in which only the first version of the person type is registered
+func newPersonSchemaRepository(t *testing.T) *port.MockSchemaRepository {
+	mockSchemaRepo := port.NewMockSchemaRepository(t)
+
+	mockSchemaRepo.On("GetSchema", mock.Anything, "person").Return(personSchema(1), nil).Maybe()
+	mockSchemaRepo.On("GetSchema", mock.Anything, mock.Anything).Return(nil, domain.ErrDataNotFound).Maybe()
+
+	return mockSchemaRepo
+}
+
+func newDefaultNamespaceRepository(t *testing.T) *port.MockNamespaceRepository {
+	mockNamespaceRepo := port.NewMockNamespaceRepository(t)
+
+	mockNamespaceRepo.On("GetNamespace", mock.Anything, domain.DefaultNamespace).Return(&domain.Namespace{Name: domain.DefaultNamespace}, nil).Maybe()
+	mockNamespaceRepo.On("GetNamespace", mock.Anything, mock.Anything).Return(nil, domain.ErrDataNotFound).Maybe()
+
+	return mockNamespaceRepo
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Name: "test-config", Type: "person", Value: value, Version: 3}, nil)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "new-config").Return(nil, domain.ErrDataNotFound)
+	latestPage := domain.PageRequest{Sort: domain.SortByVersion, Order: domain.SortDescending, Limit: 1}
+	mockRepo.On("ListConfigurationVersions", context.Background(), domain.DefaultNamespace, "new-config", domain.VersionFilter{}, latestPage).Return(nil, uint64(0), domain.ErrDataNotFound)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "deleted-config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("ListConfigurationVersions", context.Background(), domain.DefaultNamespace, "deleted-config", domain.VersionFilter{}, latestPage).Return([]*domain.Config{{Name: "deleted-config", Version: 2, Deleted: true}}, uint64(2), nil)
+
+	t.Run("Success", func(t *testing.T) {
+		config, err := configurationService.ValidateConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 3)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if config.Version != 4 || config.SchemaVersion != 1 {
+			t.Fatalf("expected version 4 validated against schema version 1, got %v", config)
+		}
+	})
+
+	t.Run("NewConfig", func(t *testing.T) {
+		config, err := configurationService.ValidateConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "new-config", Type: "person", Value: value}, 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if config.Version != 1 {
+			t.Fatalf("expected version 1, got %v", config)
+		}
+	})
+
+	t.Run("DeletedConfig", func(t *testing.T) {
+		config, err := configurationService.ValidateConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "deleted-config", Type: "person", Value: value}, 2)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if config.Version != 3 {
+			t.Fatalf("expected version 3 after the tombstone, got %v", config)
+		}
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		_, err := configurationService.ValidateConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John"}}, 0)
+
+		var validationErr *domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+	})
+
+	t.Run("VersionConflict", func(t *testing.T) {
+		_, err := configurationService.ValidateConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 2)
+		if err != domain.ErrVersionConflict {
+			t.Fatalf("expected error %v, got %v", domain.ErrVersionConflict, err)
+		}
+	})
+}
+
+func TestPatchConfiguration(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 3}, nil)
+
+	t.Run("MergePatch", func(t *testing.T) {
+		patched := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": float64(26)}, SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), patched, 3).Return(&domain.Config{Name: "test-config", Version: 4}, nil).Once()
+
+		config, err := configurationService.PatchConfiguration(context.Background(), domain.DefaultNamespace, "test-config", domain.PatchTypeMerge, []byte(`{"age": 26}`), 3)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if config.Version != 4 {
+			t.Fatalf("expected version 4, got %v", config)
+		}
+	})
+
+	t.Run("JSONPatch", func(t *testing.T) {
+		patched := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "Jane", "age": float64(25)}, SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), patched, 3).Return(&domain.Config{Name: "test-config", Version: 4}, nil).Once()
+
+		_, err := configurationService.PatchConfiguration(context.Background(), domain.DefaultNamespace, "test-config", domain.PatchTypeJSON, []byte(`[{"op": "replace", "path": "/name", "value": "Jane"}]`), 0)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("InvalidValue", func(t *testing.T) {
+		_, err := configurationService.PatchConfiguration(context.Background(), domain.DefaultNamespace, "test-config", domain.PatchTypeMerge, []byte(`{"age": null}`), 0)
+
+		var validationErr *domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+	})
+
+	t.Run("VersionConflict", func(t *testing.T) {
+		_, err := configurationService.PatchConfiguration(context.Background(), domain.DefaultNamespace, "test-config", domain.PatchTypeMerge, []byte(`{"age": 26}`), 2)
+		if err != domain.ErrVersionConflict {
+			t.Fatalf("expected error %v, got %v", domain.ErrVersionConflict, err)
+		}
+	})
+}
+
+func TestPutConfigurationOverlay(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	staging := map[string]interface{}{"age": 30}
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: map[string]map[string]interface{}{"staging": staging}, Version: 3}, nil)
+
+	t.Run("Overlay", func(t *testing.T) {
+		prod := map[string]interface{}{"name": "Jane"}
+		overlaid := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: map[string]map[string]interface{}{"staging": staging, "prod": prod}, Environment: "prod", SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), overlaid, 3).Return(&domain.Config{Name: "test-config", Version: 4}, nil).Once()
+
+		if _, err := configurationService.PutConfigurationOverlay(context.Background(), domain.DefaultNamespace, "test-config", "prod", prod, 3); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("EmptyOverlay", func(t *testing.T) {
+		removed := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: map[string]map[string]interface{}{}, Environment: "staging", SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), removed, 3).Return(&domain.Config{Name: "test-config", Version: 4}, nil).Once()
+
+		if _, err := configurationService.PutConfigurationOverlay(context.Background(), domain.DefaultNamespace, "test-config", "staging", nil, 0); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("InvalidEffectiveValue", func(t *testing.T) {
+		_, err := configurationService.PutConfigurationOverlay(context.Background(), domain.DefaultNamespace, "test-config", "prod", map[string]interface{}{"age": -1}, 0)
+
+		var validationErr *domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("expected a validation error, got %v", err)
+		}
+	})
+
+	t.Run("InvalidEnvironment", func(t *testing.T) {
+		_, err := configurationService.PutConfigurationOverlay(context.Background(), domain.DefaultNamespace, "test-config", "Prod", map[string]interface{}{"age": 40}, 0)
+		if err != domain.ErrInvalidEnvironment {
+			t.Fatalf("expected error %v, got %v", domain.ErrInvalidEnvironment, err)
+		}
+	})
+
+	t.Run("VersionConflict", func(t *testing.T) {
+		_, err := configurationService.PutConfigurationOverlay(context.Background(), domain.DefaultNamespace, "test-config", "prod", map[string]interface{}{"age": 40}, 2)
+		if err != domain.ErrVersionConflict {
+			t.Fatalf("expected error %v, got %v", domain.ErrVersionConflict, err)
+		}
+	})
+}
+
+func TestPutConfigurationKeepsOverlays(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	overlays := map[string]map[string]interface{}{"prod": {"name": "Jane"}}
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Overlays: overlays, Version: 1}, nil)
+
+	value := map[string]interface{}{"name": "John", "age": 26}
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: overlays, SchemaVersion: 1}, 0).Return(&domain.Config{Name: "test-config", Version: 2}, nil)
+
+	if _, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Every environment is validated with the new base value
+	_, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"age": 26}}, 0)
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestPutConfigurationLabels(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	overlays := map[string]map[string]interface{}{"prod": {"name": "Jane"}}
+	labels := map[string]string{"team": "payments"}
+	annotations := map[string]string{"owner": "alice"}
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: overlays, Labels: labels, Annotations: annotations, Version: 3}, nil)
+
+	t.Run("Relabel", func(t *testing.T) {
+		relabeled := map[string]string{"team": "orders", "example.com/tier": "critical"}
+		config := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: overlays, Labels: relabeled, Annotations: annotations, SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), config, 3).Return(&domain.Config{Name: "test-config", Version: 4}, nil).Once()
+
+		if _, err := configurationService.PutConfigurationLabels(context.Background(), domain.DefaultNamespace, "test-config", relabeled, nil, 3); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("RemoveAnnotations", func(t *testing.T) {
+		config := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: overlays, Labels: labels, Annotations: map[string]string{}, SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), config, 3).Return(&domain.Config{Name: "test-config", Version: 4}, nil).Once()
+
+		if _, err := configurationService.PutConfigurationLabels(context.Background(), domain.DefaultNamespace, "test-config", nil, map[string]string{}, 0); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("InvalidLabel", func(t *testing.T) {
+		for _, invalid := range []map[string]string{{"-team": "payments"}, {"team": "pay ments"}, {"Example.com/team": "payments"}, {"team": strings.Repeat("a", 64)}} {
+			if _, err := configurationService.PutConfigurationLabels(context.Background(), domain.DefaultNamespace, "test-config", invalid, nil, 0); err != domain.ErrInvalidLabel {
+				t.Fatalf("expected error %v for %v, got %v", domain.ErrInvalidLabel, invalid, err)
+			}
+		}
+	})
+
+	t.Run("InvalidAnnotation", func(t *testing.T) {
+		for _, invalid := range []map[string]string{{"owner/": "alice"}, {"notes": strings.Repeat("a", 256*1024)}} {
+			if _, err := configurationService.PutConfigurationLabels(context.Background(), domain.DefaultNamespace, "test-config", nil, invalid, 0); err != domain.ErrInvalidAnnotation {
+				t.Fatalf("expected error %v, got %v", domain.ErrInvalidAnnotation, err)
+			}
+		}
+	})
+
+	t.Run("VersionConflict", func(t *testing.T) {
+		_, err := configurationService.PutConfigurationLabels(context.Background(), domain.DefaultNamespace, "test-config", labels, nil, 2)
+		if err != domain.ErrVersionConflict {
+			t.Fatalf("expected error %v, got %v", domain.ErrVersionConflict, err)
+		}
+	})
+}
+
+func TestPutConfigurationKeepsLabels(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	labels := map[string]string{"team": "payments"}
+	annotations := map[string]string{"owner": "alice"}
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Labels: labels, Annotations: annotations, Version: 1}, nil)
+
+	value := map[string]interface{}{"name": "John", "age": 26}
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Labels: labels, Annotations: annotations, SchemaVersion: 1}, 0).Return(&domain.Config{Name: "test-config", Version: 2}, nil).Once()
+
+	if _, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Labels the config sets replace the kept ones
+	relabeled := map[string]string{"team": "orders"}
+	mockRepo.On("PutConfiguration", context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Labels: relabeled, Annotations: annotations, SchemaVersion: 1}, 0).Return(&domain.Config{Name: "test-config", Version: 3}, nil).Once()
+
+	if _, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Labels: relabeled}, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestPromoteConfigurationVersion(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: map[string]map[string]interface{}{"staging": {"age": 30}}, Version: 2}, nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 3).Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Version: 3, Deleted: true}, nil)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: map[string]map[string]interface{}{"staging": {"age": 31}}, Version: 4}, nil)
+
+	t.Run("Promote", func(t *testing.T) {
+		promoted := &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, Overlays: map[string]map[string]interface{}{"staging": {"age": 31}, "prod": {"age": 30}}, Environment: "prod", PromotedFrom: "staging", PromotedVersion: 2, SchemaVersion: 1}
+		mockRepo.On("PutConfiguration", context.Background(), promoted, 4).Return(&domain.Config{Name: "test-config", Version: 5, Environment: "prod", PromotedFrom: "staging", PromotedVersion: 2}, nil).Once()
+
+		config, err := configurationService.PromoteConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 2, "staging", "prod")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if config.Version != 5 || config.PromotedVersion != 2 {
+			t.Fatalf("expected version 5 promoted from version 2, got %v", config)
+		}
+	})
+
+	t.Run("SameEnvironment", func(t *testing.T) {
+		_, err := configurationService.PromoteConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 2, "prod", "prod")
+		if err != domain.ErrInvalidPromotion {
+			t.Fatalf("expected error %v, got %v", domain.ErrInvalidPromotion, err)
+		}
+	})
+
+	t.Run("DeletedVersion", func(t *testing.T) {
+		_, err := configurationService.PromoteConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 3, "staging", "prod")
+		if err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
+		}
+	})
+}
+
+func TestMoveConfigurationAlias(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	ctx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{Kind: domain.PrincipalUser, Subject: "alice"})
+	ctx = domain.ContextWithChangeMessage(ctx, "Release the new limits")
+
+	mockRepo.On("GetConfiguration", ctx, domain.DefaultNamespace, "test-config").Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Version: 4}, nil).Maybe()
+	mockRepo.On("GetConfigurationVersion", ctx, domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Version: 2}, nil).Maybe()
+	mockRepo.On("GetConfigurationVersion", ctx, domain.DefaultNamespace, "test-config", 3).Return(&domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Version: 3, Deleted: true}, nil).Maybe()
+	mockRepo.On("GetConfigurationVersion", ctx, domain.DefaultNamespace, "test-config", 9).Return(nil, domain.ErrDataNotFound).Maybe()
+
+	t.Run("Move", func(t *testing.T) {
+		alias := &domain.Alias{Namespace: domain.DefaultNamespace, Name: "test-config", Alias: "stable", Version: 2, MovedBy: "alice", ChangeMessage: "Release the new limits"}
+		mockRepo.On("MoveAlias", ctx, alias, 1).Return(&domain.Alias{Namespace: domain.DefaultNamespace, Name: "test-config", Alias: "stable", Version: 2, Revision: 2}, nil).Once()
+
+		moved, err := configurationService.MoveConfigurationAlias(ctx, domain.DefaultNamespace, "test-config", "stable", 2, 1)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if moved.Version != 2 || moved.Revision != 2 {
+			t.Fatalf("expected stable at version 2 in revision 2, got %v", moved)
+		}
+	})
+
+	t.Run("InvalidAlias", func(t *testing.T) {
+		for _, invalid := range []string{"", "Stable", "-stable", strings.Repeat("a", 64)} {
+			if _, err := configurationService.MoveConfigurationAlias(ctx, domain.DefaultNamespace, "test-config", invalid, 2, 0); err != domain.ErrInvalidAlias {
+				t.Fatalf("expected error %v for %q, got %v", domain.ErrInvalidAlias, invalid, err)
+			}
+		}
+		if _, err := configurationService.MoveConfigurationAlias(ctx, domain.DefaultNamespace, "test-config", "stable", 0, 0); err != domain.ErrInvalidAlias {
+			t.Fatalf("expected error %v for version 0, got %v", domain.ErrInvalidAlias, err)
+		}
+	})
+
+	t.Run("MissingVersion", func(t *testing.T) {
+		for _, version := range []int{3, 9} {
+			if _, err := configurationService.MoveConfigurationAlias(ctx, domain.DefaultNamespace, "test-config", "stable", version, 0); err != domain.ErrDataNotFound {
+				t.Fatalf("expected error %v for version %d, got %v", domain.ErrDataNotFound, version, err)
+			}
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		mockRepo.On("GetAlias", ctx, domain.DefaultNamespace, "test-config", "canary").Return(&domain.Alias{Alias: "canary", Version: 4, Revision: 1}, nil).Once()
+		alias := &domain.Alias{Namespace: domain.DefaultNamespace, Name: "test-config", Alias: "canary", MovedBy: "alice", ChangeMessage: "Release the new limits"}
+		mockRepo.On("MoveAlias", ctx, alias, 0).Return(&domain.Alias{Alias: "canary", Revision: 2}, nil).Once()
+
+		if _, err := configurationService.ClearConfigurationAlias(ctx, domain.DefaultNamespace, "test-config", "canary", 0); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("ClearCleared", func(t *testing.T) {
+		mockRepo.On("GetAlias", ctx, domain.DefaultNamespace, "test-config", "canary").Return(&domain.Alias{Alias: "canary", Revision: 2}, nil).Once()
+
+		if _, err := configurationService.ClearConfigurationAlias(ctx, domain.DefaultNamespace, "test-config", "canary", 0); err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
+		}
+	})
+}
+
+func TestGetConfigurationAlias(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Name: "test-config", Version: 4}, nil)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "deleted-config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("GetAlias", context.Background(), domain.DefaultNamespace, "test-config", "stable").Return(&domain.Alias{Alias: "stable", Version: 2, Revision: 3}, nil)
+	mockRepo.On("GetAlias", context.Background(), domain.DefaultNamespace, "test-config", "canary").Return(&domain.Alias{Alias: "canary", Revision: 2}, nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Name: "test-config", Version: 2}, nil)
+
+	alias, config, err := configurationService.GetConfigurationAlias(context.Background(), domain.DefaultNamespace, "test-config", "stable")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if alias.Revision != 3 || config.Version != 2 {
+		t.Fatalf("expected version 2 through revision 3, got %v and %v", alias, config)
+	}
+
+	// A cleared alias and the aliases of a deleted configuration resolve to nothing
+	if _, _, err := configurationService.GetConfigurationAlias(context.Background(), domain.DefaultNamespace, "test-config", "canary"); err != domain.ErrDataNotFound {
+		t.Fatalf("expected error %v for a cleared alias, got %v", domain.ErrDataNotFound, err)
+	}
+	if _, _, err := configurationService.GetConfigurationAlias(context.Background(), domain.DefaultNamespace, "deleted-config", "stable"); err != domain.ErrDataNotFound {
+		t.Fatalf("expected error %v for a deleted configuration, got %v", domain.ErrDataNotFound, err)
+	}
+}
+
+func TestDiffConfigurationVersions(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 1).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}, nil).Maybe()
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 26}, Version: 2}, nil).Maybe()
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 9).Return(nil, domain.ErrDataNotFound).Maybe()
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(&domain.Config{Name: "test-config", Type: "employee", Value: map[string]interface{}{"name": "John"}, Version: 3}, nil).Maybe()
+
+	t.Run("Patch", func(t *testing.T) {
+		diff, err := configurationService.DiffConfigurationVersions(context.Background(), domain.DefaultNamespace, "test-config", 1, 2, domain.DiffFormatPatch)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		want := &domain.ConfigDiff{
+			Namespace:   domain.DefaultNamespace,
+			Name:        "test-config",
+			FromVersion: 1,
+			ToVersion:   2,
+			FromType:    "person",
+			ToType:      "person",
+			Operations:  []domain.DiffOperation{{Op: "replace", Path: "/age", Value: float64(26)}},
+		}
+		if !reflect.DeepEqual(diff, want) {
+			t.Fatalf("expected diff %v, got %v", want, diff)
+		}
+	})
+
+	t.Run("LatestUnified", func(t *testing.T) {
+		diff, err := configurationService.DiffConfigurationVersions(context.Background(), domain.DefaultNamespace, "test-config", 2, 0, domain.DiffFormatUnified)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if diff.ToVersion != 3 || diff.ToType != "employee" || diff.Operations != nil || diff.Unified == "" {
+			t.Fatalf("expected a unified diff with the latest version 3 of type employee, got %v", diff)
+		}
+	})
+
+	t.Run("VersionNotFound", func(t *testing.T) {
+		diff, err := configurationService.DiffConfigurationVersions(context.Background(), domain.DefaultNamespace, "test-config", 1, 9, domain.DiffFormatPatch)
+		if diff != nil || err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got diff: %v, error: %v", domain.ErrDataNotFound, diff, err)
+		}
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		_, err := configurationService.DiffConfigurationVersions(context.Background(), domain.DefaultNamespace, "test-config", 1, 2, domain.DiffFormat("html"))
+		if err != domain.ErrUnsupportedDiffFormat {
+			t.Fatalf("expected error %v, got %v", domain.ErrUnsupportedDiffFormat, err)
+		}
+	})
+}
+
+func TestDeleteAndRestoreConfiguration(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+	events := NewEventBus(10)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), events, NewWriteLocks())
+
+	mockRepo.On("DeleteConfiguration", context.Background(), domain.DefaultNamespace, "test-config", 2, domain.Change{}).Return(&domain.Config{Name: "test-config", Version: 3, Deleted: true}, nil)
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("ListConfigurationVersions", context.Background(), domain.DefaultNamespace, "test-config", domain.VersionFilter{}, mock.Anything).Return([]*domain.Config{{Name: "test-config", Type: "person", Version: 3, Deleted: true}}, uint64(3), nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 2}, nil)
+	mockRepo.On("RestoreConfiguration", context.Background(), domain.DefaultNamespace, "test-config", domain.Change{}).Return(&domain.Config{Name: "test-config", Version: 4, RollbackedVersion: 2}, nil)
+	mockRepo.On("PurgeConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(nil)
+
+	tombstone, err := configurationService.DeleteConfiguration(context.Background(), domain.DefaultNamespace, "test-config", 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !tombstone.Deleted || tombstone.Version != 3 {
+		t.Fatalf("expected a tombstone with version 3, got %v", tombstone)
+	}
+
+	restored, err := configurationService.RestoreConfiguration(context.Background(), domain.DefaultNamespace, "test-config")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if restored.Version != 4 || restored.RollbackedVersion != 2 {
+		t.Fatalf("expected version 4 restored from version 2, got %v", restored)
+	}
+
+	// The purge of a deleted configuration carries the type of its tombstone, so watchers of the type see it
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher, err := events.Watch(ctx, domain.WatchFilter{Type: "person"}, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := configurationService.PurgeConfiguration(context.Background(), domain.DefaultNamespace, "test-config"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if event := receive(t, watcher); event.Type != domain.ConfigEventPurge || event.Config.Type != "person" {
+		t.Fatalf("expected a purge of a person config, got %v", event)
+	}
+}
+
+func TestRestoreConfigurationNoLongerValid(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	// Version 2 was written before the latest schema required an age, restoring it would store a value that fails it
+	mockRepo.On("GetConfiguration", context.Background(), domain.DefaultNamespace, "test-config").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("ListConfigurationVersions", context.Background(), domain.DefaultNamespace, "test-config", domain.VersionFilter{}, mock.Anything).Return([]*domain.Config{{Name: "test-config", Type: "person", Version: 3, Deleted: true}}, uint64(3), nil)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 2).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John"}, Version: 2}, nil)
+
+	restored, err := configurationService.RestoreConfiguration(context.Background(), domain.DefaultNamespace, "test-config")
+	if restored != nil {
+		t.Fatalf("expected no config, got %v", restored)
+	}
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestWritesPublishEvents(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+	events := NewEventBus(10)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), events, NewWriteLocks())
+
+	// Both puts create the config, the rollback finds it
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound).Twice()
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(&domain.Config{Name: "test-config", Type: "person", Version: 1}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher, err := events.Watch(ctx, domain.WatchFilter{Name: "test-config"}, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	mockRepo.On("PutConfiguration", context.Background(), mock.Anything, 0).Return(&domain.Config{Name: "test-config", Type: "person", Version: 1}, nil)
+	mockRepo.On("PutConfiguration", context.Background(), mock.Anything, 5).Return(nil, domain.ErrVersionConflict)
+	mockRepo.On("GetConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 1).Return(&domain.Config{Name: "test-config", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 1}, nil)
+	mockRepo.On("RollbackConfigurationVersion", context.Background(), domain.DefaultNamespace, "test-config", 1, domain.Change{}).Return(&domain.Config{Name: "test-config", Type: "person", Version: 2, RollbackedVersion: 1}, nil)
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	if _, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.PutConfiguration(context.Background(), &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 5); err != domain.ErrVersionConflict {
+		t.Fatalf("expected error %v, got %v", domain.ErrVersionConflict, err)
+	}
+	if _, err := configurationService.RollbackConfigurationVersion(context.Background(), domain.DefaultNamespace, "test-config", 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The failed write publishes nothing
+	if event := receive(t, watcher); event.Type != domain.ConfigEventPut || event.Config.Version != 1 {
+		t.Fatalf("expected the put of version 1, got %v", event)
+	}
+	if event := receive(t, watcher); event.Type != domain.ConfigEventRollback || event.Config.Version != 2 {
+		t.Fatalf("expected the rollback to version 2, got %v", event)
+	}
+}
+
+func TestWaitForConfiguration(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+	events := NewEventBus(10)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), events, NewWriteLocks())
+
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, "test-config").Return(&domain.Config{Name: "test-config", Type: "person", Version: 3}, nil)
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, "new-config").Return(nil, domain.ErrDataNotFound)
+
+	// Every wait must end its watch, which unsubscribes shortly after
+	t.Cleanup(func() {
+		watchers := 0
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			events.mu.Lock()
+			watchers = len(events.subscribers)
+			events.mu.Unlock()
+
+			if watchers == 0 {
+				return
+			}
+		}
+		t.Errorf("expected no watchers left, got %d", watchers)
+	})
+
+	t.Run("AlreadyNewer", func(t *testing.T) {
+		config, err := configurationService.WaitForConfiguration(context.Background(), domain.DefaultNamespace, "test-config", 2, time.Second)
+		if err != nil || config.Version != 3 {
+			t.Fatalf("expected version 3 right away, got config: %v, error: %v", config, err)
+		}
+	})
+
+	t.Run("NewVersion", func(t *testing.T) {
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			events.publish(domain.ConfigEventPut, &domain.Config{Namespace: domain.DefaultNamespace, Name: "other-config", Version: 9})
+			events.publish(domain.ConfigEventPut, &domain.Config{Namespace: "payments", Name: "test-config", Version: 9})
+			events.publish(domain.ConfigEventPut, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Version: 4})
+		}()
+
+		config, err := configurationService.WaitForConfiguration(context.Background(), domain.DefaultNamespace, "test-config", 3, time.Second)
+		if err != nil || config.Version != 4 {
+			t.Fatalf("expected version 4, got config: %v, error: %v", config, err)
+		}
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			events.publish(domain.ConfigEventDelete, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Version: 4, Deleted: true})
+		}()
+
+		_, err := configurationService.WaitForConfiguration(context.Background(), domain.DefaultNamespace, "test-config", 3, time.Second)
+		if err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		_, err := configurationService.WaitForConfiguration(context.Background(), domain.DefaultNamespace, "test-config", 3, 20*time.Millisecond)
+		if err != domain.ErrNotModified {
+			t.Fatalf("expected error %v, got %v", domain.ErrNotModified, err)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+
+		_, err := configurationService.WaitForConfiguration(ctx, domain.DefaultNamespace, "test-config", 3, time.Second)
+		if err != context.Canceled {
+			t.Fatalf("expected error %v, got %v", context.Canceled, err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := configurationService.WaitForConfiguration(context.Background(), domain.DefaultNamespace, "new-config", 0, time.Second)
+		if err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
+		}
+	})
+}
+
+func TestWritesRecordChange(t *testing.T) {
+	mockRepo := port.NewMockConfigurationRepository(t)
+
+	configurationService := NewConfigurationService(mockRepo, newDefaultNamespaceRepository(t), newPersonSchemaRepository(t), NewEventBus(10), NewWriteLocks())
+
+	// The config is created, rolled back while it exists, then deleted and restored
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound).Once()
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(&domain.Config{Name: "test-config", Type: "person", Version: 1}, nil).Once()
+	mockRepo.On("GetConfiguration", mock.Anything, domain.DefaultNamespace, mock.Anything).Return(nil, domain.ErrDataNotFound)
+
+	ctx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{Kind: domain.PrincipalApiKey, Subject: "abc"})
+	ctx = domain.ContextWithChangeMessage(ctx, "Raise the limit")
+	change := domain.Change{CreatedBy: "api_key:abc", Message: "Raise the limit"}
+
+	value := map[string]interface{}{"name": "John", "age": 25}
+	mockRepo.On("PutConfiguration", ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value, SchemaVersion: 1, CreatedBy: change.CreatedBy, ChangeMessage: change.Message}, 0).Return(&domain.Config{Name: "test-config", Version: 1}, nil)
+	mockRepo.On("RollbackConfigurationVersion", ctx, domain.DefaultNamespace, "test-config", 1, change).Return(&domain.Config{Name: "test-config", Version: 2}, nil)
+	mockRepo.On("DeleteConfiguration", ctx, domain.DefaultNamespace, "test-config", 0, change).Return(&domain.Config{Name: "test-config", Version: 3, Deleted: true}, nil)
+	mockRepo.On("ListConfigurationVersions", ctx, domain.DefaultNamespace, "test-config", domain.VersionFilter{}, mock.Anything).Return([]*domain.Config{{Name: "test-config", Type: "person", Version: 3, Deleted: true}}, uint64(3), nil)
+	mockRepo.On("GetConfigurationVersion", ctx, domain.DefaultNamespace, "test-config", mock.Anything).Return(&domain.Config{Name: "test-config", Type: "person", Value: value, Version: 1}, nil)
+	mockRepo.On("RestoreConfiguration", ctx, domain.DefaultNamespace, "test-config", change).Return(&domain.Config{Name: "test-config", Version: 4}, nil)
+
+	if _, err := configurationService.PutConfiguration(ctx, &domain.Config{Namespace: domain.DefaultNamespace, Name: "test-config", Type: "person", Value: value}, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.RollbackConfigurationVersion(ctx, domain.DefaultNamespace, "test-config", 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.DeleteConfiguration(ctx, domain.DefaultNamespace, "test-config", 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := configurationService.RestoreConfiguration(ctx, domain.DefaultNamespace, "test-config"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
 }
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change of a unified diff
+const diffContextLines = 3
+
+// normalizeValue round-trips a value through JSON, so numbers of any Go type compare equal to the
+// float64 a JSON decoder produces
+func normalizeValue(value map[string]interface{}) (interface{}, error) {
+	doc, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(doc, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// diffValues returns the JSON Patch operations that turn the from value into the to value
+func diffValues(from, to map[string]interface{}) ([]domain.DiffOperation, error) {
+	normalizedFrom, err := normalizeValue(from)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedTo, err := normalizeValue(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffNode("", normalizedFrom, normalizedTo), nil
+}
+
+// diffNode compares two decoded JSON values at path, descending into objects and arrays so that only the changed
+// members are replaced
+func diffNode(path string, from, to interface{}) []domain.DiffOperation {
+	fromObject, fromIsObject := from.(map[string]interface{})
+	toObject, toIsObject := to.(map[string]interface{})
+	if fromIsObject && toIsObject {
+		return diffObjects(path, fromObject, toObject)
+	}
+
+	fromArray, fromIsArray := from.([]interface{})
+	toArray, toIsArray := to.([]interface{})
+	if fromIsArray && toIsArray {
+		return diffArrays(path, fromArray, toArray)
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+
+	return []domain.DiffOperation{{Op: "replace", Path: path, Value: to}}
+}
+
+func diffObjects(path string, from, to map[string]interface{}) []domain.DiffOperation {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var operations []domain.DiffOperation
+	for _, key := range keys {
+		memberPath := path + "/" + escapePointer(key)
+
+		fromMember, inFrom := from[key]
+		toMember, inTo := to[key]
+
+		switch {
+		case !inTo:
+			operations = append(operations, domain.DiffOperation{Op: "remove", Path: memberPath})
+		case !inFrom:
+			operations = append(operations, domain.DiffOperation{Op: "add", Path: memberPath, Value: toMember})
+		default:
+			operations = append(operations, diffNode(memberPath, fromMember, toMember)...)
+		}
+	}
+
+	return operations
+}
+
+// diffArrays compares the elements two arrays have in common by position, then removes or appends the rest.
+// Surplus elements are removed from the end, so the index of each remove is still valid when it is applied
+func diffArrays(path string, from, to []interface{}) []domain.DiffOperation {
+	common := min(len(from), len(to))
+
+	var operations []domain.DiffOperation
+	for i := 0; i < common; i++ {
+		operations = append(operations, diffNode(fmt.Sprintf("%s/%d", path, i), from[i], to[i])...)
+	}
+
+	for i := len(from) - 1; i >= common; i-- {
+		operations = append(operations, domain.DiffOperation{Op: "remove", Path: fmt.Sprintf("%s/%d", path, i)})
+	}
+
+	for i := common; i < len(to); i++ {
+		operations = append(operations, domain.DiffOperation{Op: "add", Path: fmt.Sprintf("%s/%d", path, i), Value: to[i]})
+	}
+
+	return operations
+}
+
+// diffLine is a line of a unified diff, kind is ' ' for an unchanged line, '-' for a removed and '+' for an added one
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff renders the changes between two versions of a configuration as a unified diff of their indented values
+func unifiedDiff(from, to *domain.Config) (string, error) {
+	fromDoc, err := json.MarshalIndent(from.Value, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	toDoc, err := json.MarshalIndent(to.Value, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s version %d (type %s)\n", from.Name, from.Version, from.Type)
+	fmt.Fprintf(&b, "+++ %s version %d (type %s)\n", to.Name, to.Version, to.Type)
+
+	lines := diffLines(strings.Split(string(fromDoc), "\n"), strings.Split(string(toDoc), "\n"))
+	writeHunks(&b, lines)
+
+	return b.String(), nil
+}
+
+// diffLines returns the edit script between two lists of lines based on their longest common subsequence
+func diffLines(from, to []string) []diffLine {
+	// lcs[i][j] is the length of the longest common subsequence of from[i:] and to[j:]
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			lines = append(lines, diffLine{' ', from[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', from[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', to[j]})
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		lines = append(lines, diffLine{'-', from[i]})
+	}
+	for ; j < len(to); j++ {
+		lines = append(lines, diffLine{'+', to[j]})
+	}
+
+	return lines
+}
+
+// writeHunks writes the changed lines of an edit script in hunks with up to diffContextLines unchanged lines around them
+func writeHunks(b *strings.Builder, lines []diffLine) {
+	// fromLine[k] and toLine[k] are the 0-based line numbers in each document where lines[k] is
+	fromLine := make([]int, len(lines)+1)
+	toLine := make([]int, len(lines)+1)
+	for k, line := range lines {
+		fromLine[k+1], toLine[k+1] = fromLine[k], toLine[k]
+		if line.kind != '+' {
+			fromLine[k+1]++
+		}
+		if line.kind != '-' {
+			toLine[k+1]++
+		}
+	}
+
+	for k := 0; k < len(lines); {
+		if lines[k].kind == ' ' {
+			k++
+			continue
+		}
+
+		// Extend the hunk while the next change is close enough for the context lines of both to touch
+		start := max(k-diffContextLines, 0)
+		end := k
+		for end < len(lines) {
+			if lines[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(lines) && lines[next].kind == ' ' {
+				next++
+			}
+			if next == len(lines) || next-end > 2*diffContextLines {
+				end = min(end+diffContextLines, len(lines))
+				break
+			}
+			end = next
+		}
+
+		fmt.Fprintf(b, "@@ -%s +%s @@\n",
+			hunkRange(fromLine[start], fromLine[end]-fromLine[start]),
+			hunkRange(toLine[start], toLine[end]-toLine[start]))
+		for _, line := range lines[start:end] {
+			b.WriteByte(line.kind)
+			b.WriteString(line.text)
+			b.WriteByte('\n')
+		}
+
+		k = end
+	}
+}
+
+// hunkRange formats the 1-based range of a hunk, an empty range is numbered after the line it follows
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
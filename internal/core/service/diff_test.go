@@ -0,0 +1,160 @@
+package service
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestDiffValues(t *testing.T) {
+	tests := []struct {
+		name string
+		from map[string]interface{}
+		to   map[string]interface{}
+		want []domain.DiffOperation
+	}{
+		{
+			name: "Equal",
+			from: map[string]interface{}{"name": "John", "age": 25},
+			to:   map[string]interface{}{"name": "John", "age": float64(25)},
+		},
+		{
+			name: "Members",
+			from: map[string]interface{}{"name": "John", "age": 25, "zip/code": "1234"},
+			to:   map[string]interface{}{"name": "John", "age": 26, "email": "john@example.com"},
+			want: []domain.DiffOperation{
+				{Op: "replace", Path: "/age", Value: float64(26)},
+				{Op: "add", Path: "/email", Value: "john@example.com"},
+				{Op: "remove", Path: "/zip~1code"},
+			},
+		},
+		{
+			name: "NestedObject",
+			from: map[string]interface{}{"address": map[string]interface{}{"city": "Jakarta", "zip": "10110"}},
+			to:   map[string]interface{}{"address": map[string]interface{}{"city": "Bandung", "zip": "10110"}},
+			want: []domain.DiffOperation{{Op: "replace", Path: "/address/city", Value: "Bandung"}},
+		},
+		{
+			name: "ShorterArray",
+			from: map[string]interface{}{"tags": []interface{}{"a", "b", "c", "d"}},
+			to:   map[string]interface{}{"tags": []interface{}{"a", "x"}},
+			want: []domain.DiffOperation{
+				{Op: "replace", Path: "/tags/1", Value: "x"},
+				{Op: "remove", Path: "/tags/3"},
+				{Op: "remove", Path: "/tags/2"},
+			},
+		},
+		{
+			name: "LongerArray",
+			from: map[string]interface{}{"tags": []interface{}{"a"}},
+			to:   map[string]interface{}{"tags": []interface{}{"a", "b", "c"}},
+			want: []domain.DiffOperation{
+				{Op: "add", Path: "/tags/1", Value: "b"},
+				{Op: "add", Path: "/tags/2", Value: "c"},
+			},
+		},
+		{
+			name: "TypeOfMember",
+			from: map[string]interface{}{"address": "Jakarta"},
+			to:   map[string]interface{}{"address": map[string]interface{}{"city": "Jakarta"}},
+			want: []domain.DiffOperation{{Op: "replace", Path: "/address", Value: map[string]interface{}{"city": "Jakarta"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffValues(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected operations %v, got %v", tt.want, got)
+			}
+
+			// The operations must turn the from value into the to value
+			patch, err := json.Marshal(patchDocument(got))
+			if err != nil {
+				t.Fatalf("failed to marshal the operations: %v", err)
+			}
+			patched, err := applyPatch(domain.PatchTypeJSON, tt.from, patch)
+			if err != nil {
+				t.Fatalf("failed to apply the operations: %v", err)
+			}
+			want, _ := normalizeValue(tt.to)
+			if !reflect.DeepEqual(patched, want) {
+				t.Fatalf("expected the patched value %v, got %v", want, patched)
+			}
+		})
+	}
+}
+
+// patchDocument converts diff operations to the members of a JSON Patch document
+func patchDocument(operations []domain.DiffOperation) []map[string]interface{} {
+	document := []map[string]interface{}{}
+	for _, operation := range operations {
+		member := map[string]interface{}{"op": operation.Op, "path": operation.Path}
+		if operation.Op != "remove" {
+			member["value"] = operation.Value
+		}
+		document = append(document, member)
+	}
+	return document
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	value := map[string]interface{}{}
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		value[key] = 1
+	}
+
+	changed := map[string]interface{}{}
+	for key, v := range value {
+		changed[key] = v
+	}
+	changed["b"] = 2
+	changed["j"] = 2
+	changed["k"] = 1
+
+	from := &domain.Config{Name: "test-config", Type: "person", Value: value, Version: 3}
+	to := &domain.Config{Name: "test-config", Type: "employee", Value: changed, Version: 7}
+
+	got, err := unifiedDiff(from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := `--- test-config version 3 (type person)
++++ test-config version 7 (type employee)
+@@ -1,6 +1,6 @@
+ {
+   "a": 1,
+-  "b": 1,
++  "b": 2,
+   "c": 1,
+   "d": 1,
+   "e": 1,
+@@ -8,5 +8,6 @@
+   "g": 1,
+   "h": 1,
+   "i": 1,
+-  "j": 1
++  "j": 2,
++  "k": 1
+ }
+`
+	if got != want {
+		t.Fatalf("expected diff\n%s\ngot\n%s", want, got)
+	}
+
+	got, err = unifiedDiff(from, from)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want = "--- test-config version 3 (type person)\n+++ test-config version 3 (type person)\n"
+	if got != want {
+		t.Fatalf("expected diff\n%s\ngot\n%s", want, got)
+	}
+}
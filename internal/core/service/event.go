@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+type WatchServicer interface {
+	// Watch streams the events that pass the filter until ctx is done. A lastRevision other than 0 first replays the
+	// events after it. The channel is also closed when the watcher falls too far behind, it can resume from the last
+	// revision it received
+	Watch(ctx context.Context, filter domain.WatchFilter, lastRevision uint64) (<-chan *domain.ConfigEvent, error)
+}
+
+// EventBus delivers the changes made through the service layer to watchers in the same process, so that every storage
+// adapter is watched the same way. It keeps the latest events so that a watcher can resume after a reconnect
+type EventBus struct {
+	mu          sync.Mutex
+	revision    uint64
+	history     []*domain.ConfigEvent // The latest events, oldest first
+	historySize int
+	subscribers map[*subscriber]struct{}
+}
+
+// NewEventBus creates an event bus that keeps the latest historySize events for resuming watchers
+func NewEventBus(historySize int) *EventBus {
+	return &EventBus{
+		historySize: historySize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// subscriber queues the events of a watcher until its goroutine hands them over
+type subscriber struct {
+	filter domain.WatchFilter
+	limit  int
+
+	mu         sync.Mutex
+	queue      []*domain.ConfigEvent
+	overflowed bool          // Set when the queue hit its limit, the watcher is closed once the queue is drained
+	notify     chan struct{} // Signals that events were queued
+}
+
+// push queues an event without blocking the publisher
+func (s *subscriber) push(event *domain.ConfigEvent) {
+	s.mu.Lock()
+	if len(s.queue) < s.limit {
+		s.queue = append(s.queue, event)
+	} else {
+		s.overflowed = true
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default: // Already signalled
+	}
+}
+
+// take removes and returns the queued events
+func (s *subscriber) take() ([]*domain.ConfigEvent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := s.queue
+	s.queue = nil
+
+	return events, s.overflowed
+}
+
+// publish assigns the next revision to a change and delivers it to the matching watchers
+func (b *EventBus) publish(eventType domain.ConfigEventType, config *domain.Config) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.revision++
+	event := &domain.ConfigEvent{Revision: b.revision, Type: eventType, Config: config}
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for s := range b.subscribers {
+		if s.filter.Matches(config) {
+			s.push(event)
+		}
+	}
+}
+
+func (b *EventBus) Watch(ctx context.Context, filter domain.WatchFilter, lastRevision uint64) (<-chan *domain.ConfigEvent, error) {
+	s := &subscriber{
+		filter: filter,
+		notify: make(chan struct{}, 1),
+	}
+
+	b.mu.Lock()
+
+	if lastRevision != 0 {
+		oldest := b.revision + 1
+		if len(b.history) > 0 {
+			oldest = b.history[0].Revision
+		}
+
+		// Events before the oldest kept one are lost, and a revision ahead of the bus was handed out before a restart
+		if lastRevision+1 < oldest || lastRevision > b.revision {
+			b.mu.Unlock()
+			return nil, domain.ErrRevisionUnavailable
+		}
+
+		for _, event := range b.history {
+			if event.Revision > lastRevision && filter.Matches(event.Config) {
+				s.queue = append(s.queue, event)
+			}
+		}
+	}
+
+	// A watcher may fall behind live events by as many as the history holds, it can still resume from there
+	s.limit = len(s.queue) + max(b.historySize, 1)
+
+	// Registering under the same lock as the replay means no event is missed or delivered twice
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	events := make(chan *domain.ConfigEvent)
+	go func() {
+		defer close(events)
+		defer b.unsubscribe(s)
+
+		for {
+			queued, overflowed := s.take()
+			for _, event := range queued {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if overflowed {
+				return
+			}
+
+			select {
+			case <-s.notify:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (b *EventBus) unsubscribe(s *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers, s)
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+// receive waits for the next event of a watcher
+func receive(t *testing.T, events <-chan *domain.ConfigEvent) *domain.ConfigEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatalf("expected an event, the watcher was closed")
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatalf("expected an event, got none")
+	}
+
+	return nil
+}
+
+func TestEventBusWatch(t *testing.T) {
+	bus := NewEventBus(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := bus.Watch(ctx, domain.WatchFilter{NamePrefix: "app_", Type: "person"}, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	bus.publish(domain.ConfigEventPut, &domain.Config{Name: "other", Type: "person", Version: 1})
+	bus.publish(domain.ConfigEventPut, &domain.Config{Name: "app_a", Type: "company", Version: 1})
+	bus.publish(domain.ConfigEventPut, &domain.Config{Name: "app_b", Type: "person", Version: 1})
+	bus.publish(domain.ConfigEventRollback, &domain.Config{Name: "app_b", Type: "person", Version: 2})
+
+	if event := receive(t, events); event.Revision != 3 || event.Type != domain.ConfigEventPut || event.Config.Name != "app_b" {
+		t.Fatalf("expected the put of app_b at revision 3, got %v", event)
+	}
+	if event := receive(t, events); event.Revision != 4 || event.Type != domain.ConfigEventRollback {
+		t.Fatalf("expected the rollback of app_b at revision 4, got %v", event)
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatalf("expected the watcher to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the watcher to be closed")
+	}
+}
+
+func TestEventBusResume(t *testing.T) {
+	bus := NewEventBus(3)
+
+	for version := 1; version <= 5; version++ {
+		bus.publish(domain.ConfigEventPut, &domain.Config{Name: "app", Version: version})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := bus.Watch(ctx, domain.WatchFilter{}, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	bus.publish(domain.ConfigEventPut, &domain.Config{Name: "app", Version: 6})
+
+	for revision := uint64(3); revision <= 6; revision++ {
+		if event := receive(t, events); event.Revision != revision {
+			t.Fatalf("expected revision %d, got %v", revision, event)
+		}
+	}
+
+	// Revision 1 was the last one before the oldest kept event, revision 7 hasn't happened
+	for _, lastRevision := range []uint64{1, 7} {
+		if _, err := bus.Watch(ctx, domain.WatchFilter{}, lastRevision); err != domain.ErrRevisionUnavailable {
+			t.Fatalf("expected error %v resuming from %d, got %v", domain.ErrRevisionUnavailable, lastRevision, err)
+		}
+	}
+}
+
+func TestEventBusSlowWatcherIsClosed(t *testing.T) {
+	bus := NewEventBus(2)
+
+	events, err := bus.Watch(context.Background(), domain.WatchFilter{}, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Hold the first event so that the rest pile up in the queue
+	bus.publish(domain.ConfigEventPut, &domain.Config{Name: "app", Version: 1})
+	time.Sleep(10 * time.Millisecond)
+	for version := 2; version <= 5; version++ {
+		bus.publish(domain.ConfigEventPut, &domain.Config{Name: "app", Version: version})
+	}
+
+	var last uint64
+	for event := range events {
+		last = event.Revision
+	}
+
+	if last != 3 {
+		t.Fatalf("expected the events that fit in the queue before the watcher is closed, got up to revision %d", last)
+	}
+}
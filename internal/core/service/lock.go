@@ -0,0 +1,46 @@
+package service
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// WriteLocks serialize the writes to configurations. A configuration service holds the lock of a configuration while it
+// validates and stores a version of it, and a schema service holds every lock while it checks the configurations of a
+// type against a new schema, so that no version validated against the previous schema is stored in the meantime.
+// Configurations share the locks by the hash of their namespace and name
+type WriteLocks struct {
+	locks [64]sync.Mutex
+}
+
+// NewWriteLocks creates the write locks shared by the configuration and the schema services
+func NewWriteLocks() *WriteLocks {
+	return &WriteLocks{}
+}
+
+// lock locks the writes to a configuration, returning the function that unlocks them
+func (l *WriteLocks) lock(namespace, name string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(namespace))
+	h.Write([]byte{0})
+	h.Write([]byte(name))
+
+	mu := &l.locks[h.Sum32()%uint32(len(l.locks))]
+	mu.Lock()
+
+	return mu.Unlock
+}
+
+// lockAll locks the writes to every configuration, returning the function that unlocks them. The locks are taken in
+// order, and a configuration write only ever holds one of them, so the two can't deadlock
+func (l *WriteLocks) lockAll() func() {
+	for i := range l.locks {
+		l.locks[i].Lock()
+	}
+
+	return func() {
+		for i := range l.locks {
+			l.locks[i].Unlock()
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+)
+
+type NamespaceServicer interface {
+	CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error)
+	GetNamespace(ctx context.Context, name string) (*domain.Namespace, error)
+	ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error)
+	// DeleteNamespace deletes a namespace that holds no configurations. The history of the configurations deleted in it
+	// is kept, they can be restored once a namespace of the same name is created again
+	DeleteNamespace(ctx context.Context, name string) error
+}
+
+type namespaceService struct {
+	repo       port.NamespaceRepository
+	configRepo port.ConfigurationRepository
+}
+
+func NewNamespaceService(repo port.NamespaceRepository, configRepo port.ConfigurationRepository) NamespaceServicer {
+	return &namespaceService{
+		repo,
+		configRepo,
+	}
+}
+
+func (s *namespaceService) CreateNamespace(ctx context.Context, namespace *domain.Namespace) (*domain.Namespace, error) {
+	if !domain.ValidNamespaceName(namespace.Name) {
+		return nil, domain.ErrInvalidNamespace
+	}
+
+	return s.repo.CreateNamespace(ctx, namespace)
+}
+
+func (s *namespaceService) GetNamespace(ctx context.Context, name string) (*domain.Namespace, error) {
+	return s.repo.GetNamespace(ctx, name)
+}
+
+func (s *namespaceService) ListNamespaces(ctx context.Context, skip, limit uint64) ([]*domain.Namespace, error) {
+	return s.repo.ListNamespaces(ctx, skip, limit)
+}
+
+func (s *namespaceService) DeleteNamespace(ctx context.Context, name string) error {
+	if name == domain.DefaultNamespace {
+		return domain.ErrDefaultNamespace
+	}
+
+	if _, err := s.repo.GetNamespace(ctx, name); err != nil {
+		return err
+	}
+
+	configs, _, err := s.configRepo.ListConfigurations(ctx, name, domain.ConfigQuery{}, domain.PageRequest{Limit: 1})
+	if err != nil {
+		return err
+	}
+
+	if len(configs) > 0 {
+		return domain.ErrNamespaceNotEmpty
+	}
+
+	return s.repo.DeleteNamespace(ctx, name)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateNamespace(t *testing.T) {
+	mockRepo := port.NewMockNamespaceRepository(t)
+
+	namespaceService := NewNamespaceService(mockRepo, port.NewMockConfigurationRepository(t))
+
+	mockRepo.On("CreateNamespace", context.Background(), mock.AnythingOfType("*domain.Namespace")).Return(&domain.Namespace{Name: "payments"}, nil)
+
+	if _, err := namespaceService.CreateNamespace(context.Background(), &domain.Namespace{Name: "payments"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, name := range []string{"", "Payments", "-payments", "payments/eu", "payments_", string(make([]byte, 64))} {
+		if _, err := namespaceService.CreateNamespace(context.Background(), &domain.Namespace{Name: name}); err != domain.ErrInvalidNamespace {
+			t.Fatalf("expected error %v for %q, got %v", domain.ErrInvalidNamespace, name, err)
+		}
+	}
+}
+
+func TestDeleteNamespace(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  bool
+		configs []*domain.Config
+		wantErr error
+	}{
+		{"Empty", true, nil, nil},
+		{"NotEmpty", true, []*domain.Config{{Namespace: "payments", Name: "test-config"}}, domain.ErrNamespaceNotEmpty},
+		{"NotFound", false, nil, domain.ErrDataNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := port.NewMockNamespaceRepository(t)
+			mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+			namespaceService := NewNamespaceService(mockRepo, mockConfigRepo)
+
+			if tt.stored {
+				mockRepo.On("GetNamespace", context.Background(), "payments").Return(&domain.Namespace{Name: "payments"}, nil)
+				mockConfigRepo.On("ListConfigurations", context.Background(), "payments", domain.ConfigQuery{}, domain.PageRequest{Limit: 1}).Return(tt.configs, uint64(len(tt.configs)), nil)
+			} else {
+				mockRepo.On("GetNamespace", context.Background(), "payments").Return(nil, domain.ErrDataNotFound)
+			}
+			if tt.wantErr == nil {
+				mockRepo.On("DeleteNamespace", context.Background(), "payments").Return(nil)
+			}
+
+			if err := namespaceService.DeleteNamespace(context.Background(), "payments"); err != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDeleteDefaultNamespace(t *testing.T) {
+	namespaceService := NewNamespaceService(port.NewMockNamespaceRepository(t), port.NewMockConfigurationRepository(t))
+
+	if err := namespaceService.DeleteNamespace(context.Background(), domain.DefaultNamespace); err != domain.ErrDefaultNamespace {
+		t.Fatalf("expected error %v, got %v", domain.ErrDefaultNamespace, err)
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// applyPatch applies a patch document to a configuration value, returning the patched copy
+func applyPatch(patchType domain.PatchType, value map[string]interface{}, patch []byte) (map[string]interface{}, error) {
+	doc, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched []byte
+
+	switch patchType {
+	case domain.PatchTypeMerge:
+		patched, err = jsonpatch.MergePatch(doc, patch)
+	case domain.PatchTypeJSON:
+		var operations jsonpatch.Patch
+		operations, err = jsonpatch.DecodePatch(patch)
+		if err == nil {
+			patched, err = operations.Apply(doc)
+		}
+	default:
+		return nil, domain.ErrUnsupportedPatchType
+	}
+
+	if errors.Is(err, jsonpatch.ErrTestFailed) {
+		return nil, fmt.Errorf("%w: %v", domain.ErrPatchTestFailed, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPatch, err)
+	}
+
+	var patchedValue map[string]interface{}
+	if err := json.Unmarshal(patched, &patchedValue); err != nil || patchedValue == nil {
+		return nil, fmt.Errorf("%w: the patched value must be an object", domain.ErrInvalidPatch)
+	}
+
+	return patchedValue, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestApplyPatch(t *testing.T) {
+	value := map[string]interface{}{
+		"name":    "John",
+		"age":     25,
+		"address": map[string]interface{}{"city": "Jakarta", "zip": "10110"},
+	}
+
+	tests := []struct {
+		name      string
+		patchType domain.PatchType
+		patch     string
+		want      map[string]interface{}
+		wantErr   error
+	}{
+		{
+			name:      "MergePatchReplacesAndRemoves",
+			patchType: domain.PatchTypeMerge,
+			patch:     `{"age": 26, "address": {"zip": null}}`,
+			want: map[string]interface{}{
+				"name":    "John",
+				"age":     float64(26),
+				"address": map[string]interface{}{"city": "Jakarta"},
+			},
+		},
+		{
+			name:      "JSONPatchOperations",
+			patchType: domain.PatchTypeJSON,
+			patch:     `[{"op": "test", "path": "/name", "value": "John"}, {"op": "add", "path": "/tags", "value": ["a"]}, {"op": "remove", "path": "/address"}]`,
+			want: map[string]interface{}{
+				"name": "John",
+				"age":  float64(25),
+				"tags": []interface{}{"a"},
+			},
+		},
+		{
+			name:      "JSONPatchTestFails",
+			patchType: domain.PatchTypeJSON,
+			patch:     `[{"op": "test", "path": "/name", "value": "Jane"}, {"op": "replace", "path": "/name", "value": "Joe"}]`,
+			wantErr:   domain.ErrPatchTestFailed,
+		},
+		{
+			name:      "JSONPatchMissingPath",
+			patchType: domain.PatchTypeJSON,
+			patch:     `[{"op": "replace", "path": "/missing", "value": 1}]`,
+			wantErr:   domain.ErrInvalidPatch,
+		},
+		{
+			name:      "MalformedPatch",
+			patchType: domain.PatchTypeJSON,
+			patch:     `{"op": "add"}`,
+			wantErr:   domain.ErrInvalidPatch,
+		},
+		{
+			name:      "MergePatchReplacesValue",
+			patchType: domain.PatchTypeMerge,
+			patch:     `[1, 2]`,
+			wantErr:   domain.ErrInvalidPatch,
+		},
+		{
+			name:      "UnsupportedType",
+			patchType: domain.PatchType("strategic"),
+			patch:     `{}`,
+			wantErr:   domain.ErrUnsupportedPatchType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyPatch(tt.patchType, value, []byte(tt.patch))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if value["age"] != 25 {
+		t.Fatalf("expected the patched value to be left untouched, got %v", value)
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/kaptinlin/jsonschema"
+)
+
+type SchemaServicer interface {
+	PutSchema(ctx context.Context, schema *domain.Schema) (*domain.Schema, error)
+	GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error)
+	ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error)
+	ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error)
+	GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error)
+	DeleteSchema(ctx context.Context, schemaType string) error
+}
+
+type schemaService struct {
+	repo       port.SchemaRepository
+	configRepo port.ConfigurationRepository
+	locks      *WriteLocks // The locks of the configuration service, held while the configurations of a type are checked
+}
+
+func NewSchemaService(repo port.SchemaRepository, configRepo port.ConfigurationRepository, locks *WriteLocks) SchemaServicer {
+	return &schemaService{
+		repo,
+		configRepo,
+		locks,
+	}
+}
+
+// compileSchema compiles the definition of a schema so that it can validate configuration values
+func compileSchema(schema *domain.Schema) (*jsonschema.Schema, error) {
+	definition, err := json.Marshal(schema.Definition)
+	if err != nil {
+		return nil, domain.ErrInvalidSchemaDefinition
+	}
+
+	// A compiler caches every schema it compiles by its $id, so each definition gets a fresh one
+	compiled, err := jsonschema.NewCompiler().Compile(definition)
+	if err != nil {
+		return nil, domain.ErrInvalidSchemaDefinition
+	}
+
+	return compiled, nil
+}
+
+// PutSchema registers a new version of the schema of a type. The new version is rejected with a
+// domain.SchemaCompatibilityError if it breaks the compatibility mode or any current configuration of the type
+func (s *schemaService) PutSchema(ctx context.Context, schema *domain.Schema) (*domain.Schema, error) {
+	// Reject definitions that can't be compiled before any configuration relies on them
+	compiled, err := compileSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// No configuration of the type is written until the new version is stored, or rejected
+	defer s.locks.lockAll()()
+
+	previous, err := s.repo.GetSchema(ctx, schema.Type)
+	if err != nil && err != domain.ErrDataNotFound {
+		return nil, err
+	}
+
+	newSchema := *schema
+	expectedVersion := 0
+
+	if previous != nil {
+		expectedVersion = previous.Version // The checks below only hold against the version they were made with
+
+		if newSchema.Compatibility == "" {
+			newSchema.Compatibility = previous.Compatibility // Keep the mode of the type unless a new one is chosen
+		}
+	}
+
+	if newSchema.Compatibility == "" {
+		newSchema.Compatibility = domain.SchemaCompatibilityBackward
+	}
+
+	report := &domain.SchemaCompatibilityError{
+		Type:          newSchema.Type,
+		Compatibility: newSchema.Compatibility,
+	}
+
+	if previous != nil {
+		report.Issues = compatibilityIssues(newSchema.Compatibility, previous.Definition, newSchema.Definition)
+	}
+
+	configs, err := s.configsOfType(ctx, newSchema.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, config := range configs {
+		report.Configs = append(report.Configs, incompatibleConfigs(compiled, config)...)
+	}
+
+	if len(report.Issues) > 0 || len(report.Configs) > 0 {
+		return nil, report
+	}
+
+	return s.repo.PutSchema(ctx, &newSchema, expectedVersion)
+}
+
+// incompatibleConfigs reports the value of a configuration, and the effective value of each of its environments, that
+// doesn't match a schema
+func incompatibleConfigs(compiled *jsonschema.Schema, config *domain.Config) []domain.IncompatibleConfig {
+	var incompatible []domain.IncompatibleConfig
+
+	environments := append([]string{""}, slices.Sorted(maps.Keys(config.Overlays))...)
+	for _, environment := range environments {
+		value := config.Value
+		if environment != "" {
+			value = config.ForEnvironment(environment).Value
+		}
+
+		var validationErr *domain.ValidationError
+		if errors.As(validateValue(compiled, value), &validationErr) {
+			incompatible = append(incompatible, domain.IncompatibleConfig{Namespace: config.Namespace, Name: config.Name, Version: config.Version, Environment: environment, Violations: validationErr.Violations})
+		}
+	}
+
+	return incompatible
+}
+
+func (s *schemaService) GetSchema(ctx context.Context, schemaType string) (*domain.Schema, error) {
+	return s.repo.GetSchema(ctx, schemaType)
+}
+
+func (s *schemaService) ListSchemas(ctx context.Context, skip, limit uint64) ([]*domain.Schema, error) {
+	return s.repo.ListSchemas(ctx, skip, limit)
+}
+
+func (s *schemaService) ListSchemaVersions(ctx context.Context, schemaType string, skip, limit uint64) ([]*domain.Schema, error) {
+	return s.repo.ListSchemaVersions(ctx, schemaType, skip, limit)
+}
+
+func (s *schemaService) GetSchemaVersion(ctx context.Context, schemaType string, version int) (*domain.Schema, error) {
+	return s.repo.GetSchemaVersion(ctx, schemaType, version)
+}
+
+func (s *schemaService) DeleteSchema(ctx context.Context, schemaType string) error {
+	defer s.locks.lockAll()()
+
+	if _, err := s.repo.GetSchema(ctx, schemaType); err != nil {
+		return err
+	}
+
+	configs, err := s.configsOfType(ctx, schemaType)
+	if err != nil {
+		return err
+	}
+
+	if len(configs) > 0 {
+		return domain.ErrSchemaInUse // Configurations of this type could no longer be replaced
+	}
+
+	return s.repo.DeleteSchema(ctx, schemaType)
+}
+
+// configsOfType returns the latest version of every configuration of the given type, in any namespace
+func (s *schemaService) configsOfType(ctx context.Context, schemaType string) ([]*domain.Config, error) {
+	const pageSize = 100
+
+	var configsOfType []*domain.Config
+
+	// The cursor continues the order it was made in, so the order is spelled out rather than left to the repository
+	query := domain.ConfigQuery{Type: schemaType}
+	page := domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: pageSize}
+	for {
+		configs, _, err := s.configRepo.ListConfigurations(ctx, "", query, page)
+		if err != nil {
+			return nil, err
+		}
+
+		configsOfType = append(configsOfType, configs...)
+
+		if len(configs) < pageSize {
+			return configsOfType, nil
+		}
+
+		page.Cursor = domain.NewCursor(configs[len(configs)-1], page)
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/arifMasnandar/go-config-management-service/internal/core/port"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPutSchemaNewType(t *testing.T) {
+	mockRepo := port.NewMockSchemaRepository(t)
+	mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+	schemaService := NewSchemaService(mockRepo, mockConfigRepo, NewWriteLocks())
+
+	schema := personSchema(0)
+	schema.Compatibility = ""
+
+	// A new type gets the default compatibility mode
+	registered := *schema
+	registered.Compatibility = domain.SchemaCompatibilityBackward
+
+	mockRepo.On("GetSchema", context.Background(), "person").Return(nil, domain.ErrDataNotFound)
+	mockConfigRepo.On("ListConfigurations", context.Background(), "", domain.ConfigQuery{Type: "person"}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 100}).Return(nil, uint64(0), nil)
+	mockRepo.On("PutSchema", context.Background(), &registered, 0).Return(personSchema(1), nil)
+
+	created, err := schemaService.PutSchema(context.Background(), schema)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.Type != "person" || created.Version != 1 {
+		t.Fatalf("expected the first version of the person schema, got %v", created)
+	}
+}
+
+func TestPutSchemaWaitsForConfigurationWrites(t *testing.T) {
+	mockRepo := port.NewMockSchemaRepository(t)
+	mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+	locks := NewWriteLocks()
+	schemaService := NewSchemaService(mockRepo, mockConfigRepo, locks)
+
+	mockRepo.On("GetSchema", context.Background(), "person").Return(nil, domain.ErrDataNotFound)
+	mockConfigRepo.On("ListConfigurations", context.Background(), "", domain.ConfigQuery{Type: "person"}, mock.Anything).Return(nil, uint64(0), nil)
+	mockRepo.On("PutSchema", context.Background(), mock.Anything, 0).Return(personSchema(1), nil)
+
+	// A configuration write in progress was validated against the previous schema, the new one waits for it to be stored
+	unlock := locks.lock(domain.DefaultNamespace, "person_config")
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := schemaService.PutSchema(context.Background(), personSchema(0))
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("expected the schema to wait for the configuration write, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the schema to be stored once the configuration write is done")
+	}
+}
+
+func TestPutSchemaInvalidDefinition(t *testing.T) {
+	mockRepo := port.NewMockSchemaRepository(t)
+	mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+	schemaService := NewSchemaService(mockRepo, mockConfigRepo, NewWriteLocks())
+
+	schema, err := schemaService.PutSchema(context.Background(), &domain.Schema{Type: "person", Definition: map[string]interface{}{"type": 12}})
+	if schema != nil || err == nil {
+		t.Fatalf("expected error, got schema: %v, error: %v", schema, err)
+	}
+
+	if err != domain.ErrInvalidSchemaDefinition {
+		t.Fatalf("expected error %v, got %v", domain.ErrInvalidSchemaDefinition, err)
+	}
+}
+
+func TestPutSchemaNewVersion(t *testing.T) {
+	mockRepo := port.NewMockSchemaRepository(t)
+	mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+	schemaService := NewSchemaService(mockRepo, mockConfigRepo, NewWriteLocks())
+
+	mockRepo.On("GetSchema", context.Background(), "person").Return(personSchema(1), nil)
+	// Only the configurations of the type are asked for
+	mockConfigRepo.On("ListConfigurations", context.Background(), "", domain.ConfigQuery{Type: "person"}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 100}).Return([]*domain.Config{
+		{Name: "john", Type: "person", Value: map[string]interface{}{"name": "John", "age": 25}, Version: 2},
+		{Name: "jane", Type: "person", Value: map[string]interface{}{"name": "Jane", "age": 17}, Version: 1},
+		{Name: "joe", Type: "person", Value: map[string]interface{}{"name": "Joe", "age": 30}, Overlays: map[string]map[string]interface{}{"staging": {"age": 16}}, Version: 3},
+	}, uint64(3), nil)
+
+	t.Run("Compatible", func(t *testing.T) {
+		// Dropping a required property is backward compatible
+		schema := personSchema(0)
+		schema.Definition["required"] = []interface{}{"name"}
+
+		mockRepo.On("PutSchema", context.Background(), mock.MatchedBy(func(s *domain.Schema) bool {
+			return s.Compatibility == domain.SchemaCompatibilityBackward
+		}), 1).Return(personSchema(2), nil).Once()
+
+		created, err := schemaService.PutSchema(context.Background(), schema)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if created.Version != 2 {
+			t.Fatalf("expected version 2, got %v", created)
+		}
+	})
+
+	t.Run("BreaksCompatibilityMode", func(t *testing.T) {
+		schema := personSchema(0)
+		schema.Definition["properties"].(map[string]interface{})["email"] = map[string]interface{}{"type": "string"}
+		schema.Definition["required"] = []interface{}{"name", "age", "email"}
+
+		_, err := schemaService.PutSchema(context.Background(), schema)
+
+		var report *domain.SchemaCompatibilityError
+		if !errors.As(err, &report) || !errors.Is(err, domain.ErrIncompatibleSchema) {
+			t.Fatalf("expected a compatibility report, got %v", err)
+		}
+		if len(report.Issues) != 1 {
+			t.Errorf("expected 1 issue, got %v", report.Issues)
+		}
+		// Every current person lacks the new required property, in every environment
+		if len(report.Configs) != 4 {
+			t.Errorf("expected 4 incompatible configs, got %v", report.Configs)
+		}
+	})
+
+	t.Run("BreaksCurrentConfigs", func(t *testing.T) {
+		schema := personSchema(0)
+		schema.Definition["properties"].(map[string]interface{})["age"] = map[string]interface{}{"type": "integer", "minimum": 18}
+
+		_, err := schemaService.PutSchema(context.Background(), schema)
+
+		var report *domain.SchemaCompatibilityError
+		if !errors.As(err, &report) {
+			t.Fatalf("expected a compatibility report, got %v", err)
+		}
+		if len(report.Issues) != 0 {
+			t.Errorf("expected no issues, got %v", report.Issues)
+		}
+		// Joe is old enough, but not in the value staging gets
+		want := []domain.IncompatibleConfig{{
+			Name:       "jane",
+			Version:    1,
+			Violations: []domain.Violation{{Pointer: "/age", Keyword: "minimum", Message: "17 should be at least 18"}},
+		}, {
+			Name:        "joe",
+			Version:     3,
+			Environment: "staging",
+			Violations:  []domain.Violation{{Pointer: "/age", Keyword: "minimum", Message: "16 should be at least 18"}},
+		}}
+		if !reflect.DeepEqual(report.Configs, want) {
+			t.Errorf("expected jane and the staging value of joe to be incompatible, got %v", report.Configs)
+		}
+	})
+}
+
+func TestDeleteSchema(t *testing.T) {
+	mockRepo := port.NewMockSchemaRepository(t)
+	mockConfigRepo := port.NewMockConfigurationRepository(t)
+
+	schemaService := NewSchemaService(mockRepo, mockConfigRepo, NewWriteLocks())
+
+	mockRepo.On("GetSchema", context.Background(), "person").Return(personSchema(1), nil)
+	mockRepo.On("GetSchema", context.Background(), "address").Return(&domain.Schema{Type: "address", Version: 1}, nil)
+	mockRepo.On("GetSchema", context.Background(), "unknown").Return(nil, domain.ErrDataNotFound)
+	mockRepo.On("DeleteSchema", context.Background(), "address").Return(nil)
+	mockConfigRepo.On("ListConfigurations", context.Background(), "", domain.ConfigQuery{Type: "person"}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 100}).Return([]*domain.Config{{Name: "test-config", Type: "person", Version: 1}}, uint64(1), nil)
+	mockConfigRepo.On("ListConfigurations", context.Background(), "", domain.ConfigQuery{Type: "address"}, domain.PageRequest{Sort: domain.SortByName, Order: domain.SortAscending, Limit: 100}).Return(nil, uint64(0), nil)
+
+	t.Run("Success", func(t *testing.T) {
+		if err := schemaService.DeleteSchema(context.Background(), "address"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("InUse", func(t *testing.T) {
+		err := schemaService.DeleteSchema(context.Background(), "person")
+		if err != domain.ErrSchemaInUse {
+			t.Fatalf("expected error %v, got %v", domain.ErrSchemaInUse, err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		err := schemaService.DeleteSchema(context.Background(), "unknown")
+		if err != domain.ErrDataNotFound {
+			t.Fatalf("expected error %v, got %v", domain.ErrDataNotFound, err)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+	"github.com/kaptinlin/jsonschema"
+)
+
+// aggregateKeywords are the keywords whose failure only summarizes the failures of their subschemas,
+// which are reported instead
+var aggregateKeywords = map[string]bool{
+	"properties":            true,
+	"patternProperties":     true,
+	"additionalProperties":  true,
+	"items":                 true,
+	"prefixItems":           true,
+	"allOf":                 true,
+	"dependentSchemas":      true,
+	"then":                  true,
+	"else":                  true,
+	"unevaluatedProperties": true,
+	"unevaluatedItems":      true,
+}
+
+// alternativeKeywords are the keywords whose subschemas are alternatives, the failure of
+// a single alternative doesn't make the value invalid so only the keyword itself is reported
+var alternativeKeywords = []string{"/anyOf", "/oneOf", "/not"}
+
+// validateValue validates a configuration value against a compiled schema,
+// returning a *domain.ValidationError listing every violation if it doesn't match
+func validateValue(schema *jsonschema.Schema, value map[string]interface{}) error {
+	result := schema.ValidateMap(value)
+	if result.IsValid() {
+		return nil
+	}
+
+	violations := collectViolations(result, "", nil)
+
+	// A missing required property is also reported as a mismatch of its own schema, the required violation says it better
+	missing := make(map[string]bool)
+	for _, v := range violations {
+		if v.Keyword == "required" {
+			missing[v.Pointer] = true
+		}
+	}
+
+	filtered := violations[:0]
+	for _, v := range violations {
+		if v.Keyword == "required" || !missing[v.Pointer] {
+			filtered = append(filtered, v)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool { return filtered[i].Pointer < filtered[j].Pointer })
+
+	return &domain.ValidationError{Violations: filtered}
+}
+
+// collectViolations walks the evaluation result of the value at pointer and its subschemas.
+// The instance location of a subschema result is relative to its parent
+func collectViolations(result *jsonschema.EvaluationResult, pointer string, violations []domain.Violation) []domain.Violation {
+	keywords := make([]string, 0, len(result.Errors))
+	for keyword := range result.Errors {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+
+	for _, keyword := range keywords {
+		err := result.Errors[keyword]
+
+		switch {
+		case aggregateKeywords[keyword]:
+			continue
+		case keyword == "required":
+			// Point at each missing property so that it can be highlighted
+			for _, property := range missingProperties(err) {
+				violations = append(violations, domain.Violation{
+					Pointer: pointer + "/" + escapePointer(property),
+					Keyword: keyword,
+					Message: fmt.Sprintf("Required property '%s' is missing", property),
+				})
+			}
+		default:
+			violations = append(violations, domain.Violation{Pointer: pointer, Keyword: keyword, Message: err.Error()})
+		}
+	}
+
+	for _, detail := range result.Details {
+		if detail.IsValid() || isAlternative(detail.EvaluationPath) {
+			continue
+		}
+
+		// Each level adds a single unescaped token, or nothing when the subschema applies to the same value
+		childPointer := pointer
+		if token, ok := strings.CutPrefix(detail.InstanceLocation, "/"); ok {
+			childPointer += "/" + escapePointer(token)
+		}
+
+		// additionalProperties: false fails with a false schema on each extra property
+		if strings.HasPrefix(detail.EvaluationPath, "/additionalProperties/") {
+			violations = append(violations, domain.Violation{Pointer: childPointer, Keyword: "additionalProperties", Message: "Additional property is not allowed"})
+			continue
+		}
+
+		violations = collectViolations(detail, childPointer, violations)
+	}
+
+	return violations
+}
+
+// missingProperties returns the names of the properties a required error is about,
+// the library reports them as a single quoted list such as 'name', 'age'
+func missingProperties(err *jsonschema.EvaluationError) []string {
+	list, ok := err.Params["properties"].(string)
+	if !ok {
+		list, _ = err.Params["property"].(string)
+	}
+
+	list = strings.TrimSuffix(strings.TrimPrefix(list, "'"), "'")
+
+	return strings.Split(list, "', '")
+}
+
+// isAlternative reports whether a subschema result belongs to anyOf, oneOf or not
+func isAlternative(evaluationPath string) bool {
+	for _, prefix := range alternativeKeywords {
+		if evaluationPath == prefix || strings.HasPrefix(evaluationPath, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// escapePointer escapes a property name to be used as a JSON pointer token
+func escapePointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/arifMasnandar/go-config-management-service/internal/core/domain"
+)
+
+func TestValidateValue(t *testing.T) {
+	schema, err := compileSchema(&domain.Schema{Definition: map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":     map[string]interface{}{"type": "string", "minLength": 1},
+			"age":      map[string]interface{}{"type": "integer", "minimum": 0},
+			"zip/code": map[string]interface{}{"type": "string"},
+			"tags":     map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+			"address": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"city": map[string]interface{}{"type": "string"}},
+				"required":   []interface{}{"city"},
+			},
+			"id": map[string]interface{}{"anyOf": []interface{}{
+				map[string]interface{}{"type": "string"},
+				map[string]interface{}{"type": "integer"},
+			}},
+		},
+		"required":             []interface{}{"name", "age", "zip/code"},
+		"additionalProperties": false,
+	}})
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	if err := validateValue(schema, map[string]interface{}{"name": "John", "age": 25, "zip/code": "1234"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err = validateValue(schema, map[string]interface{}{
+		"name":    "",
+		"age":     1.5,
+		"tags":    []interface{}{"a", 3},
+		"address": map[string]interface{}{},
+		"id":      true,
+		"extra":   1,
+		"a/b":     1,
+	})
+
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	want := []domain.Violation{
+		{Pointer: "/address/city", Keyword: "required", Message: "Required property 'city' is missing"},
+		{Pointer: "/age", Keyword: "type", Message: "Value is number but should be integer"},
+		{Pointer: "/a~1b", Keyword: "additionalProperties", Message: "Additional property is not allowed"},
+		{Pointer: "/extra", Keyword: "additionalProperties", Message: "Additional property is not allowed"},
+		{Pointer: "/id", Keyword: "anyOf", Message: "Value does not match anyOf schema"},
+		{Pointer: "/name", Keyword: "minLength", Message: "Value should be at least 1 characters"},
+		{Pointer: "/tags/1", Keyword: "type", Message: "Value is integer but should be string"},
+		{Pointer: "/zip~1code", Keyword: "required", Message: "Required property 'zip/code' is missing"},
+	}
+	if !reflect.DeepEqual(validationErr.Violations, want) {
+		t.Errorf("validateValue() violations =\n%v\nwant\n%v", validationErr.Violations, want)
+	}
+}